@@ -54,6 +54,7 @@ func main() {
 	// 4. Fiber-App mit ErrorHandler, RequestID- und Logger-Middleware erstellen.
 	app := fiber.New(fiber.Config{
 		ErrorHandler: middleware.ErrorHandlerMiddleware(i18nSvc),
+		BodyLimit:    25 * 1024 * 1024, // Anhänge bis 20 MB plus Multipart-Overhead
 	})
 	app.Use(middleware.RequestIDMiddleware())
 	app.Use(middleware.AcceptLanguageMiddleware())
@@ -64,7 +65,10 @@ func main() {
 		Host:     cfg.DATABASE.Redis.Addr,
 		Password: cfg.DATABASE.Redis.Password,
 	}
-	routers.SetupRoutes(app, dbPool, redisPool, i18nSvc, paseto, cfgStorage)
+	cfgBlob := routers.CfgBlobStorage{
+		Root: cfg.STORAGE.Local.Root,
+	}
+	routers.SetupRoutes(app, dbPool, redisPool, i18nSvc, paseto, cfgStorage, cfgBlob)
 
 	go func() {
 		// 6. HTTP-Server starten (app.Listen), soll es am Ende stellen, weil es blocking ist. Aber wir können es eigenlich in eine Goroutine einfügen.
@@ -0,0 +1,15 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+)
+
+// BlobStorage stores file content under a key, metadata is kept by the caller
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) *app_errors.AppError
+	Open(ctx context.Context, key string) (io.ReadCloser, *app_errors.AppError)
+	Delete(ctx context.Context, key string) error
+}
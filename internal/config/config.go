@@ -44,6 +44,12 @@ type AppConfig struct {
 			MailtrapDomain   string `mapstructure:"MAILTRAP_DOMAIN"`
 		}
 	}
+
+	STORAGE struct {
+		Local struct {
+			Root string `mapstructure:"ROOT"`
+		}
+	}
 }
 
 func LoadConfig() *AppConfig {
@@ -66,6 +72,10 @@ func LoadConfig() *AppConfig {
 		config.APP.Port = "8080"
 	}
 
+	if config.STORAGE.Local.Root == "" {
+		config.STORAGE.Local.Root = "./storage"
+	}
+
 	if config.DATABASE.Postgres.DSN == "" {
 		log.Error().Msg("Datenbank-DSN ist nicht konfiguriert")
 		return nil
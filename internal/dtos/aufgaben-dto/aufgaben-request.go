@@ -1,6 +1,7 @@
 package aufgaben_dto
 
 import (
+	"io"
 	"time"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
@@ -8,26 +9,54 @@ import (
 )
 
 type CreateNewAufgabenRequest struct {
-	Title       string     `json:"title" validate:"required"`
-	Description *string    `json:"description,omitempty"`
-	Priority    *string    `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	AssigneeID  *string    `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Title        string     `json:"title" validate:"required"`
+	Description  *string    `json:"description,omitempty"`
+	Priority     *string    `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	AssigneeID   *string    `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Estimate     *float64   `json:"estimate,omitempty" validate:"omitempty,gt=0,lte=10000"`
+	EstimateUnit *string    `json:"estimate_unit,omitempty" validate:"required_with=Estimate,omitempty,estimateUnit"`
 }
 
+// AufgabenListFilter pages by offset, or by keyset once Cursor (the id of the last task seen) is given, Page is ignored then.
+// Sort takes up to three comma separated keys, a leading "-" sorts descending. Both due date bounds include the given day.
 type AufgabenListFilter struct {
-	Status     *string `query:"status,omitempty" validate:"omitempty,aufgabenStatus"`
-	AssigneeID *string `query:"assigned_id,omitempty" validate:"omitempty,uuid"`
-	Limit      int     `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
-	Page       int     `query:"page,omitempty" validate:"omitempty,min=1,max=100"`
+	Status     *string  `query:"status,omitempty" validate:"omitempty,aufgabenStatus"`
+	AssigneeID *string  `query:"assigned_id,omitempty" validate:"omitempty,uuid"`
+	Priority   *string  `query:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	CreatedBy  *string  `query:"created_by,omitempty" validate:"omitempty,uuid"`
+	DueBefore  *string  `query:"due_before,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	DueAfter   *string  `query:"due_after,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Overdue    *bool    `query:"overdue,omitempty"`
+	Unassigned *bool    `query:"unassigned,omitempty"`
+	Query      *string  `query:"q,omitempty" validate:"omitempty,min=2,max=200"`
+	Labels     []string `query:"labels,omitempty" validate:"omitempty,max=20,dive,min=1,max=50"`
+	LabelMatch *string  `query:"label_match,omitempty" validate:"omitempty,oneof=any all"`
+	Sort       []string `query:"sort,omitempty" validate:"omitempty,max=3,dive,min=1,max=50"`
+	Limit      int      `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Page       int      `query:"page,omitempty" validate:"omitempty,min=1,max=100"`
+	Cursor     *string  `query:"cursor,omitempty" validate:"omitempty,uuid"`
 }
 
 type AssignedAufgabenFilter struct {
+	Status     *string  `query:"status,omitempty" validate:"omitempty,aufgabenStatus"`
+	Priority   *string  `query:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	ProjectID  *string  `query:"project_id,omitempty" validate:"omitempty,uuid"`
+	Labels     []string `query:"labels,omitempty" validate:"omitempty,max=20,dive,min=1,max=50"`
+	LabelMatch *string  `query:"label_match,omitempty" validate:"omitempty,oneof=any all"`
+	Role       *string  `query:"role,omitempty" validate:"omitempty,oneof=Owner Collaborator Reviewer"`
+	Overdue    *bool    `query:"overdue,omitempty"`
+	Limit      int      `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Cursor     *string  `query:"cursor,omitempty" validate:"omitempty,uuid"`
+}
+
+type AufgabenSearchFilter struct {
+	Query     string  `query:"q" validate:"required,min=2,max=200"`
 	Status    *string `query:"status,omitempty" validate:"omitempty,aufgabenStatus"`
 	Priority  *string `query:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
 	ProjectID *string `query:"project_id,omitempty" validate:"omitempty,uuid"`
 	Limit     int     `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
-	Cursor    *string `query:"cursor,omitempty" validate:"omitempty,uuid"`
+	Page      int     `query:"page,omitempty" validate:"omitempty,min=1,max=100"`
 }
 
 type AufgabenEventFilter struct {
@@ -43,6 +72,14 @@ type AufgabenAssignRequest struct {
 	DueDate time.Time `json:"due_date" validate:"required"`
 }
 
+type UpdateWorkloadThresholdRequest struct {
+	Threshold *int `json:"threshold" validate:"required,min=0,max=1000"`
+}
+
+type PickNextAufgabeRequest struct {
+	DueDate *time.Time `json:"due_date,omitempty"`
+}
+
 type AufgabenUpdateProgressRequest struct {
 	Status string `json:"status" validate:"required,aufgabenStatus"`
 }
@@ -67,6 +104,16 @@ type ForceAufgabeHandoverRequest struct {
 	Reason     string `json:"reason" validate:"required"`
 }
 
+type ApproveHandoverRequest struct {
+	Note       string `json:"note,omitempty" validate:"omitempty,min=3"`
+	ReasonCode string `json:"reason_code" validate:"required,reasonCode"`
+	Reason     string `json:"reason" validate:"required"`
+}
+
+type RejectHandoverRequest struct {
+	Reason string `json:"reason" validate:"required,min=3"`
+}
+
 type ReassignAufgabenRequest struct {
 	TargetID   string  `json:"target_id" validate:"required,uuid"`
 	Note       string  `json:"note" validate:"required"`
@@ -74,10 +121,281 @@ type ReassignAufgabenRequest struct {
 	ReasonCode *string `json:"reason_code,omitempty" validate:"omitempty,reasonCode"`
 }
 
+type RestoreAufgabenRequest struct {
+	Note       *string `json:"note,omitempty" validate:"omitempty,min=3"`
+	ReasonCode string  `json:"reason_code" validate:"required,restoreReasonCode"`
+	Reason     string  `json:"reason" validate:"required"`
+}
+
+type WorkflowStatusRequest struct {
+	Key      string `json:"key" validate:"required,max=50,excludesall=0x20"`
+	Name     string `json:"name" validate:"required,max=100"`
+	Category string `json:"category" validate:"required,oneof=Todo In_Progress Done"`
+}
+
+type WorkflowTransitionRequest struct {
+	From  string   `json:"from" validate:"required,max=50"`
+	To    string   `json:"to" validate:"required,max=50"`
+	Roles []string `json:"roles,omitempty" validate:"omitempty,dive,oneof=Meister Mitarbeiter"`
+}
+
+type UpdateWorkflowRequest struct {
+	Statuses    []WorkflowStatusRequest     `json:"statuses" validate:"required,min=3,max=20,dive"`
+	Transitions []WorkflowTransitionRequest `json:"transitions" validate:"required,min=1,max=100,dive"`
+}
+
+type TransitionTaskRequest struct {
+	Status string  `json:"status" validate:"required,max=50"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,min=3"`
+}
+
+type UpdateAufgabenRequest struct {
+	Title       *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Priority    *string `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+}
+
+// UpdateEstimateRequest clears the estimate when it's null
+type UpdateEstimateRequest struct {
+	Estimate     *float64 `json:"estimate" validate:"omitempty,gt=0,lte=10000"`
+	EstimateUnit *string  `json:"estimate_unit,omitempty" validate:"required_with=Estimate,omitempty,estimateUnit"`
+}
+
+type VelocityFilter struct {
+	Weeks int `query:"weeks,omitempty" validate:"omitempty,min=1,max=52"`
+}
+
 type UpdateDueDateRequest struct {
 	DueDate time.Time `json:"due_date" validate:"required,dateInFuture"`
 }
 
+type AddDependencyRequest struct {
+	BlockedByID string `json:"blocked_by_id" validate:"required,uuid"`
+}
+
+type ParamBlockerID struct {
+	ID string `params:"blocker_id" validate:"required,uuid"`
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=5000"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=5000"`
+}
+
+type AufgabenCommentFilter struct {
+	Limit  int     `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Cursor *string `query:"cursor,omitempty" validate:"omitempty,uuid"`
+}
+
+type ParamCommentID struct {
+	ID string `params:"comment_id" validate:"required,uuid"`
+}
+
+type CreateRecurrenceRequest struct {
+	Title             string  `json:"title" validate:"required,max=255"`
+	Description       *string `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Priority          *string `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	DefaultAssigneeID *string `json:"default_assignee_id,omitempty" validate:"omitempty,uuid"`
+	DueOffsetMinutes  *int    `json:"due_offset_minutes,omitempty" validate:"omitempty,min=0,max=525600"`
+	CronExpr          string  `json:"cron_expr" validate:"required,max=100"`
+}
+
+// UpdateRecurrenceRequest clears the default assignee with an empty string
+type UpdateRecurrenceRequest struct {
+	Title             *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Description       *string `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Priority          *string `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	DefaultAssigneeID *string `json:"default_assignee_id,omitempty" validate:"omitempty,uuid|len=0"`
+	DueOffsetMinutes  *int    `json:"due_offset_minutes,omitempty" validate:"omitempty,min=0,max=525600"`
+	CronExpr          *string `json:"cron_expr,omitempty" validate:"omitempty,min=1,max=100"`
+	Active            *bool   `json:"active,omitempty"`
+}
+
+type ParamRecurrenceID struct {
+	ID string `params:"recurrence_id" validate:"required,uuid"`
+}
+
+// SaveTemplateRequest is used for create and full update, title and description may contain {{variable}} placeholders
+type SaveTemplateRequest struct {
+	Name             string   `json:"name" validate:"required,max=100"`
+	Title            string   `json:"title" validate:"required,max=255"`
+	Description      *string  `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Priority         *string  `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	DueOffsetMinutes *int     `json:"due_offset_minutes,omitempty" validate:"omitempty,min=0,max=525600"`
+	Estimate         *float64 `json:"estimate,omitempty" validate:"omitempty,gt=0,lte=10000"`
+	EstimateUnit     *string  `json:"estimate_unit,omitempty" validate:"required_with=Estimate,omitempty,estimateUnit"`
+}
+
+// CreateFromTemplateRequest overrides the template defaults, variables fill the {{variable}} placeholders
+type CreateFromTemplateRequest struct {
+	Title        *string           `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Description  *string           `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Priority     *string           `json:"priority,omitempty" validate:"omitempty,aufgabenPriority"`
+	DueDate      *time.Time        `json:"due_date,omitempty"`
+	AssigneeID   *string           `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Estimate     *float64          `json:"estimate,omitempty" validate:"omitempty,gt=0,lte=10000"`
+	EstimateUnit *string           `json:"estimate_unit,omitempty" validate:"required_with=Estimate,omitempty,estimateUnit"`
+	Variables    map[string]string `json:"variables,omitempty" validate:"omitempty,max=50,dive,keys,min=1,max=50,endkeys,max=1000"`
+}
+
+type ParamTemplateID struct {
+	ID string `params:"template_id" validate:"required,uuid"`
+}
+
+// AttachmentUpload is the file part of a multipart upload, the content is read once by the service
+type AttachmentUpload struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     io.Reader
+}
+
+type ParamAttachmentID struct {
+	ID string `params:"attachment_id" validate:"required,uuid"`
+}
+
+type ParamHandoverRequestID struct {
+	ID string `params:"request_id" validate:"required,uuid"`
+}
+
+type AddTaskAssigneeRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+	Role   string `json:"role" validate:"required,oneof=Collaborator Reviewer"`
+}
+
+type ParamAssigneeUserID struct {
+	ID string `params:"user_id" validate:"required,uuid"`
+}
+
+type StartTimerRequest struct {
+	Note *string `json:"note,omitempty" validate:"omitempty,max=500"`
+}
+
+type CreateWorklogRequest struct {
+	StartedAt       time.Time `json:"started_at" validate:"required"`
+	DurationMinutes int       `json:"duration_minutes" validate:"required,min=1,max=1440"`
+	Note            *string   `json:"note,omitempty" validate:"omitempty,max=500"`
+}
+
+type UpdateWorklogRequest struct {
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	DurationMinutes *int       `json:"duration_minutes,omitempty" validate:"omitempty,min=1,max=1440"`
+	Note            *string    `json:"note,omitempty" validate:"omitempty,max=500"`
+}
+
+type AufgabenWorklogFilter struct {
+	Limit  int     `query:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Cursor *string `query:"cursor,omitempty" validate:"omitempty,uuid"`
+}
+
+type TimeReportFilter struct {
+	From   string  `query:"from" validate:"required,datetime=2006-01-02"`
+	To     string  `query:"to" validate:"required,datetime=2006-01-02"`
+	UserID *string `query:"user_id,omitempty" validate:"omitempty,uuid"`
+}
+
+type SLAComplianceFilter struct {
+	From string `query:"from" validate:"required,datetime=2006-01-02"`
+	To   string `query:"to" validate:"required,datetime=2006-01-02"`
+}
+
+type UpsertSLAPolicyRequest struct {
+	AssignWithinMinutes   *int `json:"assign_within_minutes,omitempty" validate:"omitempty,min=1,max=525600"`
+	CompleteWithinMinutes *int `json:"complete_within_minutes,omitempty" validate:"omitempty,min=1,max=525600"`
+}
+
+type ParamSLAPriority struct {
+	ID string `params:"priority" validate:"required,aufgabenPriority"`
+}
+
+type UpsertEscalationLevelRequest struct {
+	AfterReminders    *int    `json:"after_reminders,omitempty" validate:"omitempty,min=1,max=100"`
+	AfterHoursOverdue *int    `json:"after_hours_overdue,omitempty" validate:"omitempty,min=1,max=8760"`
+	Action            *string `json:"action,omitempty" validate:"omitempty,escalationAction"`
+}
+
+type ParamEscalationLevel struct {
+	ID int `params:"level" validate:"required,min=1,max=5"`
+}
+
+type MoveBoardTaskRequest struct {
+	Status   string  `json:"status" validate:"required,max=50"`
+	Position *int    `json:"position,omitempty" validate:"omitempty,min=0"`
+	Note     *string `json:"note,omitempty" validate:"omitempty,min=3"`
+}
+
+type UpsertBoardColumnRequest struct {
+	WIPLimit *int `json:"wip_limit" validate:"required,min=1,max=1000"`
+}
+
+type ParamBoardStatusKey struct {
+	ID string `params:"status_key" validate:"required,max=50"`
+}
+
+type CreateMilestoneRequest struct {
+	Name      string  `json:"name" validate:"required,max=100"`
+	Goal      *string `json:"goal,omitempty" validate:"omitempty,max=2000"`
+	StartDate string  `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string  `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+// UpdateMilestoneRequest clears the goal with an empty string
+type UpdateMilestoneRequest struct {
+	Name      *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Goal      *string `json:"goal,omitempty" validate:"omitempty,max=2000"`
+	StartDate *string `json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   *string `json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// CloseMilestoneRequest moves unfinished tasks to CarryOverTo, or back to the backlog when it is missing
+type CloseMilestoneRequest struct {
+	CarryOverTo *string `json:"carry_over_to,omitempty" validate:"omitempty,uuid"`
+}
+
+// SetTaskMilestoneRequest removes the task from its milestone when MilestoneID is missing
+type SetTaskMilestoneRequest struct {
+	MilestoneID *string `json:"milestone_id" validate:"omitempty,uuid"`
+}
+
+type ParamMilestoneID struct {
+	ID string `params:"milestone_id" validate:"required,uuid"`
+}
+
+type ParamCalendarFeedID struct {
+	ID string `params:"feed_id" validate:"required,uuid"`
+}
+
+// ParamCalendarFeedToken is the raw feed token, calendar apps get it with an optional ".ics" suffix
+type ParamCalendarFeedToken struct {
+	Token string `params:"token" validate:"required,max=64"`
+}
+
+// CalendarFeedQuery picks how tasks show up in the feed, as VEVENT at the due date (default) or as VTODO
+type CalendarFeedQuery struct {
+	Component *string `query:"component,omitempty" validate:"omitempty,oneof=event todo"`
+}
+
+type ParamWorklogID struct {
+	ID string `params:"worklog_id" validate:"required,uuid"`
+}
+
+type CreateLabelRequest struct {
+	Name  string  `json:"name" validate:"required,min=1,max=50,excludesall=0x2C"`
+	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+type UpdateLabelRequest struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=50,excludesall=0x2C"`
+	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+type ParamLabelID struct {
+	ID string `params:"label_id" validate:"required,uuid"`
+}
+
 func IsDateInFuture(fl validator.FieldLevel) bool {
 	v := fl.Field().Interface().(time.Time)
 	return v.After(time.Now())
@@ -93,6 +411,16 @@ func IsValidReasonCode(fl validator.FieldLevel) bool {
 	}
 }
 
+func IsValidRestoreReasonCode(fl validator.FieldLevel) bool {
+	v := fl.Field().String()
+	switch entity.ReasonCodeEvent(v) {
+	case entity.ReasonMistake, entity.ReasonScopeChanged, entity.ReasonDefectFound, entity.ReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsValidAufgabenStatus(fl validator.FieldLevel) bool {
 	v := fl.Field().String()
 	switch entity.AufgabenStatus(v) {
@@ -103,6 +431,26 @@ func IsValidAufgabenStatus(fl validator.FieldLevel) bool {
 	}
 }
 
+func IsValidEscalationAction(fl validator.FieldLevel) bool {
+	v := fl.Field().String()
+	switch entity.EscalationAction(v) {
+	case entity.EscalationNotifyMeister, entity.EscalationFlagForceUnassign:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsValidEstimateUnit(fl validator.FieldLevel) bool {
+	v := fl.Field().String()
+	switch entity.EstimateUnit(v) {
+	case entity.EstimatePoints, entity.EstimateHours:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsValidAufgabenPriority(fl validator.FieldLevel) bool {
 	v := fl.Field().String()
 	switch entity.AufgabenPriority(v) {
@@ -3,25 +3,55 @@ package aufgaben_dto
 import "time"
 
 type CreateNewAufgabenResponse struct {
-	AufgabenID  string     `json:"aufgaben_id"`
-	ProjectID   string     `json:"project_id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Status      string     `json:"status"`
-	Priority    string     `json:"priority"`
-	AssigneeID  *string    `json:"assignee_id,omitempty"`
-	CreateAt    time.Time  `json:"created_at"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
+	AufgabenID   string     `json:"aufgaben_id"`
+	ProjectID    string     `json:"project_id"`
+	ParentID     *string    `json:"parent_id,omitempty"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Status       string     `json:"status"`
+	Priority     string     `json:"priority"`
+	AssigneeID   *string    `json:"assignee_id,omitempty"`
+	CreateAt     time.Time  `json:"created_at"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	Estimate     *float64   `json:"estimate,omitempty"`
+	EstimateUnit *string    `json:"estimate_unit,omitempty"`
 }
 
 type AufgabenItem struct {
-	AufgabenID  string     `json:"aufgaben_id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Status      string     `json:"status"`
-	Priority    string     `json:"priority"`
-	AssigneeID  *string    `json:"assignee_id,omitempty"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
+	AufgabenID     string               `json:"aufgaben_id"`
+	ParentID       *string              `json:"parent_id,omitempty"`
+	Title          string               `json:"title"`
+	Description    *string              `json:"description,omitempty"`
+	Status         string               `json:"status"`
+	Priority       string               `json:"priority"`
+	AssigneeID     *string              `json:"assignee_id,omitempty"`
+	DueDate        *time.Time           `json:"due_date,omitempty"`
+	WorkflowStatus *string              `json:"workflow_status,omitempty"`
+	Estimate       *float64             `json:"estimate,omitempty"`
+	EstimateUnit   *string              `json:"estimate_unit,omitempty"`
+	RecurrenceID   *string              `json:"recurrence_id,omitempty"`
+	MilestoneID    *string              `json:"milestone_id,omitempty"`
+	Subtasks       *SubtaskProgressItem `json:"subtasks,omitempty"`
+	Labels         []*LabelItem         `json:"labels,omitempty"`
+
+	EscalationLevel      int        `json:"escalation_level,omitempty"`
+	FlaggedForUnassignAt *time.Time `json:"flagged_for_unassign_at,omitempty"`
+
+	// OverdueSince is how long the task has been past its due date, in seconds
+	IsOverdue    bool   `json:"is_overdue"`
+	OverdueSince *int64 `json:"overdue_since,omitempty"`
+}
+
+type SubtaskProgressItem struct {
+	Total   int `json:"total"`
+	Done    int `json:"done"`
+	Percent int `json:"percent"`
+}
+
+type SubtaskListResponse struct {
+	ParentID string              `json:"parent_id"`
+	Progress SubtaskProgressItem `json:"progress"`
+	Subtasks []*AufgabenItem     `json:"subtasks"`
 }
 
 type AssignedAufgabenListItem struct {
@@ -32,6 +62,10 @@ type AssignedAufgabenListItem struct {
 	Status      string    `json:"status"`
 	Priority    string    `json:"priority"`
 	DueDate     time.Time `json:"due_date"`
+	Role        string    `json:"role"`
+
+	IsOverdue    bool   `json:"is_overdue"`
+	OverdueSince *int64 `json:"overdue_since,omitempty"`
 }
 
 type AufgabenAssignResponse struct {
@@ -60,6 +94,13 @@ type UnassignAufgabenResponse struct {
 	Reason     string `json:"reason"`
 }
 
+type RestoreAufgabenResponse struct {
+	AufgabenID string `json:"aufgaben_id"`
+	Status     string `json:"status"`
+	Action     string `json:"action"`
+	Reason     string `json:"reason"`
+}
+
 type ReassignAufgabenResponse struct {
 	AufgabenID    string  `json:"aufgaben_id"`
 	NewAssigneeID *string `json:"new_assignee_id"`
@@ -67,6 +108,33 @@ type ReassignAufgabenResponse struct {
 	Note          string  `json:"note"`
 	Action        string  `json:"action"`
 	Reason        *string `json:"reason,omitempty"`
+	RequestID     *string `json:"request_id,omitempty"`
+
+	WorkloadWarning *WorkloadWarning `json:"workload_warning,omitempty"`
+}
+
+type WorkloadWarning struct {
+	UserID    string  `json:"user_id"`
+	LoadScore float64 `json:"load_score"`
+	Threshold int     `json:"threshold"`
+}
+
+type AssigneeSuggestionItem struct {
+	UserID          string  `json:"user_id"`
+	Username        string  `json:"username"`
+	Role            string  `json:"role"`
+	OpenAssignments int     `json:"open_assignments"`
+	OverdueCount    int     `json:"overdue_count"`
+	RecentOverloads int     `json:"recent_overloads"`
+	UrgentOpen      int     `json:"urgent_open"`
+	HighOpen        int     `json:"high_open"`
+	LoadScore       float64 `json:"load_score"`
+	AboveThreshold  bool    `json:"above_threshold"`
+}
+
+type WorkloadThresholdResponse struct {
+	ProjectID string `json:"project_id"`
+	Threshold int    `json:"threshold"`
 }
 
 type UpdateDueDateResponse struct {
@@ -74,6 +142,48 @@ type UpdateDueDateResponse struct {
 	DueDate    time.Time `json:"due_date"`
 }
 
+type UpdateEstimateResponse struct {
+	AufgabenID   string    `json:"aufgaben_id"`
+	Estimate     *float64  `json:"estimate"`
+	EstimateUnit *string   `json:"estimate_unit"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type VelocityWeekItem struct {
+	WeekStart       string  `json:"week_start"`
+	PlannedPoints   float64 `json:"planned_points"`
+	PlannedHours    float64 `json:"planned_hours"`
+	PlannedTasks    int     `json:"planned_tasks"`
+	CompletedPoints float64 `json:"completed_points"`
+	CompletedHours  float64 `json:"completed_hours"`
+	CompletedTasks  int     `json:"completed_tasks"`
+}
+
+type VelocityResponse struct {
+	ProjectID     string             `json:"project_id"`
+	From          string             `json:"from"`
+	To            string             `json:"to"`
+	AveragePoints float64            `json:"average_points"`
+	AverageHours  float64            `json:"average_hours"`
+	AverageTasks  float64            `json:"average_tasks"`
+	Weeks         []VelocityWeekItem `json:"weeks"`
+}
+
+type FieldChangeItem struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+}
+
+type UpdateAufgabenResponse struct {
+	AufgabenID  string            `json:"aufgaben_id"`
+	Title       string            `json:"title"`
+	Description *string           `json:"description,omitempty"`
+	Priority    string            `json:"priority"`
+	Changes     []FieldChangeItem `json:"changes"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
 type AufgabenEventItem struct {
 	EventID     string    `json:"event_id"`
 	AufgabeID   string    `json:"aufgabe_id"`
@@ -83,5 +193,390 @@ type AufgabenEventItem struct {
 	TargetID    *string   `json:"target_id,omitempty"`
 	ReasonCode  *string   `json:"reason_code,omitempty"`
 	ReasonText  *string   `json:"reason_text,omitempty"`
+	Field       *string   `json:"field,omitempty"`
+	OldValue    *string   `json:"old_value,omitempty"`
+	NewValue    *string   `json:"new_value,omitempty"`
 	EventTime   time.Time `json:"event_time"`
 }
+
+type DependencyItem struct {
+	AufgabenID string    `json:"aufgaben_id"`
+	Title      string    `json:"title"`
+	Status     string    `json:"status"`
+	AssigneeID *string   `json:"assignee_id,omitempty"`
+	IsOpen     bool      `json:"is_open"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type DependencyListResponse struct {
+	AufgabenID string            `json:"aufgaben_id"`
+	IsBlocked  bool              `json:"is_blocked"`
+	BlockedBy  []*DependencyItem `json:"blocked_by"`
+	Blocking   []*DependencyItem `json:"blocking"`
+}
+
+type AddDependencyResponse struct {
+	AufgabenID  string    `json:"aufgaben_id"`
+	BlockedByID string    `json:"blocked_by_id"`
+	IsBlocked   bool      `json:"is_blocked"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CommentItem struct {
+	CommentID string     `json:"comment_id"`
+	AufgabeID string     `json:"aufgabe_id"`
+	AuthorID  string     `json:"author_id"`
+	Body      string     `json:"body"`
+	Mentions  []string   `json:"mentions,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
+
+type LabelItem struct {
+	LabelID   string    `json:"label_id"`
+	ProjectID string    `json:"project_id"`
+	Name      string    `json:"name"`
+	Color     *string   `json:"color,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TaskLabelResponse struct {
+	AufgabenID string    `json:"aufgaben_id"`
+	LabelID    string    `json:"label_id"`
+	Name       string    `json:"name"`
+	AttachedAt time.Time `json:"attached_at"`
+}
+
+type AufgabenSearchItem struct {
+	AufgabenID     string     `json:"aufgaben_id"`
+	ProjectID      string     `json:"project_id"`
+	ProjectName    string     `json:"project_name"`
+	Title          string     `json:"title"`
+	Status         string     `json:"status"`
+	Priority       string     `json:"priority"`
+	AssigneeID     *string    `json:"assignee_id,omitempty"`
+	DueDate        *time.Time `json:"due_date,omitempty"`
+	Rank           float32    `json:"rank"`
+	Snippet        string     `json:"snippet"`
+	CommentSnippet *string    `json:"comment_snippet,omitempty"`
+}
+
+type WorkflowStatusItem struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Position int    `json:"position"`
+}
+
+type WorkflowTransitionItem struct {
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+	Roles []string `json:"roles"`
+}
+
+type WorkflowResponse struct {
+	ProjectID   string                   `json:"project_id"`
+	IsDefault   bool                     `json:"is_default"`
+	Statuses    []WorkflowStatusItem     `json:"statuses"`
+	Transitions []WorkflowTransitionItem `json:"transitions"`
+}
+
+type TransitionTaskResponse struct {
+	AufgabenID     string `json:"aufgaben_id"`
+	Status         string `json:"status"`
+	WorkflowStatus string `json:"workflow_status"`
+	PreviousStatus string `json:"previous_status"`
+}
+
+type WorklogItem struct {
+	WorklogID       string     `json:"worklog_id"`
+	AufgabenID      string     `json:"aufgaben_id"`
+	UserID          string     `json:"user_id"`
+	Source          string     `json:"source"`
+	StartedAt       time.Time  `json:"started_at"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	DurationMinutes *int       `json:"duration_minutes,omitempty"`
+	Running         bool       `json:"running"`
+	Note            *string    `json:"note,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+type WorklogTotalItem struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	TotalMinutes int    `json:"total_minutes"`
+}
+
+type TaskTimeSummaryResponse struct {
+	AufgabenID   string             `json:"aufgaben_id"`
+	TotalMinutes int                `json:"total_minutes"`
+	Members      []WorklogTotalItem `json:"members"`
+}
+
+type TimeReportResponse struct {
+	ProjectID    string             `json:"project_id"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	TotalMinutes int                `json:"total_minutes"`
+	Members      []WorklogTotalItem `json:"members"`
+	Tasks        []WorklogTotalItem `json:"tasks"`
+}
+
+type SLAPolicyItem struct {
+	Priority              string    `json:"priority"`
+	AssignWithinMinutes   *int      `json:"assign_within_minutes,omitempty"`
+	CompleteWithinMinutes *int      `json:"complete_within_minutes,omitempty"`
+	UpdatedBy             *string   `json:"updated_by,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+type SLAComplianceItem struct {
+	Priority             string  `json:"priority"`
+	Measured             int     `json:"measured"`
+	AssignmentBreaches   int     `json:"assignment_breaches"`
+	CompletionBreaches   int     `json:"completion_breaches"`
+	AssignmentCompliance float64 `json:"assignment_compliance"`
+	CompletionCompliance float64 `json:"completion_compliance"`
+}
+
+type SLAComplianceResponse struct {
+	ProjectID  string              `json:"project_id"`
+	From       string              `json:"from"`
+	To         string              `json:"to"`
+	Priorities []SLAComplianceItem `json:"priorities"`
+}
+
+type EscalationLevelItem struct {
+	Level             int       `json:"level"`
+	AfterReminders    *int      `json:"after_reminders,omitempty"`
+	AfterHoursOverdue *int      `json:"after_hours_overdue,omitempty"`
+	Action            string    `json:"action"`
+	UpdatedBy         *string   `json:"updated_by,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type FlaggedTaskItem struct {
+	AufgabenID           string     `json:"aufgaben_id"`
+	Title                string     `json:"title"`
+	Status               string     `json:"status"`
+	Priority             string     `json:"priority"`
+	AssigneeID           *string    `json:"assignee_id,omitempty"`
+	DueDate              *time.Time `json:"due_date,omitempty"`
+	EscalationLevel      int        `json:"escalation_level"`
+	FlaggedForUnassignAt time.Time  `json:"flagged_for_unassign_at"`
+}
+
+type BoardTaskItem struct {
+	AufgabenID string     `json:"aufgaben_id"`
+	Title      string     `json:"title"`
+	Priority   string     `json:"priority"`
+	AssigneeID *string    `json:"assignee_id,omitempty"`
+	DueDate    *time.Time `json:"due_date,omitempty"`
+	Rank       string     `json:"rank"`
+}
+
+type BoardColumnItem struct {
+	Key      string          `json:"key"`
+	Name     string          `json:"name"`
+	Category string          `json:"category"`
+	Position int             `json:"position"`
+	WIPLimit *int            `json:"wip_limit,omitempty"`
+	Count    int             `json:"count"`
+	Tasks    []BoardTaskItem `json:"tasks"`
+}
+
+type BoardResponse struct {
+	ProjectID string            `json:"project_id"`
+	Columns   []BoardColumnItem `json:"columns"`
+}
+
+type MoveBoardTaskResponse struct {
+	AufgabenID     string `json:"aufgaben_id"`
+	Status         string `json:"status"`
+	WorkflowStatus string `json:"workflow_status"`
+	PreviousStatus string `json:"previous_status"`
+	Position       int    `json:"position"`
+	Rank           string `json:"rank"`
+}
+
+type BoardColumnLimitItem struct {
+	StatusKey string    `json:"status_key"`
+	WIPLimit  int       `json:"wip_limit"`
+	UpdatedBy *string   `json:"updated_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type MilestoneItem struct {
+	MilestoneID string     `json:"milestone_id"`
+	ProjectID   string     `json:"project_id"`
+	Name        string     `json:"name"`
+	Goal        *string    `json:"goal,omitempty"`
+	StartDate   string     `json:"start_date"`
+	EndDate     string     `json:"end_date"`
+	TotalTasks  int        `json:"total_tasks"`
+	DoneTasks   int        `json:"done_tasks"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+	ClosedBy    *string    `json:"closed_by,omitempty"`
+	CreatedBy   string     `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+type TaskMilestoneResponse struct {
+	AufgabenID  string    `json:"aufgaben_id"`
+	MilestoneID *string   `json:"milestone_id"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CloseMilestoneResponse struct {
+	MilestoneID      string    `json:"milestone_id"`
+	ClosedAt         time.Time `json:"closed_at"`
+	CarriedOverTo    *string   `json:"carried_over_to"`
+	CarriedOverTasks []string  `json:"carried_over_tasks"`
+}
+
+// BurndownDayItem holds the actual values up to today, later days only carry the ideal line
+type BurndownDayItem struct {
+	Date                 string   `json:"date"`
+	ScopeTasks           *int     `json:"scope_tasks"`
+	DoneTasks            *int     `json:"done_tasks"`
+	RemainingTasks       *int     `json:"remaining_tasks"`
+	ScopePoints          *float64 `json:"scope_points"`
+	DonePoints           *float64 `json:"done_points"`
+	RemainingPoints      *float64 `json:"remaining_points"`
+	ScopeHours           *float64 `json:"scope_hours"`
+	DoneHours            *float64 `json:"done_hours"`
+	RemainingHours       *float64 `json:"remaining_hours"`
+	IdealRemainingTasks  float64  `json:"ideal_remaining_tasks"`
+	IdealRemainingPoints float64  `json:"ideal_remaining_points"`
+	IdealRemainingHours  float64  `json:"ideal_remaining_hours"`
+}
+
+type MilestoneBurndownResponse struct {
+	MilestoneID string            `json:"milestone_id"`
+	Name        string            `json:"name"`
+	StartDate   string            `json:"start_date"`
+	EndDate     string            `json:"end_date"`
+	ClosedAt    *time.Time        `json:"closed_at,omitempty"`
+	Days        []BurndownDayItem `json:"days"`
+}
+
+// CalendarFeedItem carries the feed URL only right after it was issued, the token is never stored in plain
+type CalendarFeedItem struct {
+	FeedID      string     `json:"feed_id"`
+	Scope       string     `json:"scope"`
+	ProjectID   *string    `json:"project_id,omitempty"`
+	ProjectName *string    `json:"project_name,omitempty"`
+	URL         *string    `json:"url,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+}
+
+type AttachmentItem struct {
+	AttachmentID string    `json:"attachment_id"`
+	AufgabenID   string    `json:"aufgaben_id"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	UploadedBy   string    `json:"uploaded_by"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type HandoverRequestItem struct {
+	RequestID        string     `json:"request_id"`
+	AufgabenID       string     `json:"aufgaben_id"`
+	AufgabeTitle     string     `json:"aufgabe_title,omitempty"`
+	RequestedBy      string     `json:"requested_by"`
+	TargetAssigneeID string     `json:"target_assignee_id"`
+	Note             string     `json:"note"`
+	Status           string     `json:"status"`
+	DecidedBy        *string    `json:"decided_by,omitempty"`
+	DecisionReason   *string    `json:"decision_reason,omitempty"`
+	DecidedAt        *time.Time `json:"decided_at,omitempty"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type WatcherItem struct {
+	AufgabenID    string    `json:"aufgaben_id"`
+	UserID        string    `json:"user_id"`
+	Username      string    `json:"username,omitempty"`
+	WatchingSince time.Time `json:"watching_since"`
+}
+
+type TaskAssigneeItem struct {
+	AufgabenID string    `json:"aufgaben_id"`
+	UserID     string    `json:"user_id"`
+	Username   string    `json:"username,omitempty"`
+	Role       string    `json:"role"`
+	AddedBy    *string   `json:"added_by,omitempty"`
+	AddedAt    time.Time `json:"added_at"`
+}
+
+type TemplateItem struct {
+	TemplateID       string     `json:"template_id"`
+	ProjectID        string     `json:"project_id"`
+	Name             string     `json:"name"`
+	Title            string     `json:"title"`
+	Description      *string    `json:"description,omitempty"`
+	Priority         string     `json:"priority"`
+	DueOffsetMinutes *int       `json:"due_offset_minutes,omitempty"`
+	Estimate         *float64   `json:"estimate,omitempty"`
+	EstimateUnit     *string    `json:"estimate_unit,omitempty"`
+	Variables        []string   `json:"variables"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+type RecurrenceItem struct {
+	RecurrenceID      string     `json:"recurrence_id"`
+	ProjectID         string     `json:"project_id"`
+	Title             string     `json:"title"`
+	Description       *string    `json:"description,omitempty"`
+	Priority          string     `json:"priority"`
+	DefaultAssigneeID *string    `json:"default_assignee_id,omitempty"`
+	DueOffsetMinutes  *int       `json:"due_offset_minutes,omitempty"`
+	CronExpr          string     `json:"cron_expr"`
+	NextRunAt         time.Time  `json:"next_run_at"`
+	LastRunAt         *time.Time `json:"last_run_at,omitempty"`
+	Active            bool       `json:"active"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+type OverdueSummaryResponse struct {
+	ProjectID   string                    `json:"project_id"`
+	Total       int                       `json:"total"`
+	GeneratedAt time.Time                 `json:"generated_at"`
+	ByPriority  []OverdueCountItem        `json:"by_priority"`
+	ByAge       []OverdueCountItem        `json:"by_age"`
+	ByAssignee  []OverdueAssigneeItem     `json:"by_assignee"`
+	Oldest      []*OverdueSummaryTaskItem `json:"oldest"`
+}
+
+type OverdueCountItem struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+type OverdueAssigneeItem struct {
+	AssigneeID   *string `json:"assignee_id"`
+	Count        int     `json:"count"`
+	OverdueSince int64   `json:"overdue_since"`
+}
+
+type OverdueSummaryTaskItem struct {
+	AufgabenID      string    `json:"aufgaben_id"`
+	Title           string    `json:"title"`
+	Status          string    `json:"status"`
+	Priority        string    `json:"priority"`
+	AssigneeID      *string   `json:"assignee_id,omitempty"`
+	DueDate         time.Time `json:"due_date"`
+	OverdueSince    int64     `json:"overdue_since"`
+	EscalationLevel int       `json:"escalation_level"`
+}
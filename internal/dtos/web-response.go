@@ -9,11 +9,13 @@ type WebResponse[T any] struct {
 	Errors    *ErrorResponse `json:"errors,omitempty"`
 }
 
+// PaginationMeta leaves out the page once a keyset cursor pages the list, total and total pages still count the whole filtered list.
 type PaginationMeta struct {
-	Page       int `json:"page"`
-	Limit      int `json:"limit"`
-	Total      int `json:"total"`
-	TotalPages int `json:"total_pages"`
+	Page       int     `json:"page,omitempty"`
+	Limit      int     `json:"limit"`
+	Total      int     `json:"total"`
+	TotalPages int     `json:"total_pages"`
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 type CursorPaginationMeta struct {
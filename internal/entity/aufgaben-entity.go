@@ -3,30 +3,277 @@ package entity
 import "time"
 
 type AufgabenEntity struct {
-	ID          string           `json:"id"`
-	ProjectID   string           `json:"project_id"`
-	ProjectName *string          `json:"project_name,omitempty"`
-	Title       string           `json:"title"`
-	Description *string          `json:"Description,omitempty"`
-	Status      AufgabenStatus   `json:"status"`
-	Priority    AufgabenPriority `json:"priority"`
-	AssigneeID  *string          `json:"assignee_id,omitempty"`
-	CreatedBy   string           `json:"created_by"`
-	DueDate     *time.Time       `json:"due_date,omitempty"`
-	CreatedAt   time.Time        `json:"created_at"`
-	UpdatedAt   *time.Time       `json:"updated_at,omitempty"`
-	ArchivedAt  *time.Time       `json:"archived_at,omitempty"`
-	CompletedAt *time.Time       `json:"completed_at,omitempty"`
+	ID             string           `json:"id"`
+	ProjectID      string           `json:"project_id"`
+	ProjectName    *string          `json:"project_name,omitempty"`
+	ParentID       *string          `json:"parent_id,omitempty"`
+	Title          string           `json:"title"`
+	Description    *string          `json:"Description,omitempty"`
+	Status         AufgabenStatus   `json:"status"`
+	Priority       AufgabenPriority `json:"priority"`
+	AssigneeID     *string          `json:"assignee_id,omitempty"`
+	CreatedBy      string           `json:"created_by"`
+	DueDate        *time.Time       `json:"due_date,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+	ArchivedAt     *time.Time       `json:"archived_at,omitempty"`
+	CompletedAt    *time.Time       `json:"completed_at,omitempty"`
+	WorkflowStatus *string          `json:"workflow_status,omitempty"`
+	Estimate       *float64         `json:"estimate,omitempty"`
+	EstimateUnit   *EstimateUnit    `json:"estimate_unit,omitempty"`
+	RecurrenceID   *string          `json:"recurrence_id,omitempty"`
+	OccurrenceAt   *time.Time       `json:"occurrence_at,omitempty"`
+	MilestoneID    *string          `json:"milestone_id,omitempty"`
+
+	EscalationLevel      int        `json:"escalation_level"`
+	FlaggedForUnassignAt *time.Time `json:"flagged_for_unassign_at,omitempty"`
+}
+
+// RecurrenceEntity is the template of a repeating task, the worker creates one task per occurrence
+type RecurrenceEntity struct {
+	ID                string           `json:"id"`
+	ProjectID         string           `json:"project_id"`
+	Title             string           `json:"title"`
+	Description       *string          `json:"description,omitempty"`
+	Priority          AufgabenPriority `json:"priority"`
+	DefaultAssigneeID *string          `json:"default_assignee_id,omitempty"`
+	DueOffsetMinutes  *int             `json:"due_offset_minutes,omitempty"`
+	CronExpr          string           `json:"cron_expr"`
+	NextRunAt         time.Time        `json:"next_run_at"`
+	LastRunAt         *time.Time       `json:"last_run_at,omitempty"`
+	Active            bool             `json:"active"`
+	CreatedBy         string           `json:"created_by"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         *time.Time       `json:"updated_at,omitempty"`
+}
+
+// TemplateEntity holds the saved defaults of a task, title and description may contain {{variable}} placeholders
+type TemplateEntity struct {
+	ID               string           `json:"id"`
+	ProjectID        string           `json:"project_id"`
+	Name             string           `json:"name"`
+	Title            string           `json:"title"`
+	Description      *string          `json:"description,omitempty"`
+	Priority         AufgabenPriority `json:"priority"`
+	DueOffsetMinutes *int             `json:"due_offset_minutes,omitempty"`
+	Estimate         *float64         `json:"estimate,omitempty"`
+	EstimateUnit     *EstimateUnit    `json:"estimate_unit,omitempty"`
+	CreatedBy        string           `json:"created_by"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty"`
+}
+
+type EstimateUnit string
+
+const (
+	EstimatePoints EstimateUnit = "Points"
+	EstimateHours  EstimateUnit = "Hours"
+)
+
+// VelocityWeek sums planned (by due_date) and completed (by completed_at) estimates of one week
+type VelocityWeek struct {
+	WeekStart       time.Time `json:"week_start"`
+	PlannedPoints   float64   `json:"planned_points"`
+	PlannedHours    float64   `json:"planned_hours"`
+	PlannedTasks    int       `json:"planned_tasks"`
+	CompletedPoints float64   `json:"completed_points"`
+	CompletedHours  float64   `json:"completed_hours"`
+	CompletedTasks  int       `json:"completed_tasks"`
+}
+
+type SubtaskProgress struct {
+	Total int `json:"total"`
+	Done  int `json:"done"`
+}
+
+type AufgabenDependency struct {
+	AufgabenID  string    `json:"aufgaben_id"`
+	BlockedByID string    `json:"blocked_by_id"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type DependencyLink struct {
+	AufgabenID string         `json:"aufgaben_id"`
+	Title      string         `json:"title"`
+	Status     AufgabenStatus `json:"status"`
+	AssigneeID *string        `json:"assignee_id,omitempty"`
+	ArchivedAt *time.Time     `json:"archived_at,omitempty"`
+	CreatedBy  string         `json:"created_by"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type UnblockedAufgaben struct {
+	ID          string  `json:"id"`
+	ProjectID   string  `json:"project_id"`
+	ProjectName string  `json:"project_name"`
+	Title       string  `json:"title"`
+	AssigneeID  *string `json:"assignee_id,omitempty"`
+}
+
+type AufgabenCommentEntity struct {
+	ID         string     `json:"id"`
+	AufgabenID string     `json:"aufgaben_id"`
+	AuthorID   string     `json:"author_id"`
+	Body       string     `json:"body"`
+	CreatedAt  time.Time  `json:"created_at"`
+	EditedAt   *time.Time `json:"edited_at,omitempty"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+type LabelEntity struct {
+	ID        string     `json:"id"`
+	ProjectID string     `json:"project_id"`
+	Name      string     `json:"name"`
+	Color     *string    `json:"color,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type AufgabenSearchResult struct {
+	ID             string           `json:"id"`
+	ProjectID      string           `json:"project_id"`
+	ProjectName    string           `json:"project_name"`
+	Title          string           `json:"title"`
+	Status         AufgabenStatus   `json:"status"`
+	Priority       AufgabenPriority `json:"priority"`
+	AssigneeID     *string          `json:"assignee_id,omitempty"`
+	DueDate        *time.Time       `json:"due_date,omitempty"`
+	Rank           float32          `json:"rank"`
+	Snippet        string           `json:"snippet"`
+	CommentSnippet *string          `json:"comment_snippet,omitempty"`
+	Total          int64            `json:"total"`
+}
+
+type WorkflowStatusEntity struct {
+	Key      string         `json:"key"`
+	Name     string         `json:"name"`
+	Category AufgabenStatus `json:"category"`
+	Position int            `json:"position"`
+}
+
+type WorkflowTransitionEntity struct {
+	FromKey      string     `json:"from_key"`
+	ToKey        string     `json:"to_key"`
+	AllowedRoles []UserRole `json:"allowed_roles,omitempty"`
+}
+
+type ProjectWorkflow struct {
+	ProjectID   string                     `json:"project_id"`
+	Statuses    []WorkflowStatusEntity     `json:"statuses"`
+	Transitions []WorkflowTransitionEntity `json:"transitions"`
+	IsDefault   bool                       `json:"is_default"`
+}
+
+// BoardTaskEntity is a task as placed on the project board
+type BoardTaskEntity struct {
+	ID             string           `json:"id"`
+	Title          string           `json:"title"`
+	Status         AufgabenStatus   `json:"status"`
+	Priority       AufgabenPriority `json:"priority"`
+	AssigneeID     *string          `json:"assignee_id,omitempty"`
+	DueDate        *time.Time       `json:"due_date,omitempty"`
+	WorkflowStatus *string          `json:"workflow_status,omitempty"`
+	BoardRank      string           `json:"board_rank"`
+}
+
+// BoardColumnEntity holds the WIP limit of one board column, the column itself is a workflow status
+type BoardColumnEntity struct {
+	ProjectID string    `json:"project_id"`
+	StatusKey string    `json:"status_key"`
+	WIPLimit  int       `json:"wip_limit"`
+	UpdatedBy *string   `json:"updated_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MilestoneEntity is a time boxed iteration of a project, task counts are taken from the current assignment
+type MilestoneEntity struct {
+	ID         string     `json:"id"`
+	ProjectID  string     `json:"project_id"`
+	Name       string     `json:"name"`
+	Goal       *string    `json:"goal,omitempty"`
+	StartDate  time.Time  `json:"start_date"`
+	EndDate    time.Time  `json:"end_date"`
+	ClosedAt   *time.Time `json:"closed_at,omitempty"`
+	ClosedBy   *string    `json:"closed_by,omitempty"`
+	CreatedBy  string     `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	TotalTasks int        `json:"total_tasks"`
+	DoneTasks  int        `json:"done_tasks"`
+}
+
+// BurndownDay is the scope and the completed work of a milestone at the end of one day
+type BurndownDay struct {
+	Day         time.Time `json:"day"`
+	ScopeTasks  int       `json:"scope_tasks"`
+	ScopePoints float64   `json:"scope_points"`
+	ScopeHours  float64   `json:"scope_hours"`
+	DoneTasks   int       `json:"done_tasks"`
+	DonePoints  float64   `json:"done_points"`
+	DoneHours   float64   `json:"done_hours"`
+}
+
+// CalendarFeedEntity is a token secured .ics feed, ProjectID is nil for the personal feed of the user
+type CalendarFeedEntity struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	ProjectID   *string    `json:"project_id,omitempty"`
+	ProjectName *string    `json:"project_name,omitempty"`
+	TokenHash   string     `json:"token_hash"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+type WorklogEntity struct {
+	ID         string        `json:"id"`
+	AufgabenID string        `json:"aufgaben_id"`
+	UserID     string        `json:"user_id"`
+	Source     WorklogSource `json:"source"`
+	StartedAt  time.Time     `json:"started_at"`
+	EndedAt    *time.Time    `json:"ended_at,omitempty"`
+	Note       *string       `json:"note,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  *time.Time    `json:"updated_at,omitempty"`
+}
+
+type WorklogSource string
+
+const (
+	WorklogTimer  WorklogSource = "Timer"
+	WorklogManual WorklogSource = "Manual"
+)
+
+// WorklogTotal sums finished worklogs, grouped either by member or by task
+type WorklogTotal struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	TotalMinutes int    `json:"total_minutes"`
+}
+
+type MentionedUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type CommentMention struct {
+	CommentID string `json:"comment_id"`
+	MentionedUser
 }
 
 type AssignedAufgaben struct {
 	ID          string           `json:"id"`
+	ProjectID   string           `json:"project_id"`
 	ProjectName string           `json:"project_name"`
 	Title       string           `json:"title"`
 	Description *string          `json:"description,omitempty"`
 	Status      AufgabenStatus   `json:"status"`
 	Priority    AufgabenPriority `json:"priority"`
 	DueDate     time.Time        `json:"due_date"`
+	Role        AssigneeRole     `json:"role"`
 }
 
 type ReminderAufgaben struct {
@@ -51,6 +298,9 @@ type AssignmentEventEntity struct {
 	Note             *string          `json:"note,omitempty"`
 	ReasonCode       *ReasonCodeEvent `json:"reason_code,omitempty"`
 	ReasonText       *string          `json:"reason_text,omitempty"`
+	FieldName        *string          `json:"field_name,omitempty"`
+	OldValue         *string          `json:"old_value,omitempty"`
+	NewValue         *string          `json:"new_value,omitempty"`
 	CreatedAt        time.Time        `json:"created_at"`
 }
 
@@ -88,19 +338,212 @@ type AddAssignment struct {
 	ReasonCode       ReasonCodeEvent `json:"reason_code,omitempty"`
 	TaskArchivedAt   *time.Time      `json:"task_archived_at,omitempty"`
 	ArchivedBy       *string         `json:"archived_by,omitempty"`
+	FieldName        *string         `json:"field_name,omitempty"`
+	OldValue         *string         `json:"old_value,omitempty"`
+	NewValue         *string         `json:"new_value,omitempty"`
+}
+
+// AttachmentEntity is the metadata of a file attached to a task, the content lives in the blob storage
+type AttachmentEntity struct {
+	ID          string    `json:"id"`
+	AufgabenID  string    `json:"aufgaben_id"`
+	ProjectID   string    `json:"project_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	StorageKey  string    `json:"storage_key"`
+	UploadedBy  string    `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AttachmentQuota is the attachment budget of a project and how much of it is used
+type AttachmentQuota struct {
+	QuotaBytes int64 `json:"quota_bytes"`
+	UsedBytes  int64 `json:"used_bytes"`
+}
+
+// WatcherEntity is a user subscribed to the changes of a task
+type WatcherEntity struct {
+	AufgabenID string    `json:"aufgaben_id"`
+	UserID     string    `json:"user_id"`
+	Username   string    `json:"username"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WatcherContact is a watcher that can still be notified, i.e. an active member of the task's project
+type WatcherContact struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// HandoverRequestEntity is a Mitarbeiter's request to hand their task over, decided by the Meister
+type HandoverRequestEntity struct {
+	ID               string                `json:"id"`
+	AufgabenID       string                `json:"aufgaben_id"`
+	ProjectID        string                `json:"project_id"`
+	RequestedBy      string                `json:"requested_by"`
+	TargetAssigneeID string                `json:"target_assignee_id"`
+	Note             string                `json:"note"`
+	Status           HandoverRequestStatus `json:"status"`
+	DecidedBy        *string               `json:"decided_by,omitempty"`
+	DecisionReason   *string               `json:"decision_reason,omitempty"`
+	DecidedAt        *time.Time            `json:"decided_at,omitempty"`
+	ExpiresAt        time.Time             `json:"expires_at"`
+	CreatedAt        time.Time             `json:"created_at"`
+	AufgabeTitle     string                `json:"aufgabe_title"`
+	ProjectName      string                `json:"project_name"`
+}
+
+// AufgabenAssigneeEntity is a participant of a task with their role on it
+type AufgabenAssigneeEntity struct {
+	AufgabenID string       `json:"aufgaben_id"`
+	UserID     string       `json:"user_id"`
+	Username   string       `json:"username"`
+	Role       AssigneeRole `json:"role"`
+	AddedBy    *string      `json:"added_by,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
 }
 
+type AssigneeRole string
+
+const (
+	AssigneeOwner        AssigneeRole = "Owner"
+	AssigneeCollaborator AssigneeRole = "Collaborator"
+	AssigneeReviewer     AssigneeRole = "Reviewer"
+)
+
+// MemberWorkload is what a project member currently carries, open and overdue count across all their projects
+type MemberWorkload struct {
+	UserID          string   `json:"user_id"`
+	Username        string   `json:"username"`
+	Role            UserRole `json:"role"`
+	OpenAssignments int      `json:"open_assignments"`
+	OverdueCount    int      `json:"overdue_count"`
+	RecentOverloads int      `json:"recent_overloads"`
+	UrgentOpen      int      `json:"urgent_open"`
+	HighOpen        int      `json:"high_open"`
+}
+
+// SLAPolicyEntity are the targets a project sets for one priority, counted in minutes from the creation of the task
+type SLAPolicyEntity struct {
+	ProjectID             string           `json:"project_id"`
+	Priority              AufgabenPriority `json:"priority"`
+	AssignWithinMinutes   *int             `json:"assign_within_minutes,omitempty"`
+	CompleteWithinMinutes *int             `json:"complete_within_minutes,omitempty"`
+	UpdatedBy             *string          `json:"updated_by,omitempty"`
+	CreatedAt             time.Time        `json:"created_at"`
+	UpdatedAt             time.Time        `json:"updated_at"`
+}
+
+// SLABreachEntity is a missed SLA target of a task together with the Meister it is escalated to
+type SLABreachEntity struct {
+	ID           string           `json:"id"`
+	AufgabenID   string           `json:"aufgaben_id"`
+	ProjectID    string           `json:"project_id"`
+	Kind         SLABreachKind    `json:"kind"`
+	Priority     AufgabenPriority `json:"priority"`
+	DeadlineAt   time.Time        `json:"deadline_at"`
+	DetectedAt   time.Time        `json:"detected_at"`
+	AufgabeTitle string           `json:"aufgabe_title"`
+	AssigneeID   *string          `json:"assignee_id,omitempty"`
+	CreatedBy    string           `json:"created_by"`
+	ProjectName  string           `json:"project_name"`
+	MeisterID    string           `json:"meister_id"`
+	MeisterEmail string           `json:"meister_email"`
+}
+
+// EscalationLevelEntity is one step of a project's overdue escalation chain
+type EscalationLevelEntity struct {
+	ProjectID         string           `json:"project_id"`
+	Level             int              `json:"level"`
+	AfterReminders    *int             `json:"after_reminders,omitempty"`
+	AfterHoursOverdue *int             `json:"after_hours_overdue,omitempty"`
+	Action            EscalationAction `json:"action"`
+	UpdatedBy         *string          `json:"updated_by,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+type EscalationAction string
+
+const (
+	EscalationNotifyMeister     EscalationAction = "Notify_Meister"
+	EscalationFlagForceUnassign EscalationAction = "Flag_Force_Unassign"
+)
+
+// OverdueEscalationEntity is an overdue task that just reached a new escalation level
+type OverdueEscalationEntity struct {
+	AufgabenID           string           `json:"aufgaben_id"`
+	AufgabeTitle         string           `json:"aufgabe_title"`
+	Priority             AufgabenPriority `json:"priority"`
+	AssigneeID           *string          `json:"assignee_id,omitempty"`
+	AssigneeName         *string          `json:"assignee_name,omitempty"`
+	CreatedBy            string           `json:"created_by"`
+	DueDate              time.Time        `json:"due_date"`
+	OverdueReminderCount int              `json:"overdue_reminder_count"`
+	PreviousLevel        int              `json:"previous_level"`
+	Level                int              `json:"level"`
+	Action               EscalationAction `json:"action"`
+	ProjectName          string           `json:"project_name"`
+	MeisterID            string           `json:"meister_id"`
+	MeisterEmail         string           `json:"meister_email"`
+}
+
+// SLAComplianceRow is how the tasks of one priority did against the project's SLA policy
+type SLAComplianceRow struct {
+	Priority           AufgabenPriority `json:"priority"`
+	Measured           int              `json:"measured"`
+	AssignmentBreaches int              `json:"assignment_breaches"`
+	CompletionBreaches int              `json:"completion_breaches"`
+}
+
+type SLABreachKind string
+
+const (
+	SLABreachAssignment SLABreachKind = "Assignment"
+	SLABreachCompletion SLABreachKind = "Completion"
+)
+
+type HandoverRequestStatus string
+
+const (
+	HandoverPending  HandoverRequestStatus = "Pending"
+	HandoverApproved HandoverRequestStatus = "Approved"
+	HandoverRejected HandoverRequestStatus = "Rejected"
+	HandoverExpired  HandoverRequestStatus = "Expired"
+)
+
 type ActionEvent string
 
 const (
-	ActionAssign          ActionEvent = "Assign"
-	ActionUnassign        ActionEvent = "Unassign"
-	ActionProgress        ActionEvent = "Progress"
-	ActionComplete        ActionEvent = "Complete"
-	ActionHandoverRequest ActionEvent = "Handover_Request"
-	ActionHandoverExecute ActionEvent = "Handover_Execute"
-	ActionArchive         ActionEvent = "Task_Archived"
-	ActionDueDateUpdate   ActionEvent = "Due_Date_Updated"
+	ActionAssign             ActionEvent = "Assign"
+	ActionUnassign           ActionEvent = "Unassign"
+	ActionProgress           ActionEvent = "Progress"
+	ActionComplete           ActionEvent = "Complete"
+	ActionHandoverRequest    ActionEvent = "Handover_Request"
+	ActionHandoverExecute    ActionEvent = "Handover_Execute"
+	ActionHandoverRejected   ActionEvent = "Handover_Rejected"
+	ActionHandoverExpired    ActionEvent = "Handover_Expired"
+	ActionArchive            ActionEvent = "Task_Archived"
+	ActionDueDateUpdate      ActionEvent = "Due_Date_Updated"
+	ActionSubtaskCreated     ActionEvent = "Subtask_Created"
+	ActionSubtaskComplete    ActionEvent = "Subtask_Completed"
+	ActionDependencyAdded    ActionEvent = "Dependency_Added"
+	ActionDependencyRemoved  ActionEvent = "Dependency_Removed"
+	ActionLabelAdded         ActionEvent = "Label_Added"
+	ActionLabelRemoved       ActionEvent = "Label_Removed"
+	ActionTaskUpdated        ActionEvent = "Task_Updated"
+	ActionTaskUnarchived     ActionEvent = "Task_Unarchived"
+	ActionTaskReopened       ActionEvent = "Task_Reopened"
+	ActionStatusChanged      ActionEvent = "Status_Changed"
+	ActionAttachmentAdded    ActionEvent = "Attachment_Added"
+	ActionAttachmentRemoved  ActionEvent = "Attachment_Removed"
+	ActionParticipantAdded   ActionEvent = "Participant_Added"
+	ActionParticipantRemoved ActionEvent = "Participant_Removed"
+	ActionSLABreached        ActionEvent = "SLA_Breached"
+	ActionEscalated          ActionEvent = "Escalated"
+	ActionMilestoneChanged   ActionEvent = "Milestone_Changed"
 )
 
 type ReasonCodeEvent string
@@ -110,6 +553,11 @@ const (
 	ReasonBlocked  ReasonCodeEvent = "Blocked"
 	ReasonSick     ReasonCodeEvent = "Sick"
 	ReasonOther    ReasonCodeEvent = "Other"
+
+	// Restore reasons, used when unarchiving or reopening a task
+	ReasonMistake      ReasonCodeEvent = "Mistake"
+	ReasonScopeChanged ReasonCodeEvent = "Scope_Changed"
+	ReasonDefectFound  ReasonCodeEvent = "Defect_Found"
 )
 
 type AufgabenStatus string
@@ -1,8 +1,10 @@
 package aufgaben_handlers
 
 import (
+	"mime"
 	"strings"
 
+	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/storage"
 	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	"github.com/Xenn-00/aufgaben-meister/internal/handlers"
@@ -20,15 +22,18 @@ type AufgabenHandler struct {
 	i18n      *internal_i18n.I18nService
 }
 
-func NewAufgabenHandler(db *pgxpool.Pool, redis *redis.Client, i18n *internal_i18n.I18nService) *AufgabenHandler {
+func NewAufgabenHandler(db *pgxpool.Pool, redis *redis.Client, i18n *internal_i18n.I18nService, blobStorage storage.BlobStorage) *AufgabenHandler {
 	validate := validator.New()
 	validate.RegisterValidation("aufgabenPriority", aufgaben_dto.IsValidAufgabenPriority)
 	validate.RegisterValidation("aufgabenStatus", aufgaben_dto.IsValidAufgabenStatus)
 	validate.RegisterValidation("reasonCode", aufgaben_dto.IsValidReasonCode)
+	validate.RegisterValidation("restoreReasonCode", aufgaben_dto.IsValidRestoreReasonCode)
 	validate.RegisterValidation("dateInFuture", aufgaben_dto.IsDateInFuture)
+	validate.RegisterValidation("estimateUnit", aufgaben_dto.IsValidEstimateUnit)
+	validate.RegisterValidation("escalationAction", aufgaben_dto.IsValidEscalationAction)
 	return &AufgabenHandler{
 		validator: validate,
-		service:   aufgaben_case.NewAufgabenService(db, redis),
+		service:   aufgaben_case.NewAufgabenService(db, redis, blobStorage),
 		i18n:      i18n,
 	}
 }
@@ -56,6 +61,11 @@ func (h *AufgabenHandler) CreateNewAufgaben(c *fiber.Ctx) error {
 		req.Priority = &s
 	}
 
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
 	if err := h.validator.Struct(req); err != nil {
 		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
 	}
@@ -98,6 +108,10 @@ func (h *AufgabenHandler) ListTasks(c *fiber.Ctx) error {
 		s := handlers.NormalizeStatusCase(*filters.Status)
 		filters.Status = &s
 	}
+	if filters.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*filters.Priority))
+		filters.Priority = &s
+	}
 	if filters.Limit == 0 {
 		filters.Limit = 20
 	} else if filters.Limit > 100 {
@@ -132,6 +146,103 @@ func (h *AufgabenHandler) ListTasks(c *fiber.Ctx) error {
 	return nil
 }
 
+func (h *AufgabenHandler) SearchTasksProject(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project ID from param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	filters, err := h.parseSearchFilter(c)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, paging, err := h.service.SearchTasksProject(c.Context(), userID, projectID, *filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_search_aufgaben", nil), resp, reqID, paging)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) SearchTasks(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	filters, err := h.parseSearchFilter(c)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, paging, err := h.service.SearchTasks(c.Context(), userID, *filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_search_aufgaben", nil), resp, reqID, paging)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+// parseSearchFilter reads, normalizes and validates search query params
+func (h *AufgabenHandler) parseSearchFilter(c *fiber.Ctx) (*aufgaben_dto.AufgabenSearchFilter, *app_errors.AppError) {
+	var filters aufgaben_dto.AufgabenSearchFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	filters.Query = strings.TrimSpace(filters.Query)
+	if filters.Status != nil {
+		s := handlers.NormalizeStatusCase(*filters.Status)
+		filters.Status = &s
+	}
+	if filters.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*filters.Priority))
+		filters.Priority = &s
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 20
+	} else if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	if filters.Page == 0 {
+		filters.Page = 1
+	} else if filters.Page > 100 {
+		filters.Page = 100
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return nil, app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	return &filters, nil
+}
+
 func (h *AufgabenHandler) AssignTask(c *fiber.Ctx) error {
 	userID, err := handlers.GetUserID(c)
 	if err != nil {
@@ -466,6 +577,104 @@ func (h *AufgabenHandler) ArchiveTask(c *fiber.Ctx) error {
 	return nil
 }
 
+func (h *AufgabenHandler) UnarchiveTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.RestoreAufgabenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	// restore reason codes may contain more words, e.g. Scope_Changed
+	if req.ReasonCode != "" {
+		req.ReasonCode = handlers.NormalizeStatusCase(strings.TrimSpace(req.ReasonCode))
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UnarchiveTask(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_unarchive_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ReopenTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.RestoreAufgabenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	// restore reason codes may contain more words, e.g. Scope_Changed
+	if req.ReasonCode != "" {
+		req.ReasonCode = handlers.NormalizeStatusCase(strings.TrimSpace(req.ReasonCode))
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.ReopenTask(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_reopen_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
 func (h *AufgabenHandler) UpdateDueDate(c *fiber.Ctx) error {
 	userID, err := handlers.GetUserID(c)
 	if err != nil {
@@ -510,7 +719,7 @@ func (h *AufgabenHandler) UpdateDueDate(c *fiber.Ctx) error {
 	return nil
 }
 
-func (h *AufgabenHandler) FetchEventsForTask(c *fiber.Ctx) error {
+func (h *AufgabenHandler) UpdateEstimate(c *fiber.Ctx) error {
 	userID, err := handlers.GetUserID(c)
 	if err != nil {
 		return err
@@ -528,25 +737,30 @@ func (h *AufgabenHandler) FetchEventsForTask(c *fiber.Ctx) error {
 		return err
 	}
 
-	// get query filter
-	var filters aufgaben_dto.AufgabenEventFilter
-	if err := c.QueryParser(&filters); err != nil {
-		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	// get req body
+	var req *aufgaben_dto.UpdateEstimateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
 	}
 
-	if err := h.validator.Struct(filters); err != nil {
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
 		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
 	}
 
 	// call service
-	events, cursor, err := h.service.FetchEventsForTask(c.Context(), userID, projectID, taskID, &filters)
+	resp, err := h.service.UpdateEstimate(c.Context(), userID, projectID, taskID, req)
 	if err != nil {
 		return err
 	}
 
 	reqID := handlers.GetRequestID(c)
 	lang, _ := c.Locals("lang").(string)
-	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_fetch_events", nil), events, reqID, cursor)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_estimate", nil), resp, reqID)
 	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
 		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
 	}
@@ -554,7 +768,7 @@ func (h *AufgabenHandler) FetchEventsForTask(c *fiber.Ctx) error {
 	return nil
 }
 
-func (h *AufgabenHandler) ForceAufgabeHandover(c *fiber.Ctx) error {
+func (h *AufgabenHandler) UpdateTask(c *fiber.Ctx) error {
 	userID, err := handlers.GetUserID(c)
 	if err != nil {
 		return err
@@ -573,27 +787,3010 @@ func (h *AufgabenHandler) ForceAufgabeHandover(c *fiber.Ctx) error {
 	}
 
 	// get req body
-	var req *aufgaben_dto.ForceAufgabeHandoverRequest
+	var req *aufgaben_dto.UpdateAufgabenRequest
 	if err := c.BodyParser(&req); err != nil {
 		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
 	}
 
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
 	if err := h.validator.Struct(req); err != nil {
 		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
 	}
 
 	// call service
-	resp, err := h.service.ForceAufgabeHandover(c.Context(), userID, projectID, taskID, req)
+	resp, err := h.service.UpdateTask(c.Context(), userID, projectID, taskID, req)
 	if err != nil {
 		return err
 	}
 
 	reqID := handlers.GetRequestID(c)
 	lang, _ := c.Locals("lang").(string)
-	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_force_handover", nil), resp, reqID)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_aufgabe", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) FetchEventsForTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.AufgabenEventFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	events, cursor, err := h.service.FetchEventsForTask(c.Context(), userID, projectID, taskID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_fetch_events", nil), events, reqID, cursor)
 	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
 		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
 	}
 
 	return nil
 }
+
+func (h *AufgabenHandler) ForceAufgabeHandover(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.ForceAufgabeHandoverRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.ForceAufgabeHandover(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_force_handover", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateSubtask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateNewAufgabenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateSubtask(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_subtask", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListSubtasks(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListSubtasks(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_subtasks", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) AddDependency(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.AddDependencyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.AddDependency(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_add_dependency", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListDependencies(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListDependencies(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_dependencies", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) RemoveDependency(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get blocker id param
+	blockerID, err := handlers.GetParamBlockerID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.RemoveDependency(c.Context(), userID, projectID, taskID, blockerID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_remove_dependency", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateComment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateComment(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_comment", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListComments(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.AufgabenCommentFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	comments, cursor, err := h.service.ListComments(c.Context(), userID, projectID, taskID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_comments", nil), comments, reqID, cursor)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) EditComment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get comment id param
+	commentID, err := handlers.GetParamCommentID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.EditComment(c.Context(), userID, projectID, taskID, commentID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_edit_comment", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteComment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get comment id param
+	commentID, err := handlers.GetParamCommentID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteComment(c.Context(), userID, projectID, taskID, commentID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_comment", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateLabel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateLabelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateLabel(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_label", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListLabels(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListLabels(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_labels", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateLabel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get label id param
+	labelID, err := handlers.GetParamLabelID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateLabelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateLabel(c.Context(), userID, projectID, labelID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_label", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteLabel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get label id param
+	labelID, err := handlers.GetParamLabelID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteLabel(c.Context(), userID, projectID, labelID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_label", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) AttachLabel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get label id param
+	labelID, err := handlers.GetParamLabelID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.AttachLabel(c.Context(), userID, projectID, taskID, labelID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_attach_label", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DetachLabel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get label id param
+	labelID, err := handlers.GetParamLabelID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DetachLabel(c.Context(), userID, projectID, taskID, labelID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_detach_label", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetWorkflow(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.GetWorkflow(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_get_workflow", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateWorkflow(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateWorkflowRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	for i := range req.Statuses {
+		req.Statuses[i].Category = handlers.NormalizeStatusCase(strings.TrimSpace(req.Statuses[i].Category))
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateWorkflow(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_workflow", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) TransitionTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.TransitionTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.TransitionTask(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_transition_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) StartTimer(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// req body is optional, a timer may start without a note
+	req := &aufgaben_dto.StartTimerRequest{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+		}
+
+		if err := h.validator.Struct(req); err != nil {
+			return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+		}
+	}
+
+	// call service
+	resp, err := h.service.StartTimer(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_start_timer", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) StopTimer(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.StopTimer(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_stop_timer", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateWorklog(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateWorklogRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateWorklog(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_worklog", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListWorklogs(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.AufgabenWorklogFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	worklogs, cursor, err := h.service.ListWorklogs(c.Context(), userID, projectID, taskID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_worklogs", nil), worklogs, reqID, cursor)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateWorklog(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get worklog id param
+	worklogID, err := handlers.GetParamWorklogID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateWorklogRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateWorklog(c.Context(), userID, projectID, taskID, worklogID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_worklog", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteWorklog(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get worklog id param
+	worklogID, err := handlers.GetParamWorklogID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteWorklog(c.Context(), userID, projectID, taskID, worklogID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_worklog", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetTaskTimeSummary(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.GetTaskTimeSummary(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_task_time_summary", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetTimeReport(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.TimeReportFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.GetTimeReport(c.Context(), userID, projectID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_time_report", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetVelocity(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.VelocityFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.GetVelocity(c.Context(), userID, projectID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_velocity", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateRecurrence(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateRecurrenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateRecurrence(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_recurrence", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListRecurrences(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListRecurrences(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_recurrences", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateRecurrence(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get recurrence id param
+	recurrenceID, err := handlers.GetParamRecurrenceID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateRecurrenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateRecurrence(c.Context(), userID, projectID, recurrenceID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_recurrence", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteRecurrence(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get recurrence id param
+	recurrenceID, err := handlers.GetParamRecurrenceID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteRecurrence(c.Context(), userID, projectID, recurrenceID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_recurrence", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateTemplate(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.SaveTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateTemplate(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_template", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListTemplates(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListTemplates(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_templates", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateTemplate(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get template id param
+	templateID, err := handlers.GetParamTemplateID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.SaveTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateTemplate(c.Context(), userID, projectID, templateID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_template", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteTemplate(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get template id param
+	templateID, err := handlers.GetParamTemplateID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteTemplate(c.Context(), userID, projectID, templateID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_template", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateAufgabenFromTemplate(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get template id param
+	templateID, err := handlers.GetParamTemplateID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateFromTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if req.Priority != nil {
+		s := strings.Title(strings.TrimSpace(*req.Priority))
+		req.Priority = &s
+	}
+
+	if req.EstimateUnit != nil {
+		s := strings.Title(strings.TrimSpace(*req.EstimateUnit))
+		req.EstimateUnit = &s
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateAufgabenFromTemplate(c.Context(), userID, projectID, templateID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_insert_new_aufgabe", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UploadAttachment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get uploaded file
+	fileHeader, formErr := c.FormFile("file")
+	if formErr != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", formErr)
+	}
+
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", openErr)
+	}
+	defer file.Close()
+
+	upload := &aufgaben_dto.AttachmentUpload{
+		FileName:    fileHeader.Filename,
+		ContentType: fileHeader.Header.Get(fiber.HeaderContentType),
+		Size:        fileHeader.Size,
+		Content:     file,
+	}
+
+	// call service
+	resp, err := h.service.UploadAttachment(c.Context(), userID, projectID, taskID, upload)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_upload_attachment", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListAttachments(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListAttachments(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_attachments", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DownloadAttachment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get attachment id param
+	attachmentID, err := handlers.GetParamAttachmentID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	attachment, content, err := h.service.DownloadAttachment(c.Context(), userID, projectID, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	// Always served as download, the browser must not render uploaded content inline
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	if err := c.Status(fiber.StatusOK).SendStream(content, int(attachment.SizeBytes)); err != nil {
+		content.Close()
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteAttachment(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get attachment id param
+	attachmentID, err := handlers.GetParamAttachmentID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteAttachment(c.Context(), userID, projectID, taskID, attachmentID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_attachment", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) WatchTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.WatchTask(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_watch_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UnwatchTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.UnwatchTask(c.Context(), userID, projectID, taskID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_unwatch_task", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListWatchers(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListWatchers(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_watchers", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListHandoverRequests(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListHandoverRequests(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_handover_requests", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ApproveHandoverRequest(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get handover request id param
+	requestID, err := handlers.GetParamHandoverRequestID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.ApproveHandoverRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.ApproveHandoverRequest(c.Context(), userID, projectID, requestID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_approve_handover_request", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) RejectHandoverRequest(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get handover request id param
+	requestID, err := handlers.GetParamHandoverRequestID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.RejectHandoverRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.RejectHandoverRequest(c.Context(), userID, projectID, requestID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_reject_handover_request", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) AddTaskAssignee(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.AddTaskAssigneeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.AddTaskAssignee(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_add_task_assignee", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) RemoveTaskAssignee(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get assignee user id param
+	targetID, err := handlers.GetParamAssigneeUserID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.RemoveTaskAssignee(c.Context(), userID, projectID, taskID, targetID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_remove_task_assignee", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListTaskAssignees(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListTaskAssignees(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_task_assignees", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) PickNextTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// req body is optional, the task's own due date is used when none is given
+	req := &aufgaben_dto.PickNextAufgabeRequest{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+		}
+
+		if err := h.validator.Struct(req); err != nil {
+			return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+		}
+	}
+
+	// call service
+	resp, err := h.service.PickNextTask(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_pick_next_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) SuggestAssignees(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.SuggestAssignees(c.Context(), userID, projectID, taskID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_suggest_assignees", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateWorkloadThreshold(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateWorkloadThresholdRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateWorkloadThreshold(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_workload_threshold", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpsertSLAPolicy(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get priority param
+	priority, err := handlers.GetParamSLAPriority(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpsertSLAPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpsertSLAPolicy(c.Context(), userID, projectID, priority, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_upsert_sla_policy", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListSLAPolicies(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListSLAPolicies(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_sla_policies", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteSLAPolicy(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get priority param
+	priority, err := handlers.GetParamSLAPriority(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteSLAPolicy(c.Context(), userID, projectID, priority); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_sla_policy", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetSLACompliance(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query filter
+	var filters aufgaben_dto.SLAComplianceFilter
+	if err := c.QueryParser(&filters); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(filters); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.GetSLACompliance(c.Context(), userID, projectID, &filters)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_get_sla_compliance", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListEscalationLevels(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListEscalationLevels(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_escalation_levels", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpsertEscalationLevel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get level param
+	level, err := handlers.GetParamEscalationLevel(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpsertEscalationLevelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpsertEscalationLevel(c.Context(), userID, projectID, level, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_upsert_escalation_level", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteEscalationLevel(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get level param
+	level, err := handlers.GetParamEscalationLevel(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteEscalationLevel(c.Context(), userID, projectID, level); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_escalation_level", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListFlaggedTasks(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListFlaggedTasks(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_flagged_tasks", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetOverdueSummary(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.GetOverdueSummary(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_get_overdue_summary", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetBoard(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.GetBoard(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_get_board", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) MoveTask(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.MoveBoardTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.MoveTask(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_move_task", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpsertBoardColumn(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get status key param
+	statusKey, err := handlers.GetParamBoardStatusKey(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpsertBoardColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpsertBoardColumn(c.Context(), userID, projectID, statusKey, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_upsert_board_column", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) DeleteBoardColumn(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get status key param
+	statusKey, err := handlers.GetParamBoardStatusKey(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.DeleteBoardColumn(c.Context(), userID, projectID, statusKey); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_delete_board_column", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateMilestone(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.CreateMilestoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.CreateMilestone(c.Context(), userID, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_milestone", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListMilestones(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListMilestones(c.Context(), userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_milestones", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) UpdateMilestone(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get milestone id param
+	milestoneID, err := handlers.GetParamMilestoneID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.UpdateMilestoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.UpdateMilestone(c.Context(), userID, projectID, milestoneID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_update_milestone", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CloseMilestone(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get milestone id param
+	milestoneID, err := handlers.GetParamMilestoneID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// req body is optional, unfinished tasks go back to the backlog when no milestone is given
+	req := &aufgaben_dto.CloseMilestoneRequest{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+		}
+
+		if err := h.validator.Struct(req); err != nil {
+			return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+		}
+	}
+
+	// call service
+	resp, err := h.service.CloseMilestone(c.Context(), userID, projectID, milestoneID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_close_milestone", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) GetMilestoneBurndown(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get milestone id param
+	milestoneID, err := handlers.GetParamMilestoneID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.GetMilestoneBurndown(c.Context(), userID, projectID, milestoneID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_get_milestone_burndown", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) SetTaskMilestone(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get task id param
+	taskID, err := handlers.GetParamTaskID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get req body
+	var req *aufgaben_dto.SetTaskMilestoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	resp, err := h.service.SetTaskMilestone(c.Context(), userID, projectID, taskID, req)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_set_task_milestone", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateCalendarFeed(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.CreateCalendarFeed(c.Context(), userID, c.BaseURL())
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_calendar_feed", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) CreateProjectCalendarFeed(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get project id param
+	projectID, err := handlers.GetParamProjectID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.CreateProjectCalendarFeed(c.Context(), userID, projectID, c.BaseURL())
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_create_calendar_feed", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) ListCalendarFeeds(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	resp, err := h.service.ListCalendarFeeds(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_list_calendar_feeds", nil), resp, reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+func (h *AufgabenHandler) RevokeCalendarFeed(c *fiber.Ctx) error {
+	userID, err := handlers.GetUserID(c)
+	if err != nil {
+		return err
+	}
+
+	// get feed id param
+	feedID, err := handlers.GetParamCalendarFeedID(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// call service
+	if err := h.service.RevokeCalendarFeed(c.Context(), userID, feedID); err != nil {
+		return err
+	}
+
+	reqID := handlers.GetRequestID(c)
+	lang, _ := c.Locals("lang").(string)
+	webResp := handlers.CreateResponse(h.i18n.T(lang, "response.success_revoke_calendar_feed", nil), "OK", reqID)
+	if err := c.Status(fiber.StatusOK).JSON(webResp); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
+
+// GetCalendarFeed serves the .ics feed to calendar apps, the token in the path is the only credential
+func (h *AufgabenHandler) GetCalendarFeed(c *fiber.Ctx) error {
+	// get feed token param
+	token, err := handlers.GetParamCalendarFeedToken(c, h.validator)
+	if err != nil {
+		return err
+	}
+
+	// get query
+	var query aufgaben_dto.CalendarFeedQuery
+	if err := c.QueryParser(&query); err != nil {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", err)
+	}
+
+	if err := h.validator.Struct(query); err != nil {
+		return app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+
+	// call service
+	feed, err := h.service.GetCalendarFeed(c.Context(), token, c.BaseURL(), &query)
+	if err != nil {
+		return err
+	}
+
+	// The URL is a credential, shared caches must not keep the feed
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": "aufgaben.ics"}))
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	if err := c.Status(fiber.StatusOK).Send(feed); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "response.write_failed", err)
+	}
+
+	return nil
+}
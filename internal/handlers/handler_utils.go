@@ -62,6 +62,42 @@ func GetParamTaskID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.Ap
 	return param.ID, nil
 }
 
+func GetParamBlockerID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamBlockerID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamCommentID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamCommentID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamLabelID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamLabelID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
 func NormalizeStatusCase(s string) string {
 	// Lowercase first
 	s = strings.ToLower(s)
@@ -82,3 +118,147 @@ func NormalizeStatusCase(s string) string {
 
 	return strings.Join(words, "_")
 }
+
+func GetParamWorklogID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamWorklogID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamRecurrenceID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamRecurrenceID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamTemplateID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamTemplateID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamAttachmentID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamAttachmentID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamHandoverRequestID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamHandoverRequestID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamAssigneeUserID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamAssigneeUserID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamEscalationLevel(c *fiber.Ctx, v *validator.Validate) (int, *app_errors.AppError) {
+	var param aufgaben_dto.ParamEscalationLevel
+	if err := c.ParamsParser(&param); err != nil {
+		return 0, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return 0, app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamMilestoneID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamMilestoneID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamCalendarFeedID(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamCalendarFeedID
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamCalendarFeedToken(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamCalendarFeedToken
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return strings.TrimSuffix(param.Token, ".ics"), nil
+}
+
+func GetParamBoardStatusKey(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamBoardStatusKey
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
+
+func GetParamSLAPriority(c *fiber.Ctx, v *validator.Validate) (string, *app_errors.AppError) {
+	var param aufgaben_dto.ParamSLAPriority
+	if err := c.ParamsParser(&param); err != nil {
+		return "", app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidParam, "request.invalid_param", err)
+	}
+
+	if err := v.Struct(param); err != nil {
+		return "", app_errors.NewValidationError(app_errors.ParseValidationError(err))
+	}
+	return param.ID, nil
+}
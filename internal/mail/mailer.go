@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/config"
@@ -19,6 +20,12 @@ type Mailer interface {
 	SendReminderAufgabenProgress(aufgabe *entity.ReminderAufgaben) error
 	SendReminderAufgabenOverdue(aufgabe *entity.ReminderAufgaben) error
 	SendHandoverRequest(aufgabe *worker_task.HandoverRequestNotifyMeister, emailMeister, usernameAssignee string) error
+	SendDependencyUnblocked(aufgabe *worker_task.DependencyUnblockedNotify, emailAssignee string) error
+	SendCommentMention(aufgabe *worker_task.CommentMentionNotify, emailMentioned, usernameAuthor string) error
+	SendWatcherNotification(aufgabe *worker_task.WatcherNotify, emailWatcher, usernameActor string) error
+	SendHandoverDecision(aufgabe *worker_task.HandoverDecisionNotify, emailRequester, usernameDecider string) error
+	SendSLABreach(breach *entity.SLABreachEntity) error
+	SendOverdueEscalation(escalation *entity.OverdueEscalationEntity) error
 }
 
 type MailService struct {
@@ -270,6 +277,7 @@ func (m *MailService) SendHandoverRequest(aufgabe *worker_task.HandoverRequestNo
 
 		Requested by	: %s
 		Requested at	: %s
+		Expires at	: %s
 
 		Please review this request and decide whether to:
 		- approve the handover,
@@ -281,7 +289,7 @@ func (m *MailService) SendHandoverRequest(aufgabe *worker_task.HandoverRequestNo
 		Thank you for your cooperation.
 
 		— Aufgaben Meister
-		`, aufgabe.ProjectName, aufgabe.AufgabeTitle, aufgabe.AufgabeStatus, aufgabe.DueDate.Format("02 Jan 2006 15:04 MST"), usernameAssignee, aufgabe.RequestedAt.Format("02 Jan 2006 15:04 MST")),
+		`, aufgabe.ProjectName, aufgabe.AufgabeTitle, aufgabe.AufgabeStatus, aufgabe.DueDate.Format("02 Jan 2006 15:04 MST"), usernameAssignee, aufgabe.RequestedAt.Format("02 Jan 2006 15:04 MST"), aufgabe.ExpiresAt.Format("02 Jan 2006 15:04 MST")),
 		"category": "Project Progress",
 	}
 
@@ -318,3 +326,280 @@ func (m *MailService) SendHandoverRequest(aufgabe *worker_task.HandoverRequestNo
 
 	return nil
 }
+
+func (m *MailService) SendDependencyUnblocked(aufgabe *worker_task.DependencyUnblockedNotify, emailAssignee string) error {
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - Aufgabe freigegeben",
+		},
+		"to": []map[string]string{
+			{
+				"email": emailAssignee,
+			},
+		},
+		"subject": fmt.Sprintf("Task unblocked: %s (%s)", aufgabe.AufgabeTitle, aufgabe.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi,
+
+		Good news, the last task blocking your work has been completed.
+
+		Project		: %s
+		Task   		: %s
+		Unblocked by	: %s
+		Unblocked at	: %s
+
+		You can start working on this task now.
+
+		— Aufgaben Meister
+		`, aufgabe.ProjectName, aufgabe.AufgabeTitle, aufgabe.UnblockedByTitle, aufgabe.UnblockedAt.Format("02 Jan 2006 15:04 MST")),
+		"category": "Project Progress",
+	}
+
+	return m.send(payload)
+}
+
+func (m *MailService) SendCommentMention(aufgabe *worker_task.CommentMentionNotify, emailMentioned, usernameAuthor string) error {
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - Erwähnung",
+		},
+		"to": []map[string]string{
+			{
+				"email": emailMentioned,
+			},
+		},
+		"subject": fmt.Sprintf("%s mentioned you on %s (%s)", usernameAuthor, aufgabe.AufgabeTitle, aufgabe.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi,
+
+		%s mentioned you in a comment.
+
+		Project		: %s
+		Task   		: %s
+		Mentioned at	: %s
+
+		"%s"
+
+		— Aufgaben Meister
+		`, usernameAuthor, aufgabe.ProjectName, aufgabe.AufgabeTitle, aufgabe.MentionedAt.Format("02 Jan 2006 15:04 MST"), aufgabe.Excerpt),
+		"category": "Project Discussion",
+	}
+
+	return m.send(payload)
+}
+
+func (m *MailService) SendWatcherNotification(aufgabe *worker_task.WatcherNotify, emailWatcher, usernameActor string) error {
+	var summary string
+	switch aufgabe.Change {
+	case worker_task.WatcherChangeAssigned:
+		summary = fmt.Sprintf("%s assigned the task to %s.", usernameActor, aufgabe.Detail)
+	case worker_task.WatcherChangeCompleted:
+		summary = fmt.Sprintf("%s completed the task.", usernameActor)
+	case worker_task.WatcherChangeDueDateChanged:
+		summary = fmt.Sprintf("%s moved the due date to %s.", usernameActor, aufgabe.Detail)
+	case worker_task.WatcherChangeArchived:
+		summary = fmt.Sprintf("%s archived the task.", usernameActor)
+	case worker_task.WatcherChangeCommented:
+		summary = fmt.Sprintf("%s commented:\n\n\t\t\"%s\"", usernameActor, aufgabe.Detail)
+	default:
+		summary = fmt.Sprintf("%s updated the task.", usernameActor)
+	}
+
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - Beobachtete Aufgabe",
+		},
+		"to": []map[string]string{
+			{
+				"email": emailWatcher,
+			},
+		},
+		"subject": fmt.Sprintf("Update on %s (%s)", aufgabe.AufgabeTitle, aufgabe.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi,
+
+		%s
+
+		Project		: %s
+		Task   		: %s
+		Changed at	: %s
+
+		You receive this email because you are watching this task.
+
+		— Aufgaben Meister
+		`, summary, aufgabe.ProjectName, aufgabe.AufgabeTitle, aufgabe.OccurredAt.Format("02 Jan 2006 15:04 MST")),
+		"category": "Project Progress",
+	}
+
+	return m.send(payload)
+}
+
+func (m *MailService) SendHandoverDecision(aufgabe *worker_task.HandoverDecisionNotify, emailRequester, usernameDecider string) error {
+	var summary string
+	decidedLabel := "Decided at"
+	switch aufgabe.Outcome {
+	case string(entity.HandoverApproved):
+		summary = fmt.Sprintf("%s approved your handover request. The task is no longer assigned to you.", usernameDecider)
+	case string(entity.HandoverRejected):
+		summary = fmt.Sprintf("%s rejected your handover request. The task stays assigned to you.", usernameDecider)
+	case string(entity.HandoverExpired):
+		// Nobody decided, the request ran out of time
+		summary = "Your handover request expired without a decision. The task stays assigned to you, you can file a new request."
+		decidedLabel = "Expired at"
+	default:
+		summary = fmt.Sprintf("Your handover request was closed by %s.", usernameDecider)
+	}
+
+	reason := aufgabe.Reason
+	if reason == "" {
+		reason = "-"
+	}
+
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - Übergabeanfrage",
+		},
+		"to": []map[string]string{
+			{
+				"email": emailRequester,
+			},
+		},
+		"subject": fmt.Sprintf("Handover request %s: %s (%s)", strings.ToLower(aufgabe.Outcome), aufgabe.AufgabeTitle, aufgabe.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi,
+
+		%s
+
+		Project		: %s
+		Task   		: %s
+		Reason		: %s
+		%s	: %s
+
+		— Aufgaben Meister
+		`, summary, aufgabe.ProjectName, aufgabe.AufgabeTitle, reason, decidedLabel, aufgabe.DecidedAt.Format("02 Jan 2006 15:04 MST")),
+		"category": "Project Progress",
+	}
+
+	return m.send(payload)
+}
+
+func (m *MailService) SendSLABreach(breach *entity.SLABreachEntity) error {
+	target := "assigned"
+	if breach.Kind == entity.SLABreachCompletion {
+		target = "completed"
+	}
+
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - SLA-Verletzung",
+		},
+		"to": []map[string]string{
+			{
+				"email": breach.MeisterEmail,
+			},
+		},
+		"subject": fmt.Sprintf("⚠️ SLA breached: %s (%s)", breach.AufgabeTitle, breach.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi Meister,
+
+		The following task was not %s within the SLA of its priority.
+
+		Project		: %s
+		Task   		: %s
+		Priority	: %s
+		SLA		: %s
+		Deadline	: %s
+
+		Please check whether the task needs a new assignee, more help or a new plan.
+
+		— Aufgaben Meister
+		`, target, breach.ProjectName, breach.AufgabeTitle, breach.Priority, breach.Kind, breach.DeadlineAt.Format("02 Jan 2006 15:04 MST")),
+		"category": "Project Progress",
+	}
+
+	return m.send(payload)
+}
+
+func (m *MailService) SendOverdueEscalation(escalation *entity.OverdueEscalationEntity) error {
+	assignee := "nobody"
+	if escalation.AssigneeName != nil {
+		assignee = *escalation.AssigneeName
+	}
+	next := "Please check in with the assignee."
+	if escalation.Action == entity.EscalationFlagForceUnassign {
+		next = "The task is now flagged for force-unassign, please take it away from the assignee or set a new due date."
+	}
+
+	payload := map[string]any{
+		"from": map[string]string{
+			"email": m.DomainSender,
+			"name":  "Aufgaben Meister - Eskalation",
+		},
+		"to": []map[string]string{
+			{
+				"email": escalation.MeisterEmail,
+			},
+		},
+		"subject": fmt.Sprintf("🚨 Overdue escalation level %d: %s (%s)", escalation.Level, escalation.AufgabeTitle, escalation.ProjectName),
+		"text": fmt.Sprintf(`
+		Hi Meister,
+
+		The following task is still overdue and reached escalation level %d.
+
+		Project		: %s
+		Task   		: %s
+		Priority	: %s
+		Assignee	: %s
+		Due date	: %s
+		Reminders	: %d
+
+		%s
+
+		— Aufgaben Meister
+		`, escalation.Level, escalation.ProjectName, escalation.AufgabeTitle, escalation.Priority, assignee, escalation.DueDate.Format("02 Jan 2006 15:04 MST"), escalation.OverdueReminderCount, next),
+		"category": "Project Progress",
+	}
+
+	return m.send(payload)
+}
+
+// send posts a prepared payload to the configured mailtrap endpoint
+func (m *MailService) send(payload map[string]any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Error().Err(err).Msg("Error when marshalling payload body.")
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, m.MailtrapUrl, bytes.NewBuffer(body))
+	if err != nil {
+		log.Error().Err(err).Msg("Error when send the request.")
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+m.MailAPI)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Error().Err(err).Msg("Error when get response from server.")
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("mailtrap send failed: status=%d body=%s",
+			resp.StatusCode,
+			string(respBody))
+	}
+
+	return nil
+}
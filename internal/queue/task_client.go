@@ -14,6 +14,10 @@ type TaskQueueClient interface {
 	EnqueueSendInvitationEmail(payload *worker_task.SendInvitationEmailPayload) error
 	EnqueueSendProjectProgressReminder(payload *worker_task.SendProjectProgressReminder, remindAt time.Time) error
 	EnqueueHandoverRequestNotifyMeister(payload *worker_task.HandoverRequestNotifyMeister) error
+	EnqueueDependencyUnblockedNotify(payload *worker_task.DependencyUnblockedNotify) error
+	EnqueueCommentMentionNotify(payload *worker_task.CommentMentionNotify) error
+	EnqueueWatcherNotify(payload *worker_task.WatcherNotify) error
+	EnqueueHandoverDecisionNotify(payload *worker_task.HandoverDecisionNotify) error
 }
 
 type TaskQueue struct {
@@ -54,3 +58,39 @@ func (q *TaskQueue) EnqueueHandoverRequestNotifyMeister(payload *worker_task.Han
 	_, err := q.client.Enqueue(task)
 	return err
 }
+
+func (q *TaskQueue) EnqueueDependencyUnblockedNotify(payload *worker_task.DependencyUnblockedNotify) error {
+	log.Info().Msg("Preparing enqueueing payload.")
+	p, _ := json.Marshal(payload)
+	task := asynq.NewTask(worker_task.TaskDependencyUnblockedNotify, p, asynq.Queue("email"))
+
+	_, err := q.client.Enqueue(task)
+	return err
+}
+
+func (q *TaskQueue) EnqueueCommentMentionNotify(payload *worker_task.CommentMentionNotify) error {
+	log.Info().Msg("Preparing enqueueing payload.")
+	p, _ := json.Marshal(payload)
+	task := asynq.NewTask(worker_task.TaskCommentMentionNotify, p, asynq.Queue("email"))
+
+	_, err := q.client.Enqueue(task)
+	return err
+}
+
+func (q *TaskQueue) EnqueueWatcherNotify(payload *worker_task.WatcherNotify) error {
+	log.Info().Msg("Preparing enqueueing payload.")
+	p, _ := json.Marshal(payload)
+	task := asynq.NewTask(worker_task.TaskWatcherNotify, p, asynq.Queue("email"))
+
+	_, err := q.client.Enqueue(task)
+	return err
+}
+
+func (q *TaskQueue) EnqueueHandoverDecisionNotify(payload *worker_task.HandoverDecisionNotify) error {
+	log.Info().Msg("Preparing enqueueing payload.")
+	p, _ := json.Marshal(payload)
+	task := asynq.NewTask(worker_task.TaskHandoverDecisionNotify, p, asynq.Queue("email"))
+
+	_, err := q.client.Enqueue(task)
+	return err
+}
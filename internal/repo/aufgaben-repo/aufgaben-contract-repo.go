@@ -15,18 +15,132 @@ type AufgabenRepoContract interface {
 	GetUserRole(ctx context.Context, projectID, userID string) (*entity.UserRole, *app_errors.AppError)
 	GetTaskByID(ctx context.Context, taskID string) (*entity.AufgabenEntity, *app_errors.AppError)
 	InsertNewAufgaben(ctx context.Context, task *entity.AufgabenEntity) *app_errors.AppError
-	CountTasks(ctx context.Context, projectID string) (int64, *app_errors.AppError)
+	CountTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) (int64, *app_errors.AppError)
 	ListTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) ([]entity.AufgabenEntity, *app_errors.AppError)
+	LockTask(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError
 	AssignTask(ctx context.Context, t tx.Tx, projectID, taskID, userID string, dueDate *time.Time) (*entity.AssignTaskEntity, *app_errors.AppError)
 	ForwardProgress(ctx context.Context, t tx.Tx, taskID string) (*entity.CompleteTaskEntity, *app_errors.AppError)
 	InsertAssignmentEvent(ctx context.Context, t tx.Tx, event *entity.AddAssignment) *app_errors.AppError
 	UnassignTask(ctx context.Context, t tx.Tx, rollbackModel *entity.UnassignTaskEntity) (entity.AufgabenStatus, *app_errors.AppError)
-	ShouldRemind(ctx context.Context, taskID string) (*entity.ReminderAufgaben, *app_errors.AppError)
+	ShouldRemind(ctx context.Context, taskID string) ([]entity.ReminderAufgaben, *app_errors.AppError)
 	UpdateAufgabeReminderBeforeDue(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError
 	ListShouldRemindOverdue(ctx context.Context) ([]entity.ReminderAufgaben, *app_errors.AppError)
 	BatchUpdateAufgabenReminderOverdue(ctx context.Context, t tx.Tx, taskIDs []string) *app_errors.AppError
 	ListAssignedTasks(ctx context.Context, userID string, filter *aufgaben_dto.AssignedAufgabenFilter) ([]entity.AssignedAufgaben, *app_errors.AppError)
 	ArchiveTask(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError
+	UnarchiveTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError)
+	ReopenTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError)
 	UpdateDueDate(ctx context.Context, t tx.Tx, taskID string, dueDate time.Time) (*time.Time, *app_errors.AppError)
+	UpdateTaskContent(ctx context.Context, t tx.Tx, taskID, title string, description *string, priority entity.AufgabenPriority) (*time.Time, *app_errors.AppError)
 	ListEventsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]entity.AssignmentEventEntity, *app_errors.AppError)
+	InsertNewAufgabenWithTx(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) *app_errors.AppError
+	ListSubtasks(ctx context.Context, parentID string) ([]entity.AufgabenEntity, *app_errors.AppError)
+	GetSubtaskProgress(ctx context.Context, parentID string) (*entity.SubtaskProgress, *app_errors.AppError)
+	InsertDependency(ctx context.Context, t tx.Tx, dependency *entity.AufgabenDependency) *app_errors.AppError
+	DeleteDependency(ctx context.Context, t tx.Tx, taskID, blockedByID string) *app_errors.AppError
+	LockProjectDependencies(ctx context.Context, t tx.Tx, projectID string) *app_errors.AppError
+	IsTransitivelyBlockedBy(ctx context.Context, t tx.Tx, taskID, blockedByID string) (bool, *app_errors.AppError)
+	CountOpenBlockers(ctx context.Context, t tx.Tx, taskID string) (int64, *app_errors.AppError)
+	ListBlockers(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError)
+	ListBlocking(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError)
+	ListUnblockedDependents(ctx context.Context, t tx.Tx, blockerID string) ([]entity.UnblockedAufgaben, *app_errors.AppError)
+	InsertComment(ctx context.Context, t tx.Tx, comment *entity.AufgabenCommentEntity) *app_errors.AppError
+	GetCommentByID(ctx context.Context, commentID string) (*entity.AufgabenCommentEntity, *app_errors.AppError)
+	UpdateCommentBody(ctx context.Context, t tx.Tx, commentID, body string) (*time.Time, *app_errors.AppError)
+	SoftDeleteComment(ctx context.Context, commentID string) *app_errors.AppError
+	ListCommentsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenCommentFilter) ([]entity.AufgabenCommentEntity, *app_errors.AppError)
+	FindMentionableMembers(ctx context.Context, projectID string, usernames []string) ([]entity.MentionedUser, *app_errors.AppError)
+	InsertCommentMentions(ctx context.Context, t tx.Tx, commentID string, userIDs []string) ([]string, *app_errors.AppError)
+	ListCommentMentions(ctx context.Context, commentIDs []string) ([]entity.CommentMention, *app_errors.AppError)
+	InsertLabel(ctx context.Context, label *entity.LabelEntity) *app_errors.AppError
+	GetLabelByID(ctx context.Context, labelID string) (*entity.LabelEntity, *app_errors.AppError)
+	ListLabels(ctx context.Context, projectID string) ([]entity.LabelEntity, *app_errors.AppError)
+	ListLabelsForTask(ctx context.Context, taskID string) ([]entity.LabelEntity, *app_errors.AppError)
+	UpdateLabel(ctx context.Context, labelID string, name, color *string) (*entity.LabelEntity, *app_errors.AppError)
+	DeleteLabel(ctx context.Context, t tx.Tx, labelID string) ([]string, *app_errors.AppError)
+	AttachLabel(ctx context.Context, t tx.Tx, taskID, labelID, userID string) (*time.Time, *app_errors.AppError)
+	DetachLabel(ctx context.Context, t tx.Tx, taskID, labelID string) *app_errors.AppError
+	GetProjectWorkflow(ctx context.Context, projectID string) (*entity.ProjectWorkflow, *app_errors.AppError)
+	ReplaceProjectWorkflow(ctx context.Context, t tx.Tx, userID string, workflow *entity.ProjectWorkflow) ([]string, *app_errors.AppError)
+	UpdateWorkflowStatus(ctx context.Context, t tx.Tx, taskID, statusKey string) *app_errors.AppError
+	UpdateEstimate(ctx context.Context, t tx.Tx, taskID string, estimate *float64, unit *entity.EstimateUnit) (*time.Time, *app_errors.AppError)
+	GetProjectVelocity(ctx context.Context, projectID string, from, to time.Time) ([]entity.VelocityWeek, *app_errors.AppError)
+	InsertRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) *app_errors.AppError
+	GetRecurrenceByID(ctx context.Context, recurrenceID string) (*entity.RecurrenceEntity, *app_errors.AppError)
+	ListRecurrences(ctx context.Context, projectID string) ([]entity.RecurrenceEntity, *app_errors.AppError)
+	UpdateRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) (*time.Time, *app_errors.AppError)
+	DeleteRecurrence(ctx context.Context, recurrenceID string) *app_errors.AppError
+	ListDueRecurrences(ctx context.Context, t tx.Tx, limit int) ([]entity.RecurrenceEntity, *app_errors.AppError)
+	InsertRecurringAufgabe(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) (bool, *app_errors.AppError)
+	AdvanceRecurrence(ctx context.Context, t tx.Tx, recurrenceID string, occurrenceAt, nextRunAt time.Time) *app_errors.AppError
+	InsertTemplate(ctx context.Context, template *entity.TemplateEntity) *app_errors.AppError
+	GetTemplateByID(ctx context.Context, templateID string) (*entity.TemplateEntity, *app_errors.AppError)
+	ListTemplates(ctx context.Context, projectID string) ([]entity.TemplateEntity, *app_errors.AppError)
+	UpdateTemplate(ctx context.Context, template *entity.TemplateEntity) (*time.Time, *app_errors.AppError)
+	DeleteTemplate(ctx context.Context, templateID string) *app_errors.AppError
+	LockAttachmentQuota(ctx context.Context, t tx.Tx, projectID string) (*entity.AttachmentQuota, *app_errors.AppError)
+	InsertAttachment(ctx context.Context, t tx.Tx, attachment *entity.AttachmentEntity) *app_errors.AppError
+	GetAttachmentByID(ctx context.Context, attachmentID string) (*entity.AttachmentEntity, *app_errors.AppError)
+	ListAttachmentsForTask(ctx context.Context, taskID string) ([]entity.AttachmentEntity, *app_errors.AppError)
+	DeleteAttachment(ctx context.Context, t tx.Tx, attachmentID string) *app_errors.AppError
+	AddWatcher(ctx context.Context, taskID, userID string) (*time.Time, *app_errors.AppError)
+	RemoveWatcher(ctx context.Context, taskID, userID string) *app_errors.AppError
+	ListWatchers(ctx context.Context, taskID string) ([]entity.WatcherEntity, *app_errors.AppError)
+	ListWatcherContacts(ctx context.Context, taskID string) ([]entity.WatcherContact, *app_errors.AppError)
+	InsertHandoverRequest(ctx context.Context, t tx.Tx, request *entity.HandoverRequestEntity) *app_errors.AppError
+	ListPendingHandoverRequests(ctx context.Context, projectID string) ([]entity.HandoverRequestEntity, *app_errors.AppError)
+	GetHandoverRequestForUpdate(ctx context.Context, t tx.Tx, requestID string) (*entity.HandoverRequestEntity, *app_errors.AppError)
+	DecideHandoverRequest(ctx context.Context, t tx.Tx, requestID string, status entity.HandoverRequestStatus, decidedBy string, reason *string) (*time.Time, *app_errors.AppError)
+	ExpireHandoverRequests(ctx context.Context, t tx.Tx) ([]entity.HandoverRequestEntity, *app_errors.AppError)
+	AddTaskAssignee(ctx context.Context, t tx.Tx, assignee *entity.AufgabenAssigneeEntity) *app_errors.AppError
+	RemoveTaskAssignee(ctx context.Context, t tx.Tx, taskID, userID string) *app_errors.AppError
+	GetTaskAssigneeRole(ctx context.Context, taskID, userID string) (*entity.AssigneeRole, *app_errors.AppError)
+	ListTaskAssignees(ctx context.Context, taskID string) ([]entity.AufgabenAssigneeEntity, *app_errors.AppError)
+	LockNextAssignableTask(ctx context.Context, t tx.Tx, projectID string, dueDate *time.Time, skipIDs []string) (*string, *app_errors.AppError)
+	ListMemberWorkloads(ctx context.Context, projectID string, userID *string) ([]entity.MemberWorkload, *app_errors.AppError)
+	GetWorkloadThreshold(ctx context.Context, projectID string) (int, *app_errors.AppError)
+	UpdateWorkloadThreshold(ctx context.Context, projectID string, threshold int) *app_errors.AppError
+	UpsertSLAPolicy(ctx context.Context, policy *entity.SLAPolicyEntity) *app_errors.AppError
+	ListSLAPolicies(ctx context.Context, projectID string) ([]entity.SLAPolicyEntity, *app_errors.AppError)
+	DeleteSLAPolicy(ctx context.Context, projectID string, priority entity.AufgabenPriority) *app_errors.AppError
+	RecordSLABreaches(ctx context.Context, t tx.Tx) ([]entity.SLABreachEntity, *app_errors.AppError)
+	GetSLACompliance(ctx context.Context, projectID string, filter *aufgaben_dto.SLAComplianceFilter) ([]entity.SLAComplianceRow, *app_errors.AppError)
+	UpsertEscalationLevel(ctx context.Context, level *entity.EscalationLevelEntity) *app_errors.AppError
+	ListEscalationLevels(ctx context.Context, projectID string) ([]entity.EscalationLevelEntity, *app_errors.AppError)
+	DeleteEscalationLevel(ctx context.Context, projectID string, level int) *app_errors.AppError
+	RecordOverdueEscalations(ctx context.Context, t tx.Tx) ([]entity.OverdueEscalationEntity, *app_errors.AppError)
+	ListFlaggedTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError)
+	ListOverdueTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError)
+	ListBoardTasks(ctx context.Context, projectID string, status *entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError)
+	LockBoardColumn(ctx context.Context, t tx.Tx, projectID, statusKey string) *app_errors.AppError
+	LockBoardTasks(ctx context.Context, t tx.Tx, projectID string, status entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError)
+	ListBoardColumns(ctx context.Context, projectID string) ([]entity.BoardColumnEntity, *app_errors.AppError)
+	GetBoardColumnWIPLimit(ctx context.Context, projectID, statusKey string) (*int, *app_errors.AppError)
+	UpsertBoardColumn(ctx context.Context, column *entity.BoardColumnEntity) *app_errors.AppError
+	DeleteBoardColumn(ctx context.Context, projectID, statusKey string) *app_errors.AppError
+	MoveBoardTask(ctx context.Context, t tx.Tx, taskID, statusKey string, afterRank, beforeRank *string) (string, *app_errors.AppError)
+	InsertMilestone(ctx context.Context, milestone *entity.MilestoneEntity) *app_errors.AppError
+	GetMilestoneByID(ctx context.Context, milestoneID string) (*entity.MilestoneEntity, *app_errors.AppError)
+	ListMilestones(ctx context.Context, projectID string) ([]entity.MilestoneEntity, *app_errors.AppError)
+	UpdateMilestone(ctx context.Context, milestone *entity.MilestoneEntity) (*time.Time, *app_errors.AppError)
+	CloseMilestone(ctx context.Context, t tx.Tx, milestoneID, userID string) (*time.Time, *app_errors.AppError)
+	SetTaskMilestone(ctx context.Context, t tx.Tx, taskID string, milestoneID *string) (*time.Time, *app_errors.AppError)
+	CarryOverMilestoneTasks(ctx context.Context, t tx.Tx, fromID string, toID *string) ([]string, *app_errors.AppError)
+	GetMilestoneBurndown(ctx context.Context, milestoneID string) ([]entity.BurndownDay, *app_errors.AppError)
+	GetRunningWorklog(ctx context.Context, userID string) (*entity.WorklogEntity, *app_errors.AppError)
+	GetWorklogByID(ctx context.Context, worklogID string) (*entity.WorklogEntity, *app_errors.AppError)
+	InsertWorklog(ctx context.Context, worklog *entity.WorklogEntity) *app_errors.AppError
+	StopWorklog(ctx context.Context, worklogID string) (*time.Time, *app_errors.AppError)
+	UpdateWorklog(ctx context.Context, worklog *entity.WorklogEntity) (*time.Time, *app_errors.AppError)
+	DeleteWorklog(ctx context.Context, worklogID string) *app_errors.AppError
+	ListWorklogsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenWorklogFilter) ([]entity.WorklogEntity, *app_errors.AppError)
+	GetTaskWorklogTotals(ctx context.Context, taskID string) ([]entity.WorklogTotal, *app_errors.AppError)
+	GetProjectTimeReport(ctx context.Context, projectID string, filter *aufgaben_dto.TimeReportFilter) ([]entity.WorklogTotal, []entity.WorklogTotal, *app_errors.AppError)
+	SearchTasks(ctx context.Context, userID string, filter *aufgaben_dto.AufgabenSearchFilter) ([]entity.AufgabenSearchResult, *app_errors.AppError)
+	RevokeActiveCalendarFeed(ctx context.Context, t tx.Tx, userID string, projectID *string) *app_errors.AppError
+	InsertCalendarFeed(ctx context.Context, t tx.Tx, feed *entity.CalendarFeedEntity) (*entity.CalendarFeedEntity, *app_errors.AppError)
+	ListCalendarFeeds(ctx context.Context, userID string) ([]entity.CalendarFeedEntity, *app_errors.AppError)
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*entity.CalendarFeedEntity, *app_errors.AppError)
+	TouchCalendarFeed(ctx context.Context, feedID string) *app_errors.AppError
+	RevokeCalendarFeed(ctx context.Context, feedID, userID string) *app_errors.AppError
 }
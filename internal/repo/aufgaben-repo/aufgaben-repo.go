@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/tx"
@@ -61,13 +62,17 @@ func (r *AufgabenRepo) GetUserRole(ctx context.Context, projectID, userID string
 
 func (r *AufgabenRepo) GetTaskByID(ctx context.Context, taskID string) (*entity.AufgabenEntity, *app_errors.AppError) {
 	query := `
-	SELECT a.*, p.name FROM aufgaben a
+	SELECT a.id, a.project_id, a.parent_id, a.title, a.description, a.status, a.priority,
+	a.assignee_id, a.created_by, a.due_date, a.created_at, a.updated_at, a.archived_at,
+	a.completed_at, p.name, a.workflow_status, a.estimate::float8, a.estimate_unit, a.recurrence_id,
+	a.escalation_level, a.flagged_for_unassign_at, a.milestone_id
+	FROM aufgaben a
 	JOIN projects p ON p.id = a.project_id
-	WHERE id = $1;
+	WHERE a.id = $1;
 	`
 
 	var row entity.AufgabenEntity
-	if err := r.db.QueryRow(ctx, query, taskID).Scan(&row.ID, &row.ProjectID, &row.Title, &row.Description, &row.Status, &row.Priority, &row.AssigneeID, &row.CreatedBy, &row.DueDate, &row.CreatedAt, &row.UpdatedAt, &row.ArchivedAt, &row.CompletedAt, &row.ProjectName); err != nil {
+	if err := r.db.QueryRow(ctx, query, taskID).Scan(&row.ID, &row.ProjectID, &row.ParentID, &row.Title, &row.Description, &row.Status, &row.Priority, &row.AssigneeID, &row.CreatedBy, &row.DueDate, &row.CreatedAt, &row.UpdatedAt, &row.ArchivedAt, &row.CompletedAt, &row.ProjectName, &row.WorkflowStatus, &row.Estimate, &row.EstimateUnit, &row.RecurrenceID, &row.EscalationLevel, &row.FlaggedForUnassignAt, &row.MilestoneID); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_not_found", nil)
 		}
@@ -82,6 +87,7 @@ func (r *AufgabenRepo) InsertNewAufgaben(ctx context.Context, task *entity.Aufga
 	INSERT INTO aufgaben (
 			id,
 			project_id,
+			parent_id,
 			title,
 			description,
 			status,
@@ -89,9 +95,11 @@ func (r *AufgabenRepo) InsertNewAufgaben(ctx context.Context, task *entity.Aufga
 			assignee_id,
 			created_by,
 			due_date,
-			created_at
+			created_at,
+			estimate,
+			estimate_unit
 		) VALUES (
-			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10
+			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13
 		)
 	`
 
@@ -100,6 +108,7 @@ func (r *AufgabenRepo) InsertNewAufgaben(ctx context.Context, task *entity.Aufga
 		query,
 		task.ID,
 		task.ProjectID,
+		task.ParentID,
 		task.Title,
 		task.Description,
 		task.Status,
@@ -108,6 +117,8 @@ func (r *AufgabenRepo) InsertNewAufgaben(ctx context.Context, task *entity.Aufga
 		task.CreatedBy,
 		task.DueDate,
 		task.CreatedAt,
+		task.Estimate,
+		task.EstimateUnit,
 	); err != nil {
 		return app_errors.MapPgxError(err)
 	}
@@ -115,16 +126,18 @@ func (r *AufgabenRepo) InsertNewAufgaben(ctx context.Context, task *entity.Aufga
 	return nil
 }
 
-func (r *AufgabenRepo) CountTasks(ctx context.Context, projectID string) (int64, *app_errors.AppError) {
+func (r *AufgabenRepo) CountTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) (int64, *app_errors.AppError) {
+	// Same conditions as ListTasks, so the total matches the listed tasks
+	conditions, args := taskListConditions(filter, 2)
 	query := `
 	SELECT COUNT(*)
-	FROM aufgaben
-	WHERE project_id = $1
-		AND status != 'Archived'
-		AND archived_at = NULL;
-	`
+	FROM aufgaben a
+	WHERE a.project_id = $1
+		AND a.archived_at IS NULL
+	` + conditions
+
 	var count int64
-	if err := r.db.QueryRow(ctx, query, projectID).Scan(&count); err != nil {
+	if err := r.db.QueryRow(ctx, query, append([]any{projectID}, args...)...).Scan(&count); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return 0, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "project_not_found", nil)
 		}
@@ -134,37 +147,176 @@ func (r *AufgabenRepo) CountTasks(ctx context.Context, projectID string) (int64,
 	return count, nil
 }
 
-func (r *AufgabenRepo) ListTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) ([]entity.AufgabenEntity, *app_errors.AppError) {
+// taskSortExpr maps a sort key to a never null expression, so it can be compared against the cursor row.
+// Tasks without due date come last in both directions.
+var taskSortExpr = map[string][2]string{
+	"created_at": {"a.created_at", "a.created_at"},
+	"updated_at": {"COALESCE(a.updated_at, a.created_at)", "COALESCE(a.updated_at, a.created_at)"},
+	"due_date":   {"COALESCE(a.due_date, 'infinity'::timestamptz)", "COALESCE(a.due_date, '-infinity'::timestamptz)"},
+	"priority":   {"a.priority", "a.priority"},
+}
+
+type taskSortKey struct {
+	expr string
+	desc bool
+}
+
+// taskListConditions builds the filter part of ListTasks and CountTasks, argument numbering starts at argsPos
+func taskListConditions(filter *aufgaben_dto.AufgabenListFilter, argsPos int) (string, []any) {
+	var query string
+	var args []any
+	add := func(clause string, value any) {
+		query += fmt.Sprintf(clause, argsPos)
+		args = append(args, value)
+		argsPos++
+	}
+
+	if filter.Status != nil && *filter.Status != string(entity.AufgabenArchived) {
+		add(" AND a.status = $%d", filter.Status)
+	}
+
+	if filter.AssigneeID != nil {
+		add(" AND a.assignee_id = $%d", filter.AssigneeID)
+	}
+
+	if filter.Unassigned != nil {
+		if *filter.Unassigned {
+			query += " AND a.assignee_id IS NULL"
+		} else {
+			query += " AND a.assignee_id IS NOT NULL"
+		}
+	}
+
+	if filter.Priority != nil {
+		add(" AND a.priority = $%d", filter.Priority)
+	}
+
+	if filter.CreatedBy != nil {
+		add(" AND a.created_by = $%d", filter.CreatedBy)
+	}
+
+	if filter.DueAfter != nil {
+		add(" AND a.due_date >= $%d::date", filter.DueAfter)
+	}
+
+	if filter.DueBefore != nil {
+		add(" AND a.due_date < $%d::date + 1", filter.DueBefore)
+	}
+
+	if filter.Overdue != nil {
+		if *filter.Overdue {
+			query += " AND a.due_date < now() AND a.status <> 'Done'"
+		} else {
+			query += " AND (a.due_date IS NULL OR a.due_date >= now() OR a.status = 'Done')"
+		}
+	}
+
+	if filter.Query != nil {
+		add(" AND a.search_vector @@ websearch_to_tsquery('simple', $%d)", filter.Query)
+	}
+
+	if len(filter.Labels) > 0 {
+		query += labelMatchClause(argsPos, argsPos+1)
+		args = append(args, filter.Labels, isLabelMatchAll(filter.LabelMatch))
+		argsPos += 2
+	}
+
+	return query, args
+}
+
+// taskListQuery builds the ListTasks query. Sort keys are compared one after another against the cursor task,
+// the id breaks ties. One row more than the limit is read to know if there is a next page.
+func taskListQuery(projectID string, filter *aufgaben_dto.AufgabenListFilter) (string, []any) {
+	keys := make([]taskSortKey, 0, len(filter.Sort)+1)
+	for _, key := range filter.Sort {
+		desc := strings.HasPrefix(key, "-")
+		exprs, ok := taskSortExpr[strings.TrimPrefix(key, "-")]
+		if !ok {
+			continue
+		}
+		if desc {
+			keys = append(keys, taskSortKey{expr: exprs[1], desc: true})
+		} else {
+			keys = append(keys, taskSortKey{expr: exprs[0]})
+		}
+	}
+	if len(keys) == 0 {
+		keys = append(keys, taskSortKey{expr: "a.created_at", desc: true})
+	}
+	keys = append(keys, taskSortKey{expr: "a.id", desc: true})
+
+	conditions, args := taskListConditions(filter, 2)
+	args = append([]any{projectID}, args...)
+	argsPos := len(args) + 1
+
 	query := `
-	SELECT a.*, p.name FROM aufgaben a
+	SELECT a.id, a.project_id, a.parent_id, a.title, a.description, a.status, a.priority,
+	a.assignee_id, a.created_by, a.due_date, a.created_at, a.updated_at, a.archived_at,
+	a.completed_at, p.name, a.estimate::float8, a.estimate_unit, a.recurrence_id
+	FROM aufgaben a
 	JOIN projects p ON p.id = a.project_id
-	WHERE project_id = $1
-		AND archived_at IS NULL
 	`
 
-	args := []any{projectID}
-	argsPos := 2
+	if filter.Cursor != nil {
+		cursorCols := make([]string, 0, len(keys))
+		for i, key := range keys {
+			cursorCols = append(cursorCols, fmt.Sprintf("%s AS k%d", key.expr, i))
+		}
+		query += fmt.Sprintf(`CROSS JOIN (
+		SELECT %s
+		FROM aufgaben a
+		WHERE a.id = $%d
+			AND a.project_id = $1
+	) cur
+	`, strings.Join(cursorCols, ", "), argsPos)
+		args = append(args, filter.Cursor)
+		argsPos++
+	}
 
-	if filter.Status != nil {
-		if *filter.Status != string(entity.AufgabenArchived) {
-			query += fmt.Sprintf(" AND status = $%d", argsPos)
-			args = append(args, filter.Status)
-			argsPos++
+	query += `WHERE a.project_id = $1
+		AND a.archived_at IS NULL
+	` + conditions
+
+	if filter.Cursor != nil {
+		var after []string
+		for i, key := range keys {
+			var parts []string
+			for j := 0; j < i; j++ {
+				parts = append(parts, fmt.Sprintf("%s = cur.k%d", keys[j].expr, j))
+			}
+			op := ">"
+			if key.desc {
+				op = "<"
+			}
+			parts = append(parts, fmt.Sprintf("%s %s cur.k%d", key.expr, op, i))
+			after = append(after, "("+strings.Join(parts, " AND ")+")")
 		}
+		query += " AND (" + strings.Join(after, " OR ") + ")"
 	}
 
-	if filter.AssigneeID != nil {
-		query += fmt.Sprintf(" AND assignee_id = $%d", argsPos)
-		args = append(args, filter.AssigneeID)
-		argsPos++
+	orderBy := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.desc {
+			orderBy = append(orderBy, key.expr+" DESC")
+		} else {
+			orderBy = append(orderBy, key.expr+" ASC")
+		}
 	}
+	query += " ORDER BY " + strings.Join(orderBy, ", ")
 
-	query += " ORDER BY created_at DESC"
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d;", argsPos, argsPos+1)
+	// The cursor replaces the offset
+	offset := 0
+	if filter.Cursor == nil {
+		offset = (filter.Page - 1) * filter.Limit
+	}
+	query += fmt.Sprintf(" LIMIT $%d + 1 OFFSET $%d;", argsPos, argsPos+1)
+	args = append(args, filter.Limit, offset)
 
-	offset := (filter.Page - 1) * filter.Limit
+	return query, args
+}
 
-	args = append(args, filter.Limit, offset)
+func (r *AufgabenRepo) ListTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	query, args := taskListQuery(projectID, filter)
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
@@ -173,11 +325,12 @@ func (r *AufgabenRepo) ListTasks(ctx context.Context, projectID string, filter *
 		}
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
+	defer rows.Close()
 
 	var results []entity.AufgabenEntity
 	for rows.Next() {
 		var result entity.AufgabenEntity
-		if err := rows.Scan(&result.ID, &result.ProjectID, &result.Title, &result.Description, &result.Status, &result.Priority, &result.AssigneeID, &result.CreatedBy, &result.DueDate, &result.CreatedAt, &result.UpdatedAt, &result.ArchivedAt, &result.CompletedAt, &result.ProjectName); err != nil {
+		if err := rows.Scan(&result.ID, &result.ProjectID, &result.ParentID, &result.Title, &result.Description, &result.Status, &result.Priority, &result.AssigneeID, &result.CreatedBy, &result.DueDate, &result.CreatedAt, &result.UpdatedAt, &result.ArchivedAt, &result.CompletedAt, &result.ProjectName, &result.Estimate, &result.EstimateUnit, &result.RecurrenceID); err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "project_not_found", nil)
 			}
@@ -193,6 +346,26 @@ func (r *AufgabenRepo) ListTasks(ctx context.Context, projectID string, filter *
 	return results, nil
 }
 
+func (r *AufgabenRepo) LockTask(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	SELECT id
+	FROM aufgaben
+	WHERE id = $1
+	FOR UPDATE;
+	`
+
+	var id string
+	if err := pgxTx.QueryRow(ctx, query, taskID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_not_found", nil)
+		}
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
 func (r *AufgabenRepo) AssignTask(ctx context.Context, t tx.Tx, projectID, taskID, userID string, dueDate *time.Time) (*entity.AssignTaskEntity, *app_errors.AppError) {
 	pgxTx := t.(*tx.PgxTx).Tx
 	query := `
@@ -220,9 +393,10 @@ func (r *AufgabenRepo) ForwardProgress(ctx context.Context, t tx.Tx, taskID stri
 	query := `
 	UPDATE aufgaben
 	SET status = 'Done',
+		reminder_stage = 'None',
 		completed_at = now()
-	WHERE id = $1;
-	RETURNING id, status, priority, assignee_id, created_by, complete_at;
+	WHERE id = $1
+	RETURNING id, status, priority, assignee_id, created_by, completed_at;
 	`
 
 	var rows entity.CompleteTaskEntity
@@ -244,12 +418,15 @@ func (r *AufgabenRepo) InsertAssignmentEvent(ctx context.Context, t tx.Tx, event
 		action,
 		note,
 		reason_code,
-		reason_text
+		reason_text,
+		field_name,
+		old_value,
+		new_value
 	) VALUES (
-		$1,$2,$3,$4,$5,$6,$7,$8
+		$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11
 	);
 	`
-	if _, err := pgxTx.Exec(ctx, query, event.ID, event.AufgabenID, event.ActorID, event.TargetAssigneeID, event.Action, event.Note, event.ReasonCode, event.ReasonText); err != nil {
+	if _, err := pgxTx.Exec(ctx, query, event.ID, event.AufgabenID, event.ActorID, event.TargetAssigneeID, event.Action, event.Note, event.ReasonCode, event.ReasonText, event.FieldName, event.OldValue, event.NewValue); err != nil {
 		return app_errors.MapPgxError(err)
 	}
 	return nil
@@ -262,7 +439,9 @@ func (r *AufgabenRepo) UnassignTask(ctx context.Context, t tx.Tx, rollbackModel
 	UPDATE aufgaben
 	SET status = 'Todo',
 		assignee_id = NULL,
-		due_date = NULL
+		due_date = NULL,
+		reminder_stage = 'None',
+		last_reminder_at = NULL
 	WHERE id = $1
 		AND assignee_id = $2
 	RETURNING status;
@@ -275,44 +454,56 @@ func (r *AufgabenRepo) UnassignTask(ctx context.Context, t tx.Tx, rollbackModel
 	return status, nil
 }
 
-func (r *AufgabenRepo) ShouldRemind(ctx context.Context, taskID string) (*entity.ReminderAufgaben, *app_errors.AppError) {
+func (r *AufgabenRepo) ShouldRemind(ctx context.Context, taskID string) ([]entity.ReminderAufgaben, *app_errors.AppError) {
+	// One row per participant of the task, each of them gets the reminder
 	query := `
-	SELECT a.id, a.project_id, a.title, a.status, a.priority, a.assignee_id,
+	SELECT a.id, a.project_id, a.title, a.status, a.priority, aa.user_id,
 	a.due_date, a.last_reminder_at, u.email as assignee_email, p.name as project_name
 	FROM aufgaben a
-	JOIN users u ON u.id = a.assignee_id
+	JOIN aufgaben_assignees aa ON aa.aufgaben_id = a.id
+	JOIN users u ON u.id = aa.user_id
 	JOIN projects p ON p.id = a.project_id
 	WHERE a.id = $1
 		AND a.status = 'In_Progress'
-		AND a.assignee_id IS NOT NULL
 		AND a.due_date IS NOT NULL
 		AND a.reminder_stage = 'None'
 		AND a.last_reminder_at IS NULL
 		AND now() >= a.due_date - INTERVAL '1 hour';
 	`
 
-	var row entity.ReminderAufgaben
-	if err := r.db.QueryRow(ctx, query, taskID).Scan(&row.ID, &row.ProjectID, &row.Title, &row.Status, &row.Priority, &row.AssigneeID, &row.DueDate, &row.LastReminderAt, &row.EmailAssignee, &row.ProjectName); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
+	defer rows.Close()
 
-	return &row, nil
+	var aufgaben []entity.ReminderAufgaben
+	for rows.Next() {
+		var row entity.ReminderAufgaben
+		if err := rows.Scan(&row.ID, &row.ProjectID, &row.Title, &row.Status, &row.Priority, &row.AssigneeID, &row.DueDate, &row.LastReminderAt, &row.EmailAssignee, &row.ProjectName); err != nil {
+			return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+		}
+		aufgaben = append(aufgaben, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return aufgaben, nil
 }
 
 func (r *AufgabenRepo) ListShouldRemindOverdue(ctx context.Context) ([]entity.ReminderAufgaben, *app_errors.AppError) {
 	query := `
-	SELECT a.id, a.project_id, a.title, a.status, a.priority, a.assignee_id,
+	SELECT a.id, a.project_id, a.title, a.status, a.priority, aa.user_id,
 	a.due_date, a.last_reminder_at, u.email as assignee_email, p.name as project_name
 	FROM aufgaben a
-	JOIN users u ON u.id = a.assignee_id
+	JOIN aufgaben_assignees aa ON aa.aufgaben_id = a.id
+	JOIN users u ON u.id = aa.user_id
 	JOIN projects p ON p.id = a.project_id
 	WHERE a.status = 'In_Progress'
-		AND a.assignee_id IS NOT NULL
 		AND a.due_date IS NOT NULL
-		AND a.reminder_stage = 'Before_Due'
+		AND a.reminder_stage IN ('Before_Due', 'Overdue')
 		AND (
 			last_reminder_at IS NULL
 			OR now() >= last_reminder_at + INTERVAL '24 hours'
@@ -340,11 +531,15 @@ func (r *AufgabenRepo) ListShouldRemindOverdue(ctx context.Context) ([]entity.Re
 
 func (r *AufgabenRepo) BatchUpdateAufgabenReminderOverdue(ctx context.Context, t tx.Tx, taskIDs []string) *app_errors.AppError {
 	pgxTx := t.(*tx.PgxTx).Tx
+	// Overdue reminders repeat daily, each one counts towards the escalation chain
 	query := `
 	UPDATE aufgaben
 	SET reminder_stage = 'Overdue',
-		last_reminder_at = now()
-	WHERE id = $1 AND reminder_stage = 'Before_Due';
+		last_reminder_at = now(),
+		overdue_reminder_count = overdue_reminder_count + 1
+	WHERE id = $1
+		AND reminder_stage IN ('Before_Due', 'Overdue')
+		AND (last_reminder_at IS NULL OR now() >= last_reminder_at + INTERVAL '24 hours');
 	`
 
 	batch := &pgx.Batch{}
@@ -382,12 +577,15 @@ func (r *AufgabenRepo) UpdateAufgabeReminderBeforeDue(ctx context.Context, t tx.
 
 func (r *AufgabenRepo) ListAssignedTasks(ctx context.Context, userID string, filter *aufgaben_dto.AssignedAufgabenFilter) ([]entity.AssignedAufgaben, *app_errors.AppError) {
 	query := `
-	SELECT a.id, p.name as project_name, a.title, a.description,
-	a.status, a.priority, a.due_date
+	SELECT a.id, a.project_id, p.name as project_name, a.title, a.description,
+	a.status, a.priority, a.due_date, aa.role
 	FROM aufgaben a
+	JOIN aufgaben_assignees aa ON aa.aufgaben_id = a.id AND aa.user_id = $1
 	JOIN projects p ON p.id = a.project_id
-	WHERE a.assignee_id = $1
-		AND a.archived_at IS NULL
+	WHERE a.archived_at IS NULL
+	AND (
+		$9::assignee_role_enum IS NULL OR aa.role = $9
+	)
 	AND (
 		$2::aufgaben_status IS NULL OR a.status = $2
 	)
@@ -399,12 +597,24 @@ func (r *AufgabenRepo) ListAssignedTasks(ctx context.Context, userID string, fil
 	)
 	AND (
 		$5::uuid IS NULL OR a.id < $5)
+	AND (
+		$7::text[] IS NULL OR (
+			SELECT COUNT(DISTINCT lower(l.name))
+			FROM aufgaben_labels al
+			JOIN project_labels l ON l.id = al.label_id
+			WHERE al.aufgaben_id = a.id
+				AND lower(l.name) = ANY($7)
+		) >= CASE WHEN $8 THEN cardinality($7) ELSE 1 END
+	)
+	AND (
+		$10::boolean IS NULL OR (COALESCE(a.due_date < now(), false) AND a.status <> 'Done') = $10
+	)
 	ORDER BY a.due_date ASC, a.id ASC
 	LIMIT $6 + 1;
 	`
 
 	var aufgaben []entity.AssignedAufgaben
-	rows, err := r.db.Query(ctx, query, userID, filter.Status, filter.Priority, filter.ProjectID, filter.Cursor, filter.Limit)
+	rows, err := r.db.Query(ctx, query, userID, filter.Status, filter.Priority, filter.ProjectID, filter.Cursor, filter.Limit, filter.Labels, isLabelMatchAll(filter.LabelMatch), filter.Role, filter.Overdue)
 	if err != nil {
 		return nil, app_errors.MapPgxError(err)
 	}
@@ -413,7 +623,7 @@ func (r *AufgabenRepo) ListAssignedTasks(ctx context.Context, userID string, fil
 
 	for rows.Next() {
 		var aufgabe entity.AssignedAufgaben
-		if err := rows.Scan(&aufgabe.ID, &aufgabe.ProjectName, &aufgabe.Title, &aufgabe.Description, &aufgabe.Status, &aufgabe.Priority, &aufgabe.DueDate); err != nil {
+		if err := rows.Scan(&aufgabe.ID, &aufgabe.ProjectID, &aufgabe.ProjectName, &aufgabe.Title, &aufgabe.Description, &aufgabe.Status, &aufgabe.Priority, &aufgabe.DueDate, &aufgabe.Role); err != nil {
 			return nil, app_errors.MapPgxError(err)
 		}
 
@@ -443,6 +653,60 @@ func (r *AufgabenRepo) ArchiveTask(ctx context.Context, t tx.Tx, taskID string)
 	return nil
 }
 
+func (r *AufgabenRepo) UnarchiveTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Completed tasks go back to Done, the others back to work depending on assignee
+	query := `
+	UPDATE aufgaben
+	SET status = CASE
+			WHEN completed_at IS NOT NULL THEN 'Done'::aufgaben_status
+			WHEN assignee_id IS NOT NULL THEN 'In_Progress'::aufgaben_status
+			ELSE 'Todo'::aufgaben_status
+		END,
+		archived_at = NULL,
+		archived_by = NULL,
+		reminder_stage = 'None',
+		last_reminder_at = NULL,
+		updated_at = now()
+	WHERE id = $1
+		AND archived_at IS NOT NULL
+	RETURNING status;
+	`
+
+	var status entity.AufgabenStatus
+	if err := pgxTx.QueryRow(ctx, query, taskID).Scan(&status); err != nil {
+		return "", app_errors.MapPgxError(err)
+	}
+
+	return status, nil
+}
+
+func (r *AufgabenRepo) ReopenTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben
+	SET status = CASE
+			WHEN assignee_id IS NOT NULL THEN 'In_Progress'::aufgaben_status
+			ELSE 'Todo'::aufgaben_status
+		END,
+		completed_at = NULL,
+		reminder_stage = 'None',
+		last_reminder_at = NULL,
+		updated_at = now()
+	WHERE id = $1
+		AND status = 'Done'
+		AND archived_at IS NULL
+	RETURNING status;
+	`
+
+	var status entity.AufgabenStatus
+	if err := pgxTx.QueryRow(ctx, query, taskID).Scan(&status); err != nil {
+		return "", app_errors.MapPgxError(err)
+	}
+
+	return status, nil
+}
+
 func (r *AufgabenRepo) UpdateDueDate(ctx context.Context, t tx.Tx, taskID string, dueDate time.Time) (*time.Time, *app_errors.AppError) {
 	pgxTx := t.(*tx.PgxTx).Tx
 	query := `
@@ -464,9 +728,50 @@ func (r *AufgabenRepo) UpdateDueDate(ctx context.Context, t tx.Tx, taskID string
 	return &updatedDueDate, nil
 }
 
+func (r *AufgabenRepo) UpdateTaskContent(ctx context.Context, t tx.Tx, taskID, title string, description *string, priority entity.AufgabenPriority) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben
+	SET title = $2,
+		description = $3,
+		priority = $4,
+		updated_at = now()
+	WHERE id = $1
+		AND archived_at IS NULL
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, taskID, title, description, priority).Scan(&updatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) UpdateEstimate(ctx context.Context, t tx.Tx, taskID string, estimate *float64, unit *entity.EstimateUnit) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben
+	SET estimate = $2,
+		estimate_unit = $3,
+		updated_at = now()
+	WHERE id = $1
+		AND archived_at IS NULL
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, taskID, estimate, unit).Scan(&updatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
 func (r *AufgabenRepo) ListEventsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]entity.AssignmentEventEntity, *app_errors.AppError) {
 	query := `
-	SELECT id, aufgaben_id, actor_id, target_assignee_id, action, note, reason_code, reason_text, created_at
+	SELECT id, aufgaben_id, actor_id, target_assignee_id, action, note, reason_code, reason_text, field_name, old_value, new_value, created_at
 	FROM aufgaben_assignment_events
 	WHERE aufgaben_id = $1
 		AND (
@@ -486,10 +791,2981 @@ func (r *AufgabenRepo) ListEventsForTask(ctx context.Context, taskID string, fil
 
 	for rows.Next() {
 		var event entity.AssignmentEventEntity
-		if err := rows.Scan(&event.ID, &event.AufgabenID, &event.ActorID, &event.TargetAssigneeID, &event.Action, &event.Note, &event.ReasonCode, &event.ReasonText, &event.CreatedAt); err != nil {
+		if err := rows.Scan(&event.ID, &event.AufgabenID, &event.ActorID, &event.TargetAssigneeID, &event.Action, &event.Note, &event.ReasonCode, &event.ReasonText, &event.FieldName, &event.OldValue, &event.NewValue, &event.CreatedAt); err != nil {
 			return nil, app_errors.MapPgxError(err)
 		}
+		events = append(events, event)
 	}
 
 	return events, nil
 }
+
+func (r *AufgabenRepo) InsertNewAufgabenWithTx(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben (
+			id,
+			project_id,
+			parent_id,
+			title,
+			description,
+			status,
+			priority,
+			assignee_id,
+			created_by,
+			due_date,
+			created_at,
+			estimate,
+			estimate_unit
+		) VALUES (
+			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13
+		)
+	`
+
+	if _, err := pgxTx.Exec(
+		ctx,
+		query,
+		task.ID,
+		task.ProjectID,
+		task.ParentID,
+		task.Title,
+		task.Description,
+		task.Status,
+		task.Priority,
+		task.AssigneeID,
+		task.CreatedBy,
+		task.DueDate,
+		task.CreatedAt,
+		task.Estimate,
+		task.EstimateUnit,
+	); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) ListSubtasks(ctx context.Context, parentID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	query := `
+	SELECT a.id, a.project_id, a.parent_id, a.title, a.description, a.status, a.priority,
+	a.assignee_id, a.created_by, a.due_date, a.created_at, a.updated_at, a.archived_at,
+	a.completed_at, p.name, a.estimate::float8, a.estimate_unit
+	FROM aufgaben a
+	JOIN projects p ON p.id = a.project_id
+	WHERE a.parent_id = $1
+		AND a.archived_at IS NULL
+	ORDER BY a.created_at ASC, a.id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, parentID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var results []entity.AufgabenEntity
+	for rows.Next() {
+		var result entity.AufgabenEntity
+		if err := rows.Scan(&result.ID, &result.ProjectID, &result.ParentID, &result.Title, &result.Description, &result.Status, &result.Priority, &result.AssigneeID, &result.CreatedBy, &result.DueDate, &result.CreatedAt, &result.UpdatedAt, &result.ArchivedAt, &result.CompletedAt, &result.ProjectName, &result.Estimate, &result.EstimateUnit); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return results, nil
+}
+
+func (r *AufgabenRepo) GetSubtaskProgress(ctx context.Context, parentID string) (*entity.SubtaskProgress, *app_errors.AppError) {
+	// Archived subtasks are no longer part of the work, so they don't count towards the rollup
+	query := `
+	SELECT COUNT(*),
+		COUNT(*) FILTER (WHERE status = 'Done')
+	FROM aufgaben
+	WHERE parent_id = $1
+		AND archived_at IS NULL;
+	`
+
+	var progress entity.SubtaskProgress
+	if err := r.db.QueryRow(ctx, query, parentID).Scan(&progress.Total, &progress.Done); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &progress, nil
+}
+
+func (r *AufgabenRepo) InsertDependency(ctx context.Context, t tx.Tx, dependency *entity.AufgabenDependency) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_dependencies (
+		aufgaben_id,
+		blocked_by_id,
+		created_by,
+		created_at
+	) VALUES (
+		$1,$2,$3,$4
+	);
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, dependency.AufgabenID, dependency.BlockedByID, dependency.CreatedBy, dependency.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) DeleteDependency(ctx context.Context, t tx.Tx, taskID, blockedByID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	DELETE FROM aufgaben_dependencies
+	WHERE aufgaben_id = $1
+		AND blocked_by_id = $2;
+	`
+
+	cmd, err := pgxTx.Exec(ctx, query, taskID, blockedByID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "dependency_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) LockProjectDependencies(ctx context.Context, t tx.Tx, projectID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Serializes dependency changes and blocker checks of a project until the tx ends,
+	// so two concurrent inserts can't both pass the cycle check and a reopened blocker is never missed
+	query := `SELECT pg_advisory_xact_lock(hashtext('aufgaben_dependencies:' || $1));`
+
+	if _, err := pgxTx.Exec(ctx, query, projectID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) IsTransitivelyBlockedBy(ctx context.Context, t tx.Tx, taskID, blockedByID string) (bool, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Walk every blocker chain starting at taskID, UNION stops on already visited nodes
+	query := `
+	WITH RECURSIVE chain AS (
+		SELECT blocked_by_id
+		FROM aufgaben_dependencies
+		WHERE aufgaben_id = $1
+		UNION
+		SELECT d.blocked_by_id
+		FROM aufgaben_dependencies d
+		JOIN chain c ON d.aufgaben_id = c.blocked_by_id
+	)
+	SELECT EXISTS (
+		SELECT 1 FROM chain WHERE blocked_by_id = $2
+	);
+	`
+
+	var exists bool
+	if err := pgxTx.QueryRow(ctx, query, taskID, blockedByID).Scan(&exists); err != nil {
+		return false, app_errors.MapPgxError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *AufgabenRepo) CountOpenBlockers(ctx context.Context, t tx.Tx, taskID string) (int64, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Archived blockers are abandoned work and don't hold anything back
+	query := `
+	SELECT COUNT(*)
+	FROM aufgaben_dependencies d
+	JOIN aufgaben a ON a.id = d.blocked_by_id
+	WHERE d.aufgaben_id = $1
+		AND a.status <> 'Done'
+		AND a.archived_at IS NULL;
+	`
+
+	var count int64
+	if err := pgxTx.QueryRow(ctx, query, taskID).Scan(&count); err != nil {
+		return 0, app_errors.MapPgxError(err)
+	}
+
+	return count, nil
+}
+
+func (r *AufgabenRepo) ListBlockers(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError) {
+	query := `
+	SELECT a.id, a.title, a.status, a.assignee_id, a.archived_at, d.created_by, d.created_at
+	FROM aufgaben_dependencies d
+	JOIN aufgaben a ON a.id = d.blocked_by_id
+	WHERE d.aufgaben_id = $1
+	ORDER BY d.created_at ASC;
+	`
+
+	return r.listDependencyLinks(ctx, query, taskID)
+}
+
+func (r *AufgabenRepo) ListBlocking(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError) {
+	query := `
+	SELECT a.id, a.title, a.status, a.assignee_id, a.archived_at, d.created_by, d.created_at
+	FROM aufgaben_dependencies d
+	JOIN aufgaben a ON a.id = d.aufgaben_id
+	WHERE d.blocked_by_id = $1
+	ORDER BY d.created_at ASC;
+	`
+
+	return r.listDependencyLinks(ctx, query, taskID)
+}
+
+func (r *AufgabenRepo) listDependencyLinks(ctx context.Context, query, taskID string) ([]entity.DependencyLink, *app_errors.AppError) {
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var links []entity.DependencyLink
+	for rows.Next() {
+		var link entity.DependencyLink
+		if err := rows.Scan(&link.AufgabenID, &link.Title, &link.Status, &link.AssigneeID, &link.ArchivedAt, &link.CreatedBy, &link.CreatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		links = append(links, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return links, nil
+}
+
+func (r *AufgabenRepo) ListUnblockedDependents(ctx context.Context, t tx.Tx, blockerID string) ([]entity.UnblockedAufgaben, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Runs in the completing transaction, so the blocker itself already counts as Done
+	query := `
+	SELECT a.id, a.project_id, p.name, a.title, a.assignee_id
+	FROM aufgaben_dependencies d
+	JOIN aufgaben a ON a.id = d.aufgaben_id
+	JOIN projects p ON p.id = a.project_id
+	WHERE d.blocked_by_id = $1
+		AND a.archived_at IS NULL
+		AND a.status NOT IN ('Done', 'Archived')
+		AND NOT EXISTS (
+			SELECT 1
+			FROM aufgaben_dependencies o
+			JOIN aufgaben b ON b.id = o.blocked_by_id
+			WHERE o.aufgaben_id = a.id
+				AND b.status <> 'Done'
+				AND b.archived_at IS NULL
+		);
+	`
+
+	rows, err := pgxTx.Query(ctx, query, blockerID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var aufgaben []entity.UnblockedAufgaben
+	for rows.Next() {
+		var aufgabe entity.UnblockedAufgaben
+		if err := rows.Scan(&aufgabe.ID, &aufgabe.ProjectID, &aufgabe.ProjectName, &aufgabe.Title, &aufgabe.AssigneeID); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		aufgaben = append(aufgaben, aufgabe)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return aufgaben, nil
+}
+
+func (r *AufgabenRepo) InsertComment(ctx context.Context, t tx.Tx, comment *entity.AufgabenCommentEntity) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_comments (
+		id,
+		aufgaben_id,
+		author_id,
+		body,
+		created_at
+	) VALUES (
+		$1,$2,$3,$4,$5
+	);
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, comment.ID, comment.AufgabenID, comment.AuthorID, comment.Body, comment.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) GetCommentByID(ctx context.Context, commentID string) (*entity.AufgabenCommentEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, author_id, body, created_at, edited_at, deleted_at
+	FROM aufgaben_comments
+	WHERE id = $1
+		AND deleted_at IS NULL;
+	`
+
+	var row entity.AufgabenCommentEntity
+	if err := r.db.QueryRow(ctx, query, commentID).Scan(&row.ID, &row.AufgabenID, &row.AuthorID, &row.Body, &row.CreatedAt, &row.EditedAt, &row.DeletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "comment_not_found", nil)
+		}
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return &row, nil
+}
+
+func (r *AufgabenRepo) UpdateCommentBody(ctx context.Context, t tx.Tx, commentID, body string) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben_comments
+	SET body = $2,
+		edited_at = now()
+	WHERE id = $1
+		AND deleted_at IS NULL
+	RETURNING edited_at;
+	`
+
+	var editedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, commentID, body).Scan(&editedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "comment_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &editedAt, nil
+}
+
+func (r *AufgabenRepo) SoftDeleteComment(ctx context.Context, commentID string) *app_errors.AppError {
+	query := `
+	UPDATE aufgaben_comments
+	SET deleted_at = now()
+	WHERE id = $1
+		AND deleted_at IS NULL;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, commentID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "comment_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) ListCommentsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenCommentFilter) ([]entity.AufgabenCommentEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, author_id, body, created_at, edited_at, deleted_at
+	FROM aufgaben_comments
+	WHERE aufgaben_id = $1
+		AND deleted_at IS NULL
+		AND (
+		$2::uuid IS NULL OR id < $2
+		)
+		ORDER BY id DESC
+		LIMIT $3 + 1;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID, filters.Cursor, filters.Limit)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var comments []entity.AufgabenCommentEntity
+	for rows.Next() {
+		var comment entity.AufgabenCommentEntity
+		if err := rows.Scan(&comment.ID, &comment.AufgabenID, &comment.AuthorID, &comment.Body, &comment.CreatedAt, &comment.EditedAt, &comment.DeletedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return comments, nil
+}
+
+func (r *AufgabenRepo) FindMentionableMembers(ctx context.Context, projectID string, usernames []string) ([]entity.MentionedUser, *app_errors.AppError) {
+	query := `
+	SELECT u.id, u.username
+	FROM project_members pm
+	JOIN users u ON u.id = pm.user_id
+	WHERE pm.project_id = $1
+		AND pm.deleted_at IS NULL
+		AND u.is_active = TRUE
+		AND lower(u.username) = ANY($2::text[]);
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID, usernames)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var users []entity.MentionedUser
+	for rows.Next() {
+		var user entity.MentionedUser
+		if err := rows.Scan(&user.UserID, &user.Username); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return users, nil
+}
+
+func (r *AufgabenRepo) InsertCommentMentions(ctx context.Context, t tx.Tx, commentID string, userIDs []string) ([]string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Already stored mentions are skipped, so only newly mentioned users are returned
+	query := `
+	INSERT INTO aufgaben_comment_mentions (comment_id, user_id)
+	SELECT $1, unnest($2::uuid[])
+	ON CONFLICT (comment_id, user_id) DO NOTHING
+	RETURNING user_id;
+	`
+
+	rows, err := pgxTx.Query(ctx, query, commentID, userIDs)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var inserted []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		inserted = append(inserted, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return inserted, nil
+}
+
+func (r *AufgabenRepo) ListCommentMentions(ctx context.Context, commentIDs []string) ([]entity.CommentMention, *app_errors.AppError) {
+	// Mentions stay stored after an edit removed them, the caller keeps the ones still in the body
+	query := `
+	SELECT m.comment_id, u.id, u.username
+	FROM aufgaben_comment_mentions m
+	JOIN users u ON u.id = m.user_id
+	WHERE m.comment_id = ANY($1::uuid[])
+	ORDER BY m.comment_id, m.created_at ASC, u.username ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, commentIDs)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var mentions []entity.CommentMention
+	for rows.Next() {
+		var m entity.CommentMention
+		if err := rows.Scan(&m.CommentID, &m.UserID, &m.Username); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		mentions = append(mentions, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return mentions, nil
+}
+
+// labelMatchClause filters by label names, matching any of them or all of them depending on the bool parameter
+func labelMatchClause(labelsPos, matchAllPos int) string {
+	return fmt.Sprintf(`
+	AND (
+		SELECT COUNT(DISTINCT lower(l.name))
+		FROM aufgaben_labels al
+		JOIN project_labels l ON l.id = al.label_id
+		WHERE al.aufgaben_id = a.id
+			AND lower(l.name) = ANY($%d::text[])
+	) >= CASE WHEN $%d::boolean THEN cardinality($%d::text[]) ELSE 1 END`, labelsPos, matchAllPos, labelsPos)
+}
+
+func isLabelMatchAll(labelMatch *string) bool {
+	return labelMatch != nil && *labelMatch == "all"
+}
+
+func (r *AufgabenRepo) InsertLabel(ctx context.Context, label *entity.LabelEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO project_labels (
+		id,
+		project_id,
+		name,
+		color,
+		created_by,
+		created_at
+	) VALUES (
+		$1,$2,$3,$4,$5,$6
+	);
+	`
+
+	if _, err := r.db.Exec(ctx, query, label.ID, label.ProjectID, label.Name, label.Color, label.CreatedBy, label.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) GetLabelByID(ctx context.Context, labelID string) (*entity.LabelEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, name, color, created_by, created_at, updated_at
+	FROM project_labels
+	WHERE id = $1;
+	`
+
+	var row entity.LabelEntity
+	if err := r.db.QueryRow(ctx, query, labelID).Scan(&row.ID, &row.ProjectID, &row.Name, &row.Color, &row.CreatedBy, &row.CreatedAt, &row.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "label_not_found", nil)
+		}
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return &row, nil
+}
+
+func (r *AufgabenRepo) ListLabels(ctx context.Context, projectID string) ([]entity.LabelEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, name, color, created_by, created_at, updated_at
+	FROM project_labels
+	WHERE project_id = $1
+	ORDER BY lower(name) ASC;
+	`
+
+	return r.queryLabels(ctx, query, projectID)
+}
+
+func (r *AufgabenRepo) ListLabelsForTask(ctx context.Context, taskID string) ([]entity.LabelEntity, *app_errors.AppError) {
+	query := `
+	SELECT l.id, l.project_id, l.name, l.color, l.created_by, l.created_at, l.updated_at
+	FROM aufgaben_labels al
+	JOIN project_labels l ON l.id = al.label_id
+	WHERE al.aufgaben_id = $1
+	ORDER BY lower(l.name) ASC;
+	`
+
+	return r.queryLabels(ctx, query, taskID)
+}
+
+func (r *AufgabenRepo) queryLabels(ctx context.Context, query string, args ...any) ([]entity.LabelEntity, *app_errors.AppError) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var labels []entity.LabelEntity
+	for rows.Next() {
+		var label entity.LabelEntity
+		if err := rows.Scan(&label.ID, &label.ProjectID, &label.Name, &label.Color, &label.CreatedBy, &label.CreatedAt, &label.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		labels = append(labels, label)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return labels, nil
+}
+
+func (r *AufgabenRepo) UpdateLabel(ctx context.Context, labelID string, name, color *string) (*entity.LabelEntity, *app_errors.AppError) {
+	query := `
+	UPDATE project_labels
+	SET name = COALESCE($2, name),
+		color = COALESCE($3, color),
+		updated_at = now()
+	WHERE id = $1
+	RETURNING id, project_id, name, color, created_by, created_at, updated_at;
+	`
+
+	var row entity.LabelEntity
+	if err := r.db.QueryRow(ctx, query, labelID, name, color).Scan(&row.ID, &row.ProjectID, &row.Name, &row.Color, &row.CreatedBy, &row.CreatedAt, &row.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "label_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &row, nil
+}
+
+func (r *AufgabenRepo) DeleteLabel(ctx context.Context, t tx.Tx, labelID string) ([]string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Collect the labelled tasks before the cascade removes the links
+	query := `
+	SELECT aufgaben_id
+	FROM aufgaben_labels
+	WHERE label_id = $1;
+	`
+
+	rows, err := pgxTx.Query(ctx, query, labelID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	var taskIDs []string
+	for rows.Next() {
+		var taskID string
+		if err := rows.Scan(&taskID); err != nil {
+			rows.Close()
+			return nil, app_errors.MapPgxError(err)
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	if _, err := pgxTx.Exec(ctx, `DELETE FROM project_labels WHERE id = $1;`, labelID); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return taskIDs, nil
+}
+
+func (r *AufgabenRepo) AttachLabel(ctx context.Context, t tx.Tx, taskID, labelID, userID string) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_labels (aufgaben_id, label_id, created_by)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (aufgaben_id, label_id) DO NOTHING
+	RETURNING created_at;
+	`
+
+	var attachedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, taskID, labelID, userID).Scan(&attachedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.label_already_attached", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &attachedAt, nil
+}
+
+func (r *AufgabenRepo) DetachLabel(ctx context.Context, t tx.Tx, taskID, labelID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	DELETE FROM aufgaben_labels
+	WHERE aufgaben_id = $1
+		AND label_id = $2;
+	`
+
+	cmd, err := pgxTx.Exec(ctx, query, taskID, labelID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "label_not_attached", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) SearchTasks(ctx context.Context, userID string, filter *aufgaben_dto.AufgabenSearchFilter) ([]entity.AufgabenSearchResult, *app_errors.AppError) {
+	// Membership is joined the same way CheckProjectMember checks it, comment matches count half
+	query := `
+	WITH q AS (
+		SELECT websearch_to_tsquery('simple', $1) AS query
+	)
+	SELECT a.id, a.project_id, p.name, a.title, a.status, a.priority, a.assignee_id, a.due_date,
+		ts_rank(a.search_vector, q.query) + COALESCE(c.rank, 0) * 0.5 AS rank,
+		ts_headline('simple', a.title || ' ' || coalesce(a.description, ''), q.query,
+			'StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2') AS snippet,
+		c.snippet AS comment_snippet,
+		COUNT(*) OVER() AS total
+	FROM aufgaben a
+	JOIN projects p ON p.id = a.project_id
+	JOIN project_members pm ON pm.project_id = a.project_id AND pm.user_id = $2
+	CROSS JOIN q
+	LEFT JOIN LATERAL (
+		SELECT ts_rank(ac.search_vector, q.query) AS rank,
+			ts_headline('simple', ac.body, q.query,
+				'StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=1') AS snippet
+		FROM aufgaben_comments ac
+		WHERE ac.aufgaben_id = a.id
+			AND ac.deleted_at IS NULL
+			AND ac.search_vector @@ q.query
+		ORDER BY rank DESC
+		LIMIT 1
+	) c ON TRUE
+	WHERE a.archived_at IS NULL
+		AND (a.search_vector @@ q.query OR c.rank IS NOT NULL)
+		AND (
+			$3::uuid IS NULL OR a.project_id = $3
+		)
+		AND (
+			$4::aufgaben_status IS NULL OR a.status = $4
+		)
+		AND (
+			$5::aufgaben_priority IS NULL OR a.priority = $5
+		)
+	ORDER BY rank DESC, a.id DESC
+	LIMIT $6 OFFSET $7;
+	`
+
+	offset := (filter.Page - 1) * filter.Limit
+
+	rows, err := r.db.Query(ctx, query, filter.Query, userID, filter.ProjectID, filter.Status, filter.Priority, filter.Limit, offset)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var results []entity.AufgabenSearchResult
+	for rows.Next() {
+		var result entity.AufgabenSearchResult
+		if err := rows.Scan(&result.ID, &result.ProjectID, &result.ProjectName, &result.Title, &result.Status, &result.Priority, &result.AssigneeID, &result.DueDate, &result.Rank, &result.Snippet, &result.CommentSnippet, &result.Total); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return results, nil
+}
+
+func (r *AufgabenRepo) GetProjectWorkflow(ctx context.Context, projectID string) (*entity.ProjectWorkflow, *app_errors.AppError) {
+	statusQuery := `
+	SELECT key, name, category, position
+	FROM project_workflow_statuses
+	WHERE project_id = $1
+	ORDER BY position ASC;
+	`
+
+	rows, err := r.db.Query(ctx, statusQuery, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	var statuses []entity.WorkflowStatusEntity
+	for rows.Next() {
+		var status entity.WorkflowStatusEntity
+		if err := rows.Scan(&status.Key, &status.Name, &status.Category, &status.Position); err != nil {
+			rows.Close()
+			return nil, app_errors.MapPgxError(err)
+		}
+		statuses = append(statuses, status)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	// No custom workflow defined for this project
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	transitionQuery := `
+	SELECT from_key, to_key, allowed_roles::text[]
+	FROM project_workflow_transitions
+	WHERE project_id = $1
+	ORDER BY from_key, to_key;
+	`
+
+	rows, err = r.db.Query(ctx, transitionQuery, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var transitions []entity.WorkflowTransitionEntity
+	for rows.Next() {
+		var transition entity.WorkflowTransitionEntity
+		var roles []string
+		if err := rows.Scan(&transition.FromKey, &transition.ToKey, &roles); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		for _, role := range roles {
+			transition.AllowedRoles = append(transition.AllowedRoles, entity.UserRole(role))
+		}
+		transitions = append(transitions, transition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &entity.ProjectWorkflow{
+		ProjectID:   projectID,
+		Statuses:    statuses,
+		Transitions: transitions,
+	}, nil
+}
+
+func (r *AufgabenRepo) ReplaceProjectWorkflow(ctx context.Context, t tx.Tx, userID string, workflow *entity.ProjectWorkflow) ([]string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+
+	// Transitions are removed by the cascade
+	if _, err := pgxTx.Exec(ctx, `DELETE FROM project_workflow_statuses WHERE project_id = $1;`, workflow.ProjectID); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	statusQuery := `
+	INSERT INTO project_workflow_statuses (project_id, key, name, category, position, created_by)
+	VALUES ($1, $2, $3, $4, $5, $6);
+	`
+	for _, status := range workflow.Statuses {
+		if _, err := pgxTx.Exec(ctx, statusQuery, workflow.ProjectID, status.Key, status.Name, status.Category, status.Position, userID); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+	}
+
+	transitionQuery := `
+	INSERT INTO project_workflow_transitions (project_id, from_key, to_key, allowed_roles)
+	VALUES ($1, $2, $3, $4::text[]::user_role_enum[]);
+	`
+	for _, transition := range workflow.Transitions {
+		roles := make([]string, 0, len(transition.AllowedRoles))
+		for _, role := range transition.AllowedRoles {
+			roles = append(roles, string(role))
+		}
+		if _, err := pgxTx.Exec(ctx, transitionQuery, workflow.ProjectID, transition.FromKey, transition.ToKey, roles); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+	}
+
+	// WIP limits of removed statuses go with them
+	if _, err := pgxTx.Exec(ctx, `
+	DELETE FROM project_board_columns bc
+	WHERE bc.project_id = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM project_workflow_statuses ws
+			WHERE ws.project_id = bc.project_id
+				AND ws.key = bc.status_key
+		);`, workflow.ProjectID); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	// Tasks whose status vanished or changed category fall back to their category default
+	resetQuery := `
+	UPDATE aufgaben a
+	SET workflow_status = NULL
+	WHERE a.project_id = $1
+		AND a.workflow_status IS NOT NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM project_workflow_statuses ws
+			WHERE ws.project_id = a.project_id
+				AND ws.key = a.workflow_status
+				AND ws.category = a.status
+		)
+	RETURNING a.id;
+	`
+
+	rows, err := pgxTx.Query(ctx, resetQuery, workflow.ProjectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var taskIDs []string
+	for rows.Next() {
+		var taskID string
+		if err := rows.Scan(&taskID); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return taskIDs, nil
+}
+
+func (r *AufgabenRepo) UpdateWorkflowStatus(ctx context.Context, t tx.Tx, taskID, statusKey string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben
+	SET workflow_status = $2,
+		updated_at = now()
+	WHERE id = $1;
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, taskID, statusKey); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) GetRunningWorklog(ctx context.Context, userID string) (*entity.WorklogEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, user_id, source, started_at, ended_at, note, created_at, updated_at
+	FROM aufgaben_worklogs
+	WHERE user_id = $1
+		AND ended_at IS NULL;
+	`
+
+	var worklog entity.WorklogEntity
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&worklog.ID, &worklog.AufgabenID, &worklog.UserID, &worklog.Source, &worklog.StartedAt, &worklog.EndedAt, &worklog.Note, &worklog.CreatedAt, &worklog.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &worklog, nil
+}
+
+func (r *AufgabenRepo) GetWorklogByID(ctx context.Context, worklogID string) (*entity.WorklogEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, user_id, source, started_at, ended_at, note, created_at, updated_at
+	FROM aufgaben_worklogs
+	WHERE id = $1;
+	`
+
+	var worklog entity.WorklogEntity
+	if err := r.db.QueryRow(ctx, query, worklogID).Scan(&worklog.ID, &worklog.AufgabenID, &worklog.UserID, &worklog.Source, &worklog.StartedAt, &worklog.EndedAt, &worklog.Note, &worklog.CreatedAt, &worklog.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "worklog_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &worklog, nil
+}
+
+func (r *AufgabenRepo) InsertWorklog(ctx context.Context, worklog *entity.WorklogEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO aufgaben_worklogs (id, aufgaben_id, user_id, source, started_at, ended_at, note, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+	`
+
+	if _, err := r.db.Exec(ctx, query, worklog.ID, worklog.AufgabenID, worklog.UserID, worklog.Source, worklog.StartedAt, worklog.EndedAt, worklog.Note, worklog.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) StopWorklog(ctx context.Context, worklogID string) (*time.Time, *app_errors.AppError) {
+	query := `
+	UPDATE aufgaben_worklogs
+	SET ended_at = now(),
+		updated_at = now()
+	WHERE id = $1
+		AND ended_at IS NULL
+	RETURNING ended_at;
+	`
+
+	var endedAt time.Time
+	if err := r.db.QueryRow(ctx, query, worklogID).Scan(&endedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "timer_not_running", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &endedAt, nil
+}
+
+func (r *AufgabenRepo) UpdateWorklog(ctx context.Context, worklog *entity.WorklogEntity) (*time.Time, *app_errors.AppError) {
+	query := `
+	UPDATE aufgaben_worklogs
+	SET started_at = $2,
+		ended_at = $3,
+		note = $4,
+		updated_at = now()
+	WHERE id = $1
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := r.db.QueryRow(ctx, query, worklog.ID, worklog.StartedAt, worklog.EndedAt, worklog.Note).Scan(&updatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) DeleteWorklog(ctx context.Context, worklogID string) *app_errors.AppError {
+	if _, err := r.db.Exec(ctx, `DELETE FROM aufgaben_worklogs WHERE id = $1;`, worklogID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) ListWorklogsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenWorklogFilter) ([]entity.WorklogEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, user_id, source, started_at, ended_at, note, created_at, updated_at
+	FROM aufgaben_worklogs
+	WHERE aufgaben_id = $1
+		AND (
+		$2::uuid IS NULL OR id < $2
+		)
+		ORDER BY id DESC
+		LIMIT $3 + 1;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID, filters.Cursor, filters.Limit)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var worklogs []entity.WorklogEntity
+	for rows.Next() {
+		var worklog entity.WorklogEntity
+		if err := rows.Scan(&worklog.ID, &worklog.AufgabenID, &worklog.UserID, &worklog.Source, &worklog.StartedAt, &worklog.EndedAt, &worklog.Note, &worklog.CreatedAt, &worklog.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		worklogs = append(worklogs, worklog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return worklogs, nil
+}
+
+func (r *AufgabenRepo) GetTaskWorklogTotals(ctx context.Context, taskID string) ([]entity.WorklogTotal, *app_errors.AppError) {
+	// Running timers are not counted until they are stopped
+	query := `
+	SELECT u.id, u.username,
+		ROUND(SUM(EXTRACT(EPOCH FROM (w.ended_at - w.started_at))) / 60)::int AS total_minutes
+	FROM aufgaben_worklogs w
+	JOIN users u ON u.id = w.user_id
+	WHERE w.aufgaben_id = $1
+		AND w.ended_at IS NOT NULL
+	GROUP BY u.id, u.username
+	ORDER BY total_minutes DESC, u.username ASC;
+	`
+
+	return r.queryWorklogTotals(ctx, query, taskID)
+}
+
+func (r *AufgabenRepo) GetProjectTimeReport(ctx context.Context, projectID string, filter *aufgaben_dto.TimeReportFilter) ([]entity.WorklogTotal, []entity.WorklogTotal, *app_errors.AppError) {
+	// Worklogs are reported by the day they started, [from, to] is inclusive
+	memberQuery := `
+	SELECT u.id, u.username,
+		ROUND(SUM(EXTRACT(EPOCH FROM (w.ended_at - w.started_at))) / 60)::int AS total_minutes
+	FROM aufgaben_worklogs w
+	JOIN aufgaben a ON a.id = w.aufgaben_id
+	JOIN users u ON u.id = w.user_id
+	WHERE a.project_id = $1
+		AND w.ended_at IS NOT NULL
+		AND w.started_at >= $2::date
+		AND w.started_at < $3::date + 1
+		AND (
+			$4::uuid IS NULL OR w.user_id = $4
+		)
+	GROUP BY u.id, u.username
+	ORDER BY total_minutes DESC, u.username ASC;
+	`
+
+	members, err := r.queryWorklogTotals(ctx, memberQuery, projectID, filter.From, filter.To, filter.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	taskQuery := `
+	SELECT a.id, a.title,
+		ROUND(SUM(EXTRACT(EPOCH FROM (w.ended_at - w.started_at))) / 60)::int AS total_minutes
+	FROM aufgaben_worklogs w
+	JOIN aufgaben a ON a.id = w.aufgaben_id
+	WHERE a.project_id = $1
+		AND w.ended_at IS NOT NULL
+		AND w.started_at >= $2::date
+		AND w.started_at < $3::date + 1
+		AND (
+			$4::uuid IS NULL OR w.user_id = $4
+		)
+	GROUP BY a.id, a.title
+	ORDER BY total_minutes DESC, a.title ASC;
+	`
+
+	tasks, err := r.queryWorklogTotals(ctx, taskQuery, projectID, filter.From, filter.To, filter.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return members, tasks, nil
+}
+
+func (r *AufgabenRepo) queryWorklogTotals(ctx context.Context, query string, args ...any) ([]entity.WorklogTotal, *app_errors.AppError) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var totals []entity.WorklogTotal
+	for rows.Next() {
+		var total entity.WorklogTotal
+		if err := rows.Scan(&total.ID, &total.Name, &total.TotalMinutes); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return totals, nil
+}
+
+func (r *AufgabenRepo) GetProjectVelocity(ctx context.Context, projectID string, from, to time.Time) ([]entity.VelocityWeek, *app_errors.AppError) {
+	// Planned work is bucketed by due_date, done work by completed_at.
+	// Archived tasks only count as planned when they were completed before.
+	query := `
+	WITH weeks AS (
+		SELECT generate_series(date_trunc('week', $2::date), date_trunc('week', $3::date), interval '1 week') AS week_start
+	),
+	planned AS (
+		SELECT date_trunc('week', due_date) AS week_start,
+			COALESCE(SUM(estimate) FILTER (WHERE estimate_unit = 'Points'), 0) AS points,
+			COALESCE(SUM(estimate) FILTER (WHERE estimate_unit = 'Hours'), 0) AS hours,
+			COUNT(*) AS tasks
+		FROM aufgaben
+		WHERE project_id = $1
+			AND due_date >= date_trunc('week', $2::date)
+			AND due_date < date_trunc('week', $3::date) + interval '1 week'
+			AND (archived_at IS NULL OR completed_at IS NOT NULL)
+		GROUP BY 1
+	),
+	completed AS (
+		SELECT date_trunc('week', completed_at) AS week_start,
+			COALESCE(SUM(estimate) FILTER (WHERE estimate_unit = 'Points'), 0) AS points,
+			COALESCE(SUM(estimate) FILTER (WHERE estimate_unit = 'Hours'), 0) AS hours,
+			COUNT(*) AS tasks
+		FROM aufgaben
+		WHERE project_id = $1
+			AND completed_at >= date_trunc('week', $2::date)
+			AND completed_at < date_trunc('week', $3::date) + interval '1 week'
+		GROUP BY 1
+	)
+	SELECT w.week_start,
+		COALESCE(p.points, 0)::float8, COALESCE(p.hours, 0)::float8, COALESCE(p.tasks, 0)::int,
+		COALESCE(c.points, 0)::float8, COALESCE(c.hours, 0)::float8, COALESCE(c.tasks, 0)::int
+	FROM weeks w
+	LEFT JOIN planned p ON p.week_start = w.week_start
+	LEFT JOIN completed c ON c.week_start = w.week_start
+	ORDER BY w.week_start ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID, from, to)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var weeks []entity.VelocityWeek
+	for rows.Next() {
+		var week entity.VelocityWeek
+		if err := rows.Scan(&week.WeekStart, &week.PlannedPoints, &week.PlannedHours, &week.PlannedTasks, &week.CompletedPoints, &week.CompletedHours, &week.CompletedTasks); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		weeks = append(weeks, week)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return weeks, nil
+}
+
+func (r *AufgabenRepo) InsertRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO aufgaben_recurrences (id, project_id, title, description, priority, default_assignee_id, due_offset_minutes, cron_expr, next_run_at, active, created_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+	`
+
+	if _, err := r.db.Exec(ctx, query, recurrence.ID, recurrence.ProjectID, recurrence.Title, recurrence.Description, recurrence.Priority, recurrence.DefaultAssigneeID, recurrence.DueOffsetMinutes, recurrence.CronExpr, recurrence.NextRunAt, recurrence.Active, recurrence.CreatedBy, recurrence.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) GetRecurrenceByID(ctx context.Context, recurrenceID string) (*entity.RecurrenceEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, title, description, priority, default_assignee_id, due_offset_minutes, cron_expr, next_run_at, last_run_at, active, created_by, created_at, updated_at
+	FROM aufgaben_recurrences
+	WHERE id = $1;
+	`
+
+	var rec entity.RecurrenceEntity
+	if err := r.db.QueryRow(ctx, query, recurrenceID).Scan(&rec.ID, &rec.ProjectID, &rec.Title, &rec.Description, &rec.Priority, &rec.DefaultAssigneeID, &rec.DueOffsetMinutes, &rec.CronExpr, &rec.NextRunAt, &rec.LastRunAt, &rec.Active, &rec.CreatedBy, &rec.CreatedAt, &rec.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "recurrence_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &rec, nil
+}
+
+func (r *AufgabenRepo) ListRecurrences(ctx context.Context, projectID string) ([]entity.RecurrenceEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, title, description, priority, default_assignee_id, due_offset_minutes, cron_expr, next_run_at, last_run_at, active, created_by, created_at, updated_at
+	FROM aufgaben_recurrences
+	WHERE project_id = $1
+	ORDER BY created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var recurrences []entity.RecurrenceEntity
+	for rows.Next() {
+		var rec entity.RecurrenceEntity
+		if err := rows.Scan(&rec.ID, &rec.ProjectID, &rec.Title, &rec.Description, &rec.Priority, &rec.DefaultAssigneeID, &rec.DueOffsetMinutes, &rec.CronExpr, &rec.NextRunAt, &rec.LastRunAt, &rec.Active, &rec.CreatedBy, &rec.CreatedAt, &rec.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		recurrences = append(recurrences, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return recurrences, nil
+}
+
+func (r *AufgabenRepo) UpdateRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) (*time.Time, *app_errors.AppError) {
+	query := `
+	UPDATE aufgaben_recurrences
+	SET title = $2,
+		description = $3,
+		priority = $4,
+		default_assignee_id = $5,
+		due_offset_minutes = $6,
+		cron_expr = $7,
+		next_run_at = $8,
+		active = $9,
+		updated_at = now()
+	WHERE id = $1
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := r.db.QueryRow(ctx, query, recurrence.ID, recurrence.Title, recurrence.Description, recurrence.Priority, recurrence.DefaultAssigneeID, recurrence.DueOffsetMinutes, recurrence.CronExpr, recurrence.NextRunAt, recurrence.Active).Scan(&updatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) DeleteRecurrence(ctx context.Context, recurrenceID string) *app_errors.AppError {
+	// Generated tasks stay, their recurrence_id is set to NULL
+	if _, err := r.db.Exec(ctx, `DELETE FROM aufgaben_recurrences WHERE id = $1;`, recurrenceID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) InsertTemplate(ctx context.Context, template *entity.TemplateEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO aufgaben_templates (id, project_id, name, title, description, priority, due_offset_minutes, estimate, estimate_unit, created_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+	`
+
+	if _, err := r.db.Exec(ctx, query, template.ID, template.ProjectID, template.Name, template.Title, template.Description, template.Priority, template.DueOffsetMinutes, template.Estimate, template.EstimateUnit, template.CreatedBy, template.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) GetTemplateByID(ctx context.Context, templateID string) (*entity.TemplateEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, name, title, description, priority, due_offset_minutes, estimate, estimate_unit, created_by, created_at, updated_at
+	FROM aufgaben_templates
+	WHERE id = $1;
+	`
+
+	var tpl entity.TemplateEntity
+	if err := r.db.QueryRow(ctx, query, templateID).Scan(&tpl.ID, &tpl.ProjectID, &tpl.Name, &tpl.Title, &tpl.Description, &tpl.Priority, &tpl.DueOffsetMinutes, &tpl.Estimate, &tpl.EstimateUnit, &tpl.CreatedBy, &tpl.CreatedAt, &tpl.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "template_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &tpl, nil
+}
+
+func (r *AufgabenRepo) ListTemplates(ctx context.Context, projectID string) ([]entity.TemplateEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, name, title, description, priority, due_offset_minutes, estimate, estimate_unit, created_by, created_at, updated_at
+	FROM aufgaben_templates
+	WHERE project_id = $1
+	ORDER BY lower(name) ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var templates []entity.TemplateEntity
+	for rows.Next() {
+		var tpl entity.TemplateEntity
+		if err := rows.Scan(&tpl.ID, &tpl.ProjectID, &tpl.Name, &tpl.Title, &tpl.Description, &tpl.Priority, &tpl.DueOffsetMinutes, &tpl.Estimate, &tpl.EstimateUnit, &tpl.CreatedBy, &tpl.CreatedAt, &tpl.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		templates = append(templates, tpl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return templates, nil
+}
+
+func (r *AufgabenRepo) UpdateTemplate(ctx context.Context, template *entity.TemplateEntity) (*time.Time, *app_errors.AppError) {
+	query := `
+	UPDATE aufgaben_templates
+	SET name = $2,
+		title = $3,
+		description = $4,
+		priority = $5,
+		due_offset_minutes = $6,
+		estimate = $7,
+		estimate_unit = $8,
+		updated_at = now()
+	WHERE id = $1
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := r.db.QueryRow(ctx, query, template.ID, template.Name, template.Title, template.Description, template.Priority, template.DueOffsetMinutes, template.Estimate, template.EstimateUnit).Scan(&updatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) DeleteTemplate(ctx context.Context, templateID string) *app_errors.AppError {
+	if _, err := r.db.Exec(ctx, `DELETE FROM aufgaben_templates WHERE id = $1;`, templateID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) ListDueRecurrences(ctx context.Context, t tx.Tx, limit int) ([]entity.RecurrenceEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Rows stay locked until commit, a second scheduler run skips them
+	query := `
+	SELECT id, project_id, title, description, priority, default_assignee_id, due_offset_minutes, cron_expr, next_run_at, last_run_at, active, created_by, created_at, updated_at
+	FROM aufgaben_recurrences
+	WHERE active = true
+		AND next_run_at <= now()
+	ORDER BY next_run_at ASC
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED;
+	`
+
+	rows, err := pgxTx.Query(ctx, query, limit)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var recurrences []entity.RecurrenceEntity
+	for rows.Next() {
+		var rec entity.RecurrenceEntity
+		if err := rows.Scan(&rec.ID, &rec.ProjectID, &rec.Title, &rec.Description, &rec.Priority, &rec.DefaultAssigneeID, &rec.DueOffsetMinutes, &rec.CronExpr, &rec.NextRunAt, &rec.LastRunAt, &rec.Active, &rec.CreatedBy, &rec.CreatedAt, &rec.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		recurrences = append(recurrences, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return recurrences, nil
+}
+
+func (r *AufgabenRepo) InsertRecurringAufgabe(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) (bool, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// The unique (recurrence_id, occurrence_at) index makes a replayed occurrence a no-op
+	query := `
+	INSERT INTO aufgaben (
+			id,
+			project_id,
+			title,
+			description,
+			status,
+			priority,
+			assignee_id,
+			created_by,
+			due_date,
+			created_at,
+			recurrence_id,
+			occurrence_at
+		) VALUES (
+			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12
+		)
+	ON CONFLICT (recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL DO NOTHING;
+	`
+
+	cmd, err := pgxTx.Exec(
+		ctx,
+		query,
+		task.ID,
+		task.ProjectID,
+		task.Title,
+		task.Description,
+		task.Status,
+		task.Priority,
+		task.AssigneeID,
+		task.CreatedBy,
+		task.DueDate,
+		task.CreatedAt,
+		task.RecurrenceID,
+		task.OccurrenceAt,
+	)
+	if err != nil {
+		return false, app_errors.MapPgxError(err)
+	}
+
+	return cmd.RowsAffected() == 1, nil
+}
+
+func (r *AufgabenRepo) AdvanceRecurrence(ctx context.Context, t tx.Tx, recurrenceID string, occurrenceAt, nextRunAt time.Time) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben_recurrences
+	SET last_run_at = $2,
+		next_run_at = $3
+	WHERE id = $1
+		AND next_run_at = $2;
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, recurrenceID, occurrenceAt, nextRunAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) LockAttachmentQuota(ctx context.Context, t tx.Tx, projectID string) (*entity.AttachmentQuota, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// The project row stays locked until commit, concurrent uploads of the same project are checked one after another
+	query := `
+	SELECT p.attachment_quota_bytes,
+		COALESCE((SELECT SUM(size_bytes) FROM aufgaben_attachments WHERE project_id = p.id), 0)::BIGINT
+	FROM projects p
+	WHERE p.id = $1
+	FOR UPDATE;
+	`
+
+	var quota entity.AttachmentQuota
+	if err := pgxTx.QueryRow(ctx, query, projectID).Scan(&quota.QuotaBytes, &quota.UsedBytes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "project_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &quota, nil
+}
+
+func (r *AufgabenRepo) InsertAttachment(ctx context.Context, t tx.Tx, attachment *entity.AttachmentEntity) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_attachments (id, aufgaben_id, project_id, file_name, content_type, size_bytes, storage_key, uploaded_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, attachment.ID, attachment.AufgabenID, attachment.ProjectID, attachment.FileName, attachment.ContentType, attachment.SizeBytes, attachment.StorageKey, attachment.UploadedBy, attachment.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) GetAttachmentByID(ctx context.Context, attachmentID string) (*entity.AttachmentEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, project_id, file_name, content_type, size_bytes, storage_key, uploaded_by, created_at
+	FROM aufgaben_attachments
+	WHERE id = $1;
+	`
+
+	var a entity.AttachmentEntity
+	if err := r.db.QueryRow(ctx, query, attachmentID).Scan(&a.ID, &a.AufgabenID, &a.ProjectID, &a.FileName, &a.ContentType, &a.SizeBytes, &a.StorageKey, &a.UploadedBy, &a.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "attachment_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &a, nil
+}
+
+func (r *AufgabenRepo) ListAttachmentsForTask(ctx context.Context, taskID string) ([]entity.AttachmentEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, aufgaben_id, project_id, file_name, content_type, size_bytes, storage_key, uploaded_by, created_at
+	FROM aufgaben_attachments
+	WHERE aufgaben_id = $1
+	ORDER BY created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var attachments []entity.AttachmentEntity
+	for rows.Next() {
+		var a entity.AttachmentEntity
+		if err := rows.Scan(&a.ID, &a.AufgabenID, &a.ProjectID, &a.FileName, &a.ContentType, &a.SizeBytes, &a.StorageKey, &a.UploadedBy, &a.CreatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return attachments, nil
+}
+
+func (r *AufgabenRepo) DeleteAttachment(ctx context.Context, t tx.Tx, attachmentID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	if _, err := pgxTx.Exec(ctx, `DELETE FROM aufgaben_attachments WHERE id = $1;`, attachmentID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) AddWatcher(ctx context.Context, taskID, userID string) (*time.Time, *app_errors.AppError) {
+	// Watching twice keeps the original subscription date
+	query := `
+	INSERT INTO aufgaben_watchers (aufgaben_id, user_id)
+	VALUES ($1, $2)
+	ON CONFLICT (aufgaben_id, user_id) DO UPDATE SET created_at = aufgaben_watchers.created_at
+	RETURNING created_at;
+	`
+
+	var createdAt time.Time
+	if err := r.db.QueryRow(ctx, query, taskID, userID).Scan(&createdAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &createdAt, nil
+}
+
+func (r *AufgabenRepo) RemoveWatcher(ctx context.Context, taskID, userID string) *app_errors.AppError {
+	query := `
+	DELETE FROM aufgaben_watchers
+	WHERE aufgaben_id = $1
+		AND user_id = $2;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, taskID, userID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "not_watching_task", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) ListWatchers(ctx context.Context, taskID string) ([]entity.WatcherEntity, *app_errors.AppError) {
+	query := `
+	SELECT w.aufgaben_id, w.user_id, u.username, w.created_at
+	FROM aufgaben_watchers w
+	JOIN users u ON u.id = w.user_id
+	WHERE w.aufgaben_id = $1
+	ORDER BY w.created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var watchers []entity.WatcherEntity
+	for rows.Next() {
+		var w entity.WatcherEntity
+		if err := rows.Scan(&w.AufgabenID, &w.UserID, &w.Username, &w.CreatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		watchers = append(watchers, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return watchers, nil
+}
+
+func (r *AufgabenRepo) ListWatcherContacts(ctx context.Context, taskID string) ([]entity.WatcherContact, *app_errors.AppError) {
+	// Watchers who left the project or were deactivated are not notified anymore
+	query := `
+	SELECT u.id, u.username, u.email
+	FROM aufgaben_watchers w
+	JOIN aufgaben a ON a.id = w.aufgaben_id
+	JOIN project_members pm ON pm.project_id = a.project_id AND pm.user_id = w.user_id
+	JOIN users u ON u.id = w.user_id
+	WHERE w.aufgaben_id = $1
+		AND pm.deleted_at IS NULL
+		AND u.is_active = TRUE;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var contacts []entity.WatcherContact
+	for rows.Next() {
+		var c entity.WatcherContact
+		if err := rows.Scan(&c.UserID, &c.Username, &c.Email); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		contacts = append(contacts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return contacts, nil
+}
+
+func (r *AufgabenRepo) InsertHandoverRequest(ctx context.Context, t tx.Tx, request *entity.HandoverRequestEntity) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_handover_requests (id, aufgaben_id, project_id, requested_by, target_assignee_id, note, status, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, request.ID, request.AufgabenID, request.ProjectID, request.RequestedBy, request.TargetAssigneeID, request.Note, request.Status, request.ExpiresAt, request.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) ListPendingHandoverRequests(ctx context.Context, projectID string) ([]entity.HandoverRequestEntity, *app_errors.AppError) {
+	// Requests past their expiry are hidden even before the worker marks them expired
+	query := `
+	SELECT hr.id, hr.aufgaben_id, hr.project_id, hr.requested_by, hr.target_assignee_id, hr.note, hr.status, hr.expires_at, hr.created_at, a.title
+	FROM aufgaben_handover_requests hr
+	JOIN aufgaben a ON a.id = hr.aufgaben_id
+	WHERE hr.project_id = $1
+		AND hr.status = 'Pending'
+		AND hr.expires_at > now()
+	ORDER BY hr.created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var requests []entity.HandoverRequestEntity
+	for rows.Next() {
+		var hr entity.HandoverRequestEntity
+		if err := rows.Scan(&hr.ID, &hr.AufgabenID, &hr.ProjectID, &hr.RequestedBy, &hr.TargetAssigneeID, &hr.Note, &hr.Status, &hr.ExpiresAt, &hr.CreatedAt, &hr.AufgabeTitle); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		requests = append(requests, hr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return requests, nil
+}
+
+func (r *AufgabenRepo) GetHandoverRequestForUpdate(ctx context.Context, t tx.Tx, requestID string) (*entity.HandoverRequestEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Lock the request so that a concurrent decision or the expiry worker waits for this one
+	query := `
+	SELECT hr.id, hr.aufgaben_id, hr.project_id, hr.requested_by, hr.target_assignee_id, hr.note, hr.status, hr.decided_by, hr.decision_reason, hr.decided_at, hr.expires_at, hr.created_at, a.title
+	FROM aufgaben_handover_requests hr
+	JOIN aufgaben a ON a.id = hr.aufgaben_id
+	WHERE hr.id = $1
+	FOR UPDATE OF hr;
+	`
+
+	var hr entity.HandoverRequestEntity
+	if err := pgxTx.QueryRow(ctx, query, requestID).Scan(&hr.ID, &hr.AufgabenID, &hr.ProjectID, &hr.RequestedBy, &hr.TargetAssigneeID, &hr.Note, &hr.Status, &hr.DecidedBy, &hr.DecisionReason, &hr.DecidedAt, &hr.ExpiresAt, &hr.CreatedAt, &hr.AufgabeTitle); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "handover_request_not_found", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &hr, nil
+}
+
+func (r *AufgabenRepo) DecideHandoverRequest(ctx context.Context, t tx.Tx, requestID string, status entity.HandoverRequestStatus, decidedBy string, reason *string) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben_handover_requests
+	SET status = $2,
+		decided_by = $3,
+		decision_reason = $4,
+		decided_at = now()
+	WHERE id = $1
+		AND status = 'Pending'
+	RETURNING decided_at;
+	`
+
+	var decidedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, requestID, status, decidedBy, reason).Scan(&decidedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.handover_request_closed", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &decidedAt, nil
+}
+
+func (r *AufgabenRepo) ExpireHandoverRequests(ctx context.Context, t tx.Tx) ([]entity.HandoverRequestEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Rows locked by an ongoing decision are skipped, the next run picks them up if they are still pending
+	query := `
+	WITH due AS (
+		SELECT id
+		FROM aufgaben_handover_requests
+		WHERE status = 'Pending'
+			AND expires_at <= now()
+		FOR UPDATE SKIP LOCKED
+	), expired AS (
+		UPDATE aufgaben_handover_requests hr
+		SET status = 'Expired',
+			decided_at = now()
+		FROM due
+		WHERE hr.id = due.id
+		RETURNING hr.id, hr.aufgaben_id, hr.project_id, hr.requested_by, hr.target_assignee_id, hr.note, hr.status, hr.decided_at, hr.expires_at, hr.created_at
+	)
+	SELECT e.id, e.aufgaben_id, e.project_id, e.requested_by, e.target_assignee_id, e.note, e.status, e.decided_at, e.expires_at, e.created_at, a.title, p.name
+	FROM expired e
+	JOIN aufgaben a ON a.id = e.aufgaben_id
+	JOIN projects p ON p.id = e.project_id;
+	`
+
+	rows, err := pgxTx.Query(ctx, query)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var requests []entity.HandoverRequestEntity
+	for rows.Next() {
+		var hr entity.HandoverRequestEntity
+		if err := rows.Scan(&hr.ID, &hr.AufgabenID, &hr.ProjectID, &hr.RequestedBy, &hr.TargetAssigneeID, &hr.Note, &hr.Status, &hr.DecidedAt, &hr.ExpiresAt, &hr.CreatedAt, &hr.AufgabeTitle, &hr.ProjectName); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		requests = append(requests, hr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return requests, nil
+}
+
+func (r *AufgabenRepo) AddTaskAssignee(ctx context.Context, t tx.Tx, assignee *entity.AufgabenAssigneeEntity) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO aufgaben_assignees (aufgaben_id, user_id, role, added_by)
+	VALUES ($1, $2, $3, $4)
+	RETURNING created_at;
+	`
+
+	if err := pgxTx.QueryRow(ctx, query, assignee.AufgabenID, assignee.UserID, assignee.Role, assignee.AddedBy).Scan(&assignee.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) RemoveTaskAssignee(ctx context.Context, t tx.Tx, taskID, userID string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// The Owner follows aufgaben.assignee_id and leaves through unassign or handover only
+	query := `
+	DELETE FROM aufgaben_assignees
+	WHERE aufgaben_id = $1
+		AND user_id = $2
+		AND role <> 'Owner';
+	`
+
+	cmd, err := pgxTx.Exec(ctx, query, taskID, userID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_assignee_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) GetTaskAssigneeRole(ctx context.Context, taskID, userID string) (*entity.AssigneeRole, *app_errors.AppError) {
+	query := `
+	SELECT role
+	FROM aufgaben_assignees
+	WHERE aufgaben_id = $1
+		AND user_id = $2;
+	`
+
+	var role entity.AssigneeRole
+	if err := r.db.QueryRow(ctx, query, taskID, userID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &role, nil
+}
+
+func (r *AufgabenRepo) ListTaskAssignees(ctx context.Context, taskID string) ([]entity.AufgabenAssigneeEntity, *app_errors.AppError) {
+	query := `
+	SELECT aa.aufgaben_id, aa.user_id, u.username, aa.role, aa.added_by, aa.created_at
+	FROM aufgaben_assignees aa
+	JOIN users u ON u.id = aa.user_id
+	WHERE aa.aufgaben_id = $1
+	ORDER BY aa.role ASC, aa.created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var assignees []entity.AufgabenAssigneeEntity
+	for rows.Next() {
+		var a entity.AufgabenAssigneeEntity
+		if err := rows.Scan(&a.AufgabenID, &a.UserID, &a.Username, &a.Role, &a.AddedBy, &a.CreatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		assignees = append(assignees, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return assignees, nil
+}
+
+func (r *AufgabenRepo) LockNextAssignableTask(ctx context.Context, t tx.Tx, projectID string, dueDate *time.Time, skipIDs []string) (*string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Most important first: priority, then the closest due date, then the oldest task.
+	// Rows locked by a concurrent pick are skipped so that two members never claim the same task.
+	// Without a due date from the picker only tasks due in more than an hour qualify, skipIDs were rejected by the caller already.
+	query := `
+	SELECT a.id
+	FROM aufgaben a
+	WHERE a.project_id = $1
+		AND a.status = 'Todo'
+		AND a.assignee_id IS NULL
+		AND a.archived_at IS NULL
+		AND ($2::timestamptz IS NOT NULL OR a.due_date > now() + interval '1 hour')
+		AND a.id <> ALL(COALESCE($3::uuid[], '{}'))
+		AND NOT EXISTS (
+			SELECT 1
+			FROM aufgaben_dependencies d
+			JOIN aufgaben b ON b.id = d.blocked_by_id
+			WHERE d.aufgaben_id = a.id
+				AND b.status <> 'Done'
+				AND b.archived_at IS NULL
+		)
+	ORDER BY a.priority DESC, a.due_date ASC NULLS LAST, a.created_at ASC
+	LIMIT 1
+	FOR UPDATE OF a SKIP LOCKED;
+	`
+
+	var taskID string
+	if err := pgxTx.QueryRow(ctx, query, projectID, dueDate, skipIDs).Scan(&taskID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &taskID, nil
+}
+
+func (r *AufgabenRepo) ListMemberWorkloads(ctx context.Context, projectID string, userID *string) ([]entity.MemberWorkload, *app_errors.AppError) {
+	// Load is person wide, assignments in other projects keep people busy as well.
+	// Every participation counts (Owner, Collaborator, Reviewer), the same as ListAssignedTasks.
+	// Only Overload unassigns of the last 30 days count, older ones say nothing about today.
+	query := `
+	SELECT u.id, u.username, pm.role,
+		COUNT(a.id),
+		COUNT(a.id) FILTER (WHERE a.due_date < now()),
+		(
+			SELECT COUNT(*)
+			FROM aufgaben_assignment_events e
+			WHERE e.target_assignee_id = pm.user_id
+				AND e.action = 'Unassign'
+				AND e.reason_code = 'Overload'
+				AND e.created_at >= now() - INTERVAL '30 days'
+		),
+		COUNT(a.id) FILTER (WHERE a.priority = 'Urgent'),
+		COUNT(a.id) FILTER (WHERE a.priority = 'High')
+	FROM project_members pm
+	JOIN users u ON u.id = pm.user_id
+	LEFT JOIN aufgaben_assignees aa ON aa.user_id = pm.user_id
+	LEFT JOIN aufgaben a ON a.id = aa.aufgaben_id
+		AND a.status = 'In_Progress'
+		AND a.archived_at IS NULL
+	WHERE pm.project_id = $1
+		AND pm.deleted_at IS NULL
+		AND u.is_active = TRUE
+		AND ($2::uuid IS NULL OR pm.user_id = $2)
+	GROUP BY u.id, u.username, pm.role, pm.user_id;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID, userID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var workloads []entity.MemberWorkload
+	for rows.Next() {
+		var w entity.MemberWorkload
+		if err := rows.Scan(&w.UserID, &w.Username, &w.Role, &w.OpenAssignments, &w.OverdueCount, &w.RecentOverloads, &w.UrgentOpen, &w.HighOpen); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		workloads = append(workloads, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return workloads, nil
+}
+
+func (r *AufgabenRepo) GetWorkloadThreshold(ctx context.Context, projectID string) (int, *app_errors.AppError) {
+	query := `
+	SELECT workload_threshold
+	FROM projects
+	WHERE id = $1;
+	`
+
+	var threshold int
+	if err := r.db.QueryRow(ctx, query, projectID).Scan(&threshold); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "project_not_found", nil)
+		}
+		return 0, app_errors.MapPgxError(err)
+	}
+
+	return threshold, nil
+}
+
+func (r *AufgabenRepo) UpdateWorkloadThreshold(ctx context.Context, projectID string, threshold int) *app_errors.AppError {
+	query := `
+	UPDATE projects
+	SET workload_threshold = $2,
+		updated_at = now()
+	WHERE id = $1;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, projectID, threshold)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "project_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) UpsertSLAPolicy(ctx context.Context, policy *entity.SLAPolicyEntity) *app_errors.AppError {
+	// created_at stays on update, only tasks created after the policy was first set are measured
+	query := `
+	INSERT INTO project_sla_policies (project_id, priority, assign_within_minutes, complete_within_minutes, updated_by)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (project_id, priority) DO UPDATE
+	SET assign_within_minutes = EXCLUDED.assign_within_minutes,
+		complete_within_minutes = EXCLUDED.complete_within_minutes,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = now()
+	RETURNING created_at, updated_at;
+	`
+
+	if err := r.db.QueryRow(ctx, query, policy.ProjectID, policy.Priority, policy.AssignWithinMinutes, policy.CompleteWithinMinutes, policy.UpdatedBy).Scan(&policy.CreatedAt, &policy.UpdatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) ListSLAPolicies(ctx context.Context, projectID string) ([]entity.SLAPolicyEntity, *app_errors.AppError) {
+	query := `
+	SELECT project_id, priority, assign_within_minutes, complete_within_minutes, updated_by, created_at, updated_at
+	FROM project_sla_policies
+	WHERE project_id = $1
+	ORDER BY priority DESC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var policies []entity.SLAPolicyEntity
+	for rows.Next() {
+		var p entity.SLAPolicyEntity
+		if err := rows.Scan(&p.ProjectID, &p.Priority, &p.AssignWithinMinutes, &p.CompleteWithinMinutes, &p.UpdatedBy, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		policies = append(policies, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return policies, nil
+}
+
+func (r *AufgabenRepo) DeleteSLAPolicy(ctx context.Context, projectID string, priority entity.AufgabenPriority) *app_errors.AppError {
+	query := `
+	DELETE FROM project_sla_policies
+	WHERE project_id = $1
+		AND priority = $2;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, projectID, priority)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "sla_policy_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) RecordSLABreaches(ctx context.Context, t tx.Tx) ([]entity.SLABreachEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Assignment is breached while the task is still unassigned after its target,
+	// completion when the task wasn't done in time, whether it is finished by now or not.
+	// Breaches already recorded are skipped, so every run only returns new ones.
+	query := `
+	WITH due AS (
+		SELECT a.id AS aufgaben_id, a.project_id, 'Assignment'::sla_breach_kind_enum AS kind, a.priority,
+			a.created_at + make_interval(mins => sp.assign_within_minutes) AS deadline_at
+		FROM aufgaben a
+		JOIN project_sla_policies sp ON sp.project_id = a.project_id AND sp.priority = a.priority
+		WHERE sp.assign_within_minutes IS NOT NULL
+			AND a.created_at >= sp.created_at
+			AND a.archived_at IS NULL
+			AND a.status = 'Todo'
+			AND a.assignee_id IS NULL
+			AND now() > a.created_at + make_interval(mins => sp.assign_within_minutes)
+		UNION ALL
+		SELECT a.id, a.project_id, 'Completion'::sla_breach_kind_enum, a.priority,
+			a.created_at + make_interval(mins => sp.complete_within_minutes)
+		FROM aufgaben a
+		JOIN project_sla_policies sp ON sp.project_id = a.project_id AND sp.priority = a.priority
+		WHERE sp.complete_within_minutes IS NOT NULL
+			AND a.created_at >= sp.created_at
+			AND a.archived_at IS NULL
+			AND COALESCE(a.completed_at, now()) > a.created_at + make_interval(mins => sp.complete_within_minutes)
+	), inserted AS (
+		INSERT INTO aufgaben_sla_breaches (id, aufgaben_id, project_id, kind, priority, deadline_at)
+		SELECT gen_random_uuid(), aufgaben_id, project_id, kind, priority, deadline_at
+		FROM due
+		ON CONFLICT (aufgaben_id, kind) DO NOTHING
+		RETURNING id, aufgaben_id, project_id, kind, priority, deadline_at, detected_at
+	)
+	SELECT i.id, i.aufgaben_id, i.project_id, i.kind, i.priority, i.deadline_at, i.detected_at,
+		a.title, a.assignee_id, a.created_by, p.name, m.id, m.email
+	FROM inserted i
+	JOIN aufgaben a ON a.id = i.aufgaben_id
+	JOIN projects p ON p.id = i.project_id
+	JOIN users m ON m.id = p.master_id;
+	`
+
+	rows, err := pgxTx.Query(ctx, query)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var breaches []entity.SLABreachEntity
+	for rows.Next() {
+		var b entity.SLABreachEntity
+		if err := rows.Scan(&b.ID, &b.AufgabenID, &b.ProjectID, &b.Kind, &b.Priority, &b.DeadlineAt, &b.DetectedAt, &b.AufgabeTitle, &b.AssigneeID, &b.CreatedBy, &b.ProjectName, &b.MeisterID, &b.MeisterEmail); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		breaches = append(breaches, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return breaches, nil
+}
+
+func (r *AufgabenRepo) GetSLACompliance(ctx context.Context, projectID string, filter *aufgaben_dto.SLAComplianceFilter) ([]entity.SLAComplianceRow, *app_errors.AppError) {
+	// Tasks are reported by the day they were created, [from, to] is inclusive
+	query := `
+	SELECT sp.priority,
+		COUNT(a.id),
+		COUNT(a.id) FILTER (WHERE EXISTS (
+			SELECT 1 FROM aufgaben_sla_breaches b WHERE b.aufgaben_id = a.id AND b.kind = 'Assignment'
+		)),
+		COUNT(a.id) FILTER (WHERE EXISTS (
+			SELECT 1 FROM aufgaben_sla_breaches b WHERE b.aufgaben_id = a.id AND b.kind = 'Completion'
+		))
+	FROM project_sla_policies sp
+	LEFT JOIN aufgaben a ON a.project_id = sp.project_id
+		AND a.priority = sp.priority
+		AND a.created_at >= sp.created_at
+		AND a.archived_at IS NULL
+		AND a.created_at >= $2::date
+		AND a.created_at < $3::date + 1
+	WHERE sp.project_id = $1
+	GROUP BY sp.priority
+	ORDER BY sp.priority DESC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID, filter.From, filter.To)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var compliance []entity.SLAComplianceRow
+	for rows.Next() {
+		var c entity.SLAComplianceRow
+		if err := rows.Scan(&c.Priority, &c.Measured, &c.AssignmentBreaches, &c.CompletionBreaches); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		compliance = append(compliance, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return compliance, nil
+}
+
+func (r *AufgabenRepo) UpsertEscalationLevel(ctx context.Context, level *entity.EscalationLevelEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO project_escalation_levels (project_id, level, after_reminders, after_hours_overdue, action, updated_by)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (project_id, level) DO UPDATE
+	SET after_reminders = EXCLUDED.after_reminders,
+		after_hours_overdue = EXCLUDED.after_hours_overdue,
+		action = EXCLUDED.action,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = now()
+	RETURNING created_at, updated_at;
+	`
+
+	if err := r.db.QueryRow(ctx, query, level.ProjectID, level.Level, level.AfterReminders, level.AfterHoursOverdue, level.Action, level.UpdatedBy).Scan(&level.CreatedAt, &level.UpdatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) ListEscalationLevels(ctx context.Context, projectID string) ([]entity.EscalationLevelEntity, *app_errors.AppError) {
+	query := `
+	SELECT project_id, level, after_reminders, after_hours_overdue, action, updated_by, created_at, updated_at
+	FROM project_escalation_levels
+	WHERE project_id = $1
+	ORDER BY level ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var levels []entity.EscalationLevelEntity
+	for rows.Next() {
+		var l entity.EscalationLevelEntity
+		if err := rows.Scan(&l.ProjectID, &l.Level, &l.AfterReminders, &l.AfterHoursOverdue, &l.Action, &l.UpdatedBy, &l.CreatedAt, &l.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		levels = append(levels, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return levels, nil
+}
+
+func (r *AufgabenRepo) DeleteEscalationLevel(ctx context.Context, projectID string, level int) *app_errors.AppError {
+	query := `
+	DELETE FROM project_escalation_levels
+	WHERE project_id = $1
+		AND level = $2;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, projectID, level)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "escalation_level_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) RecordOverdueEscalations(ctx context.Context, t tx.Tx) ([]entity.OverdueEscalationEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Every overdue task jumps to the highest level it has reached by now, lower levels
+	// reached in the same run are skipped. The level only ever goes up until
+	// reminder_stage is reset, a task that is already flagged stays flagged.
+	query := `
+	WITH reached AS (
+		SELECT DISTINCT ON (a.id) a.id, a.escalation_level AS previous_level, el.level, el.action
+		FROM aufgaben a
+		JOIN project_escalation_levels el ON el.project_id = a.project_id
+		WHERE a.status = 'In_Progress'
+			AND a.archived_at IS NULL
+			AND a.due_date IS NOT NULL
+			AND now() >= a.due_date
+			AND el.level > a.escalation_level
+			AND (
+				(el.after_reminders IS NOT NULL AND a.overdue_reminder_count >= el.after_reminders)
+				OR (el.after_hours_overdue IS NOT NULL AND now() >= a.due_date + make_interval(hours => el.after_hours_overdue))
+			)
+		ORDER BY a.id, el.level DESC
+	), escalated AS (
+		UPDATE aufgaben a
+		SET escalation_level = r.level,
+			escalated_at = now(),
+			flagged_for_unassign_at = CASE
+				WHEN r.action = 'Flag_Force_Unassign' THEN COALESCE(a.flagged_for_unassign_at, now())
+				ELSE a.flagged_for_unassign_at
+			END
+		FROM reached r
+		WHERE a.id = r.id
+			AND a.escalation_level = r.previous_level
+		RETURNING a.id, a.title, a.priority, a.assignee_id, a.created_by, a.due_date,
+			a.overdue_reminder_count, a.project_id, r.previous_level, r.level, r.action
+	)
+	SELECT e.id, e.title, e.priority, e.assignee_id, u.username, e.created_by, e.due_date,
+		e.overdue_reminder_count, e.previous_level, e.level, e.action, p.name, m.id, m.email
+	FROM escalated e
+	JOIN projects p ON p.id = e.project_id
+	JOIN users m ON m.id = p.master_id
+	LEFT JOIN users u ON u.id = e.assignee_id;
+	`
+
+	rows, err := pgxTx.Query(ctx, query)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var escalations []entity.OverdueEscalationEntity
+	for rows.Next() {
+		var e entity.OverdueEscalationEntity
+		if err := rows.Scan(&e.AufgabenID, &e.AufgabeTitle, &e.Priority, &e.AssigneeID, &e.AssigneeName, &e.CreatedBy, &e.DueDate, &e.OverdueReminderCount, &e.PreviousLevel, &e.Level, &e.Action, &e.ProjectName, &e.MeisterID, &e.MeisterEmail); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		escalations = append(escalations, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return escalations, nil
+}
+
+func (r *AufgabenRepo) ListFlaggedTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, title, status, priority, assignee_id, created_by, due_date,
+	created_at, escalation_level, flagged_for_unassign_at
+	FROM aufgaben
+	WHERE project_id = $1
+		AND flagged_for_unassign_at IS NOT NULL
+		AND archived_at IS NULL
+	ORDER BY flagged_for_unassign_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var tasks []entity.AufgabenEntity
+	for rows.Next() {
+		var t entity.AufgabenEntity
+		if err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Status, &t.Priority, &t.AssigneeID, &t.CreatedBy, &t.DueDate, &t.CreatedAt, &t.EscalationLevel, &t.FlaggedForUnassignAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return tasks, nil
+}
+
+func (r *AufgabenRepo) ListOverdueTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	query := `
+	SELECT id, project_id, title, status, priority, assignee_id, created_by, due_date,
+	created_at, escalation_level
+	FROM aufgaben
+	WHERE project_id = $1
+		AND due_date < now()
+		AND status <> 'Done'
+		AND archived_at IS NULL
+	ORDER BY due_date ASC, id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var tasks []entity.AufgabenEntity
+	for rows.Next() {
+		var t entity.AufgabenEntity
+		if err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Status, &t.Priority, &t.AssigneeID, &t.CreatedBy, &t.DueDate, &t.CreatedAt, &t.EscalationLevel); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return tasks, nil
+}
+
+func (r *AufgabenRepo) ListBoardTasks(ctx context.Context, projectID string, status *entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError) {
+	// Tasks come in board order, the service puts them into their workflow column
+	query := `
+	SELECT id, title, status, priority, assignee_id, due_date, workflow_status, board_rank::text
+	FROM aufgaben
+	WHERE project_id = $1
+		AND archived_at IS NULL
+		AND ($2::aufgaben_status IS NULL OR status = $2)
+	ORDER BY board_rank ASC, created_at ASC, id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID, status)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var tasks []entity.BoardTaskEntity
+	for rows.Next() {
+		var t entity.BoardTaskEntity
+		if err := rows.Scan(&t.ID, &t.Title, &t.Status, &t.Priority, &t.AssigneeID, &t.DueDate, &t.WorkflowStatus, &t.BoardRank); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return tasks, nil
+}
+
+func (r *AufgabenRepo) LockBoardColumn(ctx context.Context, t tx.Tx, projectID, statusKey string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Moves into one column of a project wait for each other until the tx ends, the WIP count and
+	// the neighbour ranks can't change between reading and writing
+	query := `SELECT pg_advisory_xact_lock(hashtext('board_column:' || $1 || ':' || $2));`
+
+	if _, err := pgxTx.Exec(ctx, query, projectID, statusKey); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) LockBoardTasks(ctx context.Context, t tx.Tx, projectID string, status entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Same order as ListBoardTasks, the rows stay locked until commit
+	query := `
+	SELECT id, title, status, priority, assignee_id, due_date, workflow_status, board_rank::text
+	FROM aufgaben
+	WHERE project_id = $1
+		AND archived_at IS NULL
+		AND status = $2
+	ORDER BY board_rank ASC, created_at ASC, id ASC
+	FOR UPDATE;
+	`
+
+	rows, err := pgxTx.Query(ctx, query, projectID, status)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var tasks []entity.BoardTaskEntity
+	for rows.Next() {
+		var t entity.BoardTaskEntity
+		if err := rows.Scan(&t.ID, &t.Title, &t.Status, &t.Priority, &t.AssigneeID, &t.DueDate, &t.WorkflowStatus, &t.BoardRank); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return tasks, nil
+}
+
+func (r *AufgabenRepo) ListBoardColumns(ctx context.Context, projectID string) ([]entity.BoardColumnEntity, *app_errors.AppError) {
+	query := `
+	SELECT project_id, status_key, wip_limit, updated_by, created_at, updated_at
+	FROM project_board_columns
+	WHERE project_id = $1;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var columns []entity.BoardColumnEntity
+	for rows.Next() {
+		var c entity.BoardColumnEntity
+		if err := rows.Scan(&c.ProjectID, &c.StatusKey, &c.WIPLimit, &c.UpdatedBy, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		columns = append(columns, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return columns, nil
+}
+
+func (r *AufgabenRepo) GetBoardColumnWIPLimit(ctx context.Context, projectID, statusKey string) (*int, *app_errors.AppError) {
+	query := `
+	SELECT wip_limit
+	FROM project_board_columns
+	WHERE project_id = $1
+		AND status_key = $2;
+	`
+
+	var limit int
+	if err := r.db.QueryRow(ctx, query, projectID, statusKey).Scan(&limit); err != nil {
+		// No row means the column is unlimited
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &limit, nil
+}
+
+func (r *AufgabenRepo) UpsertBoardColumn(ctx context.Context, column *entity.BoardColumnEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO project_board_columns (project_id, status_key, wip_limit, updated_by)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (project_id, status_key) DO UPDATE
+	SET wip_limit = EXCLUDED.wip_limit,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = now()
+	RETURNING created_at, updated_at;
+	`
+
+	if err := r.db.QueryRow(ctx, query, column.ProjectID, column.StatusKey, column.WIPLimit, column.UpdatedBy).Scan(&column.CreatedAt, &column.UpdatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) DeleteBoardColumn(ctx context.Context, projectID, statusKey string) *app_errors.AppError {
+	query := `
+	DELETE FROM project_board_columns
+	WHERE project_id = $1
+		AND status_key = $2;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, projectID, statusKey)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "board_column_not_found", nil)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) MoveBoardTask(ctx context.Context, t tx.Tx, taskID, statusKey string, afterRank, beforeRank *string) (string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// afterRank is the task above the new position, beforeRank the one below, either may be missing
+	// at the edges of the column. Multiplying by 0.5 keeps the midpoint exact.
+	query := `
+	UPDATE aufgaben
+	SET workflow_status = $2,
+		board_rank = CASE
+			WHEN $3::text IS NOT NULL AND $4::text IS NOT NULL THEN ($3::text::numeric + $4::text::numeric) * 0.5
+			WHEN $3::text IS NOT NULL THEN $3::text::numeric + 1024
+			WHEN $4::text IS NOT NULL THEN $4::text::numeric - 1024
+			ELSE board_rank
+		END,
+		updated_at = now()
+	WHERE id = $1
+	RETURNING board_rank::text;
+	`
+
+	var rank string
+	if err := pgxTx.QueryRow(ctx, query, taskID, statusKey, afterRank, beforeRank).Scan(&rank); err != nil {
+		return "", app_errors.MapPgxError(err)
+	}
+
+	return rank, nil
+}
+
+func (r *AufgabenRepo) InsertMilestone(ctx context.Context, milestone *entity.MilestoneEntity) *app_errors.AppError {
+	query := `
+	INSERT INTO project_milestones (id, project_id, name, goal, start_date, end_date, created_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+	`
+
+	if _, err := r.db.Exec(ctx, query, milestone.ID, milestone.ProjectID, milestone.Name, milestone.Goal, milestone.StartDate, milestone.EndDate, milestone.CreatedBy, milestone.CreatedAt); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+	return nil
+}
+
+func (r *AufgabenRepo) GetMilestoneByID(ctx context.Context, milestoneID string) (*entity.MilestoneEntity, *app_errors.AppError) {
+	query := `
+	SELECT m.id, m.project_id, m.name, m.goal, m.start_date, m.end_date, m.closed_at, m.closed_by, m.created_by, m.created_at, m.updated_at,
+		COUNT(a.id)::int, COUNT(a.id) FILTER (WHERE a.status = 'Done')::int
+	FROM project_milestones m
+	LEFT JOIN aufgaben a ON a.milestone_id = m.id AND a.archived_at IS NULL
+	WHERE m.id = $1
+	GROUP BY m.id;
+	`
+
+	var row entity.MilestoneEntity
+	if err := r.db.QueryRow(ctx, query, milestoneID).Scan(&row.ID, &row.ProjectID, &row.Name, &row.Goal, &row.StartDate, &row.EndDate, &row.ClosedAt, &row.ClosedBy, &row.CreatedBy, &row.CreatedAt, &row.UpdatedAt, &row.TotalTasks, &row.DoneTasks); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "milestone_not_found", nil)
+		}
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return &row, nil
+}
+
+func (r *AufgabenRepo) ListMilestones(ctx context.Context, projectID string) ([]entity.MilestoneEntity, *app_errors.AppError) {
+	query := `
+	SELECT m.id, m.project_id, m.name, m.goal, m.start_date, m.end_date, m.closed_at, m.closed_by, m.created_by, m.created_at, m.updated_at,
+		COUNT(a.id)::int, COUNT(a.id) FILTER (WHERE a.status = 'Done')::int
+	FROM project_milestones m
+	LEFT JOIN aufgaben a ON a.milestone_id = m.id AND a.archived_at IS NULL
+	WHERE m.project_id = $1
+	GROUP BY m.id
+	ORDER BY m.start_date ASC, m.created_at ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var milestones []entity.MilestoneEntity
+	for rows.Next() {
+		var row entity.MilestoneEntity
+		if err := rows.Scan(&row.ID, &row.ProjectID, &row.Name, &row.Goal, &row.StartDate, &row.EndDate, &row.ClosedAt, &row.ClosedBy, &row.CreatedBy, &row.CreatedAt, &row.UpdatedAt, &row.TotalTasks, &row.DoneTasks); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		milestones = append(milestones, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return milestones, nil
+}
+
+func (r *AufgabenRepo) UpdateMilestone(ctx context.Context, milestone *entity.MilestoneEntity) (*time.Time, *app_errors.AppError) {
+	query := `
+	UPDATE project_milestones
+	SET name = $2,
+		goal = $3,
+		start_date = $4,
+		end_date = $5,
+		updated_at = now()
+	WHERE id = $1
+		AND closed_at IS NULL
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := r.db.QueryRow(ctx, query, milestone.ID, milestone.Name, milestone.Goal, milestone.StartDate, milestone.EndDate).Scan(&updatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) CloseMilestone(ctx context.Context, t tx.Tx, milestoneID, userID string) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE project_milestones
+	SET closed_at = now(),
+		closed_by = $2,
+		updated_at = now()
+	WHERE id = $1
+		AND closed_at IS NULL
+	RETURNING closed_at;
+	`
+
+	var closedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, milestoneID, userID).Scan(&closedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &closedAt, nil
+}
+
+func (r *AufgabenRepo) SetTaskMilestone(ctx context.Context, t tx.Tx, taskID string, milestoneID *string) (*time.Time, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	UPDATE aufgaben
+	SET milestone_id = $2,
+		updated_at = now()
+	WHERE id = $1
+		AND archived_at IS NULL
+	RETURNING updated_at;
+	`
+
+	var updatedAt time.Time
+	if err := pgxTx.QueryRow(ctx, query, taskID, milestoneID).Scan(&updatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &updatedAt, nil
+}
+
+func (r *AufgabenRepo) CarryOverMilestoneTasks(ctx context.Context, t tx.Tx, fromID string, toID *string) ([]string, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// Archived tasks stay in the closed milestone, they are no open work anymore
+	query := `
+	UPDATE aufgaben
+	SET milestone_id = $2,
+		updated_at = now()
+	WHERE milestone_id = $1
+		AND status <> 'Done'
+		AND archived_at IS NULL
+	RETURNING id;
+	`
+
+	rows, err := pgxTx.Query(ctx, query, fromID, toID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var taskIDs []string
+	for rows.Next() {
+		var taskID string
+		if err := rows.Scan(&taskID); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return taskIDs, nil
+}
+
+func (r *AufgabenRepo) GetMilestoneBurndown(ctx context.Context, milestoneID string) ([]entity.BurndownDay, *app_errors.AppError) {
+	// A task counts for a day when its last 'Milestone_Changed' event before the end of that day points to the milestone,
+	// so the scope follows tasks added or carried over later. Closing the milestone freezes the series.
+	// Days after today are not returned.
+	query := `
+	WITH milestone AS (
+		SELECT id, start_date, end_date, closed_at
+		FROM project_milestones
+		WHERE id = $1
+	),
+	days AS (
+		SELECT d::date AS day,
+			LEAST(d + interval '1 day', COALESCE(m.closed_at, 'infinity'::timestamptz)) AS cutoff
+		FROM milestone m,
+			generate_series(m.start_date::timestamptz, LEAST(m.end_date, CURRENT_DATE)::timestamptz, interval '1 day') d
+	),
+	changes AS (
+		SELECT e.id, e.aufgaben_id, e.new_value, e.created_at
+		FROM aufgaben_assignment_events e
+		WHERE e.action = 'Milestone_Changed'
+			AND e.aufgaben_id IN (
+				SELECT aufgaben_id
+				FROM aufgaben_assignment_events
+				WHERE action = 'Milestone_Changed'
+					AND new_value = $1::text
+			)
+	),
+	scope AS (
+		SELECT d.day, d.cutoff, a.estimate, a.estimate_unit, a.completed_at
+		FROM days d
+		JOIN LATERAL (
+			SELECT DISTINCT ON (c.aufgaben_id) c.aufgaben_id, c.new_value
+			FROM changes c
+			WHERE c.created_at < d.cutoff
+			ORDER BY c.aufgaben_id, c.created_at DESC, c.id DESC
+		) last ON last.new_value = $1::text
+		JOIN aufgaben a ON a.id = last.aufgaben_id
+		WHERE a.archived_at IS NULL OR a.archived_at >= d.cutoff OR a.completed_at < d.cutoff
+	)
+	SELECT d.day,
+		COUNT(s.day)::int,
+		COALESCE(SUM(s.estimate) FILTER (WHERE s.estimate_unit = 'Points'), 0)::float8,
+		COALESCE(SUM(s.estimate) FILTER (WHERE s.estimate_unit = 'Hours'), 0)::float8,
+		COUNT(s.day) FILTER (WHERE s.completed_at < s.cutoff)::int,
+		COALESCE(SUM(s.estimate) FILTER (WHERE s.estimate_unit = 'Points' AND s.completed_at < s.cutoff), 0)::float8,
+		COALESCE(SUM(s.estimate) FILTER (WHERE s.estimate_unit = 'Hours' AND s.completed_at < s.cutoff), 0)::float8
+	FROM days d
+	LEFT JOIN scope s ON s.day = d.day
+	GROUP BY d.day
+	ORDER BY d.day ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, milestoneID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var days []entity.BurndownDay
+	for rows.Next() {
+		var day entity.BurndownDay
+		if err := rows.Scan(&day.Day, &day.ScopeTasks, &day.ScopePoints, &day.ScopeHours, &day.DoneTasks, &day.DonePoints, &day.DoneHours); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return days, nil
+}
+
+func (r *AufgabenRepo) RevokeActiveCalendarFeed(ctx context.Context, t tx.Tx, userID string, projectID *string) *app_errors.AppError {
+	pgxTx := t.(*tx.PgxTx).Tx
+	// A nil project is the personal feed
+	query := `
+	UPDATE calendar_feeds
+	SET revoked_at = now()
+	WHERE user_id = $1
+		AND project_id IS NOT DISTINCT FROM $2
+		AND revoked_at IS NULL;
+	`
+
+	if _, err := pgxTx.Exec(ctx, query, userID, projectID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) InsertCalendarFeed(ctx context.Context, t tx.Tx, feed *entity.CalendarFeedEntity) (*entity.CalendarFeedEntity, *app_errors.AppError) {
+	pgxTx := t.(*tx.PgxTx).Tx
+	query := `
+	INSERT INTO calendar_feeds (id, user_id, project_id, token_hash)
+	VALUES ($1, $2, $3, $4)
+	RETURNING created_at;
+	`
+
+	if err := pgxTx.QueryRow(ctx, query, feed.ID, feed.UserID, feed.ProjectID, feed.TokenHash).Scan(&feed.CreatedAt); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return feed, nil
+}
+
+func (r *AufgabenRepo) ListCalendarFeeds(ctx context.Context, userID string) ([]entity.CalendarFeedEntity, *app_errors.AppError) {
+	query := `
+	SELECT f.id, f.user_id, f.project_id, p.name, f.created_at, f.last_used_at
+	FROM calendar_feeds f
+	LEFT JOIN projects p ON p.id = f.project_id
+	WHERE f.user_id = $1
+		AND f.revoked_at IS NULL
+	ORDER BY f.created_at DESC;
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+	defer rows.Close()
+
+	var feeds []entity.CalendarFeedEntity
+	for rows.Next() {
+		var f entity.CalendarFeedEntity
+		if err := rows.Scan(&f.ID, &f.UserID, &f.ProjectID, &f.ProjectName, &f.CreatedAt, &f.LastUsedAt); err != nil {
+			return nil, app_errors.MapPgxError(err)
+		}
+		feeds = append(feeds, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return feeds, nil
+}
+
+func (r *AufgabenRepo) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*entity.CalendarFeedEntity, *app_errors.AppError) {
+	// Only active feeds, a revoked token reads the same as an unknown one
+	query := `
+	SELECT f.id, f.user_id, f.project_id, p.name, f.token_hash, f.created_at, f.last_used_at
+	FROM calendar_feeds f
+	LEFT JOIN projects p ON p.id = f.project_id
+	WHERE f.token_hash = $1
+		AND f.revoked_at IS NULL;
+	`
+
+	var f entity.CalendarFeedEntity
+	if err := r.db.QueryRow(ctx, query, tokenHash).Scan(&f.ID, &f.UserID, &f.ProjectID, &f.ProjectName, &f.TokenHash, &f.CreatedAt, &f.LastUsedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "calendar_feed_not_found", err)
+		}
+		return nil, app_errors.MapPgxError(err)
+	}
+
+	return &f, nil
+}
+
+func (r *AufgabenRepo) TouchCalendarFeed(ctx context.Context, feedID string) *app_errors.AppError {
+	query := `
+	UPDATE calendar_feeds
+	SET last_used_at = now()
+	WHERE id = $1;
+	`
+
+	if _, err := r.db.Exec(ctx, query, feedID); err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	return nil
+}
+
+func (r *AufgabenRepo) RevokeCalendarFeed(ctx context.Context, feedID, userID string) *app_errors.AppError {
+	query := `
+	UPDATE calendar_feeds
+	SET revoked_at = now()
+	WHERE id = $1
+		AND user_id = $2
+		AND revoked_at IS NULL;
+	`
+
+	cmd, err := r.db.Exec(ctx, query, feedID, userID)
+	if err != nil {
+		return app_errors.MapPgxError(err)
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "calendar_feed_not_found", nil)
+	}
+
+	return nil
+}
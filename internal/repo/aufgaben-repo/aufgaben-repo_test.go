@@ -0,0 +1,30 @@
+package aufgaben_repo
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test: SQL in raw strings must not carry Go comments, Postgres rejects them as syntax errors
+func TestQueries_NoGoCommentsInSQL(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "aufgaben-repo.go", nil, 0)
+	assert.NoError(t, err)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING || !strings.HasPrefix(lit.Value, "`") {
+			return true
+		}
+
+		for _, line := range strings.Split(lit.Value, "\n") {
+			assert.False(t, strings.HasPrefix(strings.TrimSpace(line), "//"), "Go comment inside query at %s: %s", fset.Position(lit.Pos()), strings.TrimSpace(line))
+		}
+		return true
+	})
+}
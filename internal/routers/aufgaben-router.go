@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/storage"
 	aufgaben_handlers "github.com/Xenn-00/aufgaben-meister/internal/handlers/aufgaben"
 	"github.com/Xenn-00/aufgaben-meister/internal/i18n"
 	"github.com/Xenn-00/aufgaben-meister/internal/middleware"
@@ -16,9 +17,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-func AufgabenRouter(api fiber.Router, db *pgxpool.Pool, redis *redis.Client, i18n *i18n.I18nService, paseto *utils.PasetoMaker, cfgStorage CfgRedisStorage) {
+func AufgabenRouter(api fiber.Router, db *pgxpool.Pool, redis *redis.Client, i18n *i18n.I18nService, paseto *utils.PasetoMaker, cfgStorage CfgRedisStorage, cfgBlob CfgBlobStorage) {
 	r := api.Group("/project/:project_id/aufgaben", middleware.AuthMiddleware(paseto, redis))
-	aufgabenHandler := aufgaben_handlers.NewAufgabenHandler(db, redis, i18n)
+	aufgabenHandler := aufgaben_handlers.NewAufgabenHandler(db, redis, i18n, storage.NewLocalStorage(cfgBlob.Root))
 
 	// prepare redis storage for rate limiter fiber
 	redisAddr := strings.Split(redis.Options().Addr, ":") // seperate host and port
@@ -30,7 +31,10 @@ func AufgabenRouter(api fiber.Router, db *pgxpool.Pool, redis *redis.Client, i18
 	})
 
 	r.Post("/create", aufgabenHandler.CreateNewAufgaben)
+	r.Post("/create/:template_id", aufgabenHandler.CreateAufgabenFromTemplate)
 	r.Get("/list", aufgabenHandler.ListTasks)
+	r.Get("/search", aufgabenHandler.SearchTasksProject)
+	r.Post("/pick-next", aufgabenHandler.PickNextTask)
 	r.Get("/:task_id", aufgabenHandler.GetAufgabeDetails)
 	r.Post("/:task_id/assign", aufgabenHandler.AssignTask)
 	r.Post("/:task_id/forward-progress", aufgabenHandler.ForwardProgress)
@@ -58,7 +62,147 @@ func AufgabenRouter(api fiber.Router, db *pgxpool.Pool, redis *redis.Client, i18
 	}), aufgabenHandler.ReassignTask)
 	r.Get("/assigned-task", aufgabenHandler.ListAssignedTasks)
 	r.Post("/:task_id/archive", aufgabenHandler.ArchiveTask)
+	r.Post("/:task_id/unarchive", aufgabenHandler.UnarchiveTask)
+	r.Post("/:task_id/reopen", aufgabenHandler.ReopenTask)
+	r.Post("/:task_id/transition", aufgabenHandler.TransitionTask)
+	r.Post("/:task_id/move", aufgabenHandler.MoveTask)
 	r.Patch("/:task_id/update-due-date", aufgabenHandler.UpdateDueDate)
+	r.Patch("/:task_id/estimate", aufgabenHandler.UpdateEstimate)
+	r.Put("/:task_id/milestone", aufgabenHandler.SetTaskMilestone)
+	r.Patch("/:task_id", aufgabenHandler.UpdateTask)
 	r.Get("/:task_id/events", aufgabenHandler.FetchEventsForTask)
 	r.Post("/:task_id/force-handover", aufgabenHandler.ForceAufgabeHandover)
+	r.Post("/:task_id/subtasks", aufgabenHandler.CreateSubtask)
+	r.Get("/:task_id/subtasks", aufgabenHandler.ListSubtasks)
+	r.Post("/:task_id/dependencies", aufgabenHandler.AddDependency)
+	r.Get("/:task_id/dependencies", aufgabenHandler.ListDependencies)
+	r.Delete("/:task_id/dependencies/:blocker_id", aufgabenHandler.RemoveDependency)
+	r.Post("/:task_id/comments", aufgabenHandler.CreateComment)
+	r.Get("/:task_id/comments", aufgabenHandler.ListComments)
+	r.Patch("/:task_id/comments/:comment_id", aufgabenHandler.EditComment)
+	r.Delete("/:task_id/comments/:comment_id", aufgabenHandler.DeleteComment)
+	r.Post("/:task_id/labels/:label_id", aufgabenHandler.AttachLabel)
+	r.Delete("/:task_id/labels/:label_id", aufgabenHandler.DetachLabel)
+	r.Post("/:task_id/timer/start", aufgabenHandler.StartTimer)
+	r.Post("/:task_id/timer/stop", aufgabenHandler.StopTimer)
+	r.Post("/:task_id/worklogs", aufgabenHandler.CreateWorklog)
+	r.Get("/:task_id/worklogs", aufgabenHandler.ListWorklogs)
+	r.Patch("/:task_id/worklogs/:worklog_id", aufgabenHandler.UpdateWorklog)
+	r.Delete("/:task_id/worklogs/:worklog_id", aufgabenHandler.DeleteWorklog)
+	r.Get("/:task_id/time", aufgabenHandler.GetTaskTimeSummary)
+	r.Post("/:task_id/attachments", aufgabenHandler.UploadAttachment)
+	r.Get("/:task_id/attachments", aufgabenHandler.ListAttachments)
+	r.Get("/:task_id/attachments/:attachment_id", aufgabenHandler.DownloadAttachment)
+	r.Delete("/:task_id/attachments/:attachment_id", aufgabenHandler.DeleteAttachment)
+	r.Post("/:task_id/watch", aufgabenHandler.WatchTask)
+	r.Delete("/:task_id/watch", aufgabenHandler.UnwatchTask)
+	r.Get("/:task_id/watchers", aufgabenHandler.ListWatchers)
+	r.Post("/:task_id/assignees", aufgabenHandler.AddTaskAssignee)
+	r.Get("/:task_id/assignees", aufgabenHandler.ListTaskAssignees)
+	r.Delete("/:task_id/assignees/:user_id", aufgabenHandler.RemoveTaskAssignee)
+	r.Get("/:task_id/assignee-suggestions", aufgabenHandler.SuggestAssignees)
+
+	// project scoped labels
+	l := api.Group("/project/:project_id/labels", middleware.AuthMiddleware(paseto, redis))
+	l.Post("/", aufgabenHandler.CreateLabel)
+	l.Get("/", aufgabenHandler.ListLabels)
+	l.Patch("/:label_id", aufgabenHandler.UpdateLabel)
+	l.Delete("/:label_id", aufgabenHandler.DeleteLabel)
+
+	// project scoped workflow
+	w := api.Group("/project/:project_id/workflow", middleware.AuthMiddleware(paseto, redis))
+	w.Get("/", aufgabenHandler.GetWorkflow)
+	w.Put("/", aufgabenHandler.UpdateWorkflow)
+
+	// project scoped task templates
+	tp := api.Group("/project/:project_id/templates", middleware.AuthMiddleware(paseto, redis))
+	tp.Post("/", aufgabenHandler.CreateTemplate)
+	tp.Get("/", aufgabenHandler.ListTemplates)
+	tp.Put("/:template_id", aufgabenHandler.UpdateTemplate)
+	tp.Delete("/:template_id", aufgabenHandler.DeleteTemplate)
+
+	// project scoped recurring tasks
+	rc := api.Group("/project/:project_id/recurrences", middleware.AuthMiddleware(paseto, redis))
+	rc.Post("/", aufgabenHandler.CreateRecurrence)
+	rc.Get("/", aufgabenHandler.ListRecurrences)
+	rc.Patch("/:recurrence_id", aufgabenHandler.UpdateRecurrence)
+	rc.Delete("/:recurrence_id", aufgabenHandler.DeleteRecurrence)
+
+	// project scoped workload settings
+	wl := api.Group("/project/:project_id/workload", middleware.AuthMiddleware(paseto, redis))
+	wl.Put("/threshold", aufgabenHandler.UpdateWorkloadThreshold)
+
+	// project scoped SLA policies
+	sla := api.Group("/project/:project_id/sla", middleware.AuthMiddleware(paseto, redis))
+	sla.Get("/policies", aufgabenHandler.ListSLAPolicies)
+	sla.Put("/policies/:priority", aufgabenHandler.UpsertSLAPolicy)
+	sla.Delete("/policies/:priority", aufgabenHandler.DeleteSLAPolicy)
+	sla.Get("/compliance", aufgabenHandler.GetSLACompliance)
+
+	// project scoped kanban board
+	board := api.Group("/project/:project_id/board", middleware.AuthMiddleware(paseto, redis))
+	board.Get("/", aufgabenHandler.GetBoard)
+	board.Put("/columns/:status_key", aufgabenHandler.UpsertBoardColumn)
+	board.Delete("/columns/:status_key", aufgabenHandler.DeleteBoardColumn)
+
+	// project scoped milestones
+	ms := api.Group("/project/:project_id/milestones", middleware.AuthMiddleware(paseto, redis))
+	ms.Post("/", aufgabenHandler.CreateMilestone)
+	ms.Get("/", aufgabenHandler.ListMilestones)
+	ms.Patch("/:milestone_id", aufgabenHandler.UpdateMilestone)
+	ms.Post("/:milestone_id/close", aufgabenHandler.CloseMilestone)
+	ms.Get("/:milestone_id/burndown", aufgabenHandler.GetMilestoneBurndown)
+
+	// project scoped overdue escalation chain
+	esc := api.Group("/project/:project_id/escalation", middleware.AuthMiddleware(paseto, redis))
+	esc.Get("/levels", aufgabenHandler.ListEscalationLevels)
+	esc.Put("/levels/:level", aufgabenHandler.UpsertEscalationLevel)
+	esc.Delete("/levels/:level", aufgabenHandler.DeleteEscalationLevel)
+	esc.Get("/flagged", aufgabenHandler.ListFlaggedTasks)
+
+	// project scoped overdue summary
+	od := api.Group("/project/:project_id/overdue", middleware.AuthMiddleware(paseto, redis))
+	od.Get("/summary", aufgabenHandler.GetOverdueSummary)
+
+	// project scoped handover requests
+	hr := api.Group("/project/:project_id/handover-requests", middleware.AuthMiddleware(paseto, redis))
+	hr.Get("/", aufgabenHandler.ListHandoverRequests)
+	hr.Post("/:request_id/approve", aufgabenHandler.ApproveHandoverRequest)
+	hr.Post("/:request_id/reject", aufgabenHandler.RejectHandoverRequest)
+
+	// project scoped time report
+	t := api.Group("/project/:project_id/time-report", middleware.AuthMiddleware(paseto, redis))
+	t.Get("/", aufgabenHandler.GetTimeReport)
+
+	// project scoped velocity
+	v := api.Group("/project/:project_id/velocity", middleware.AuthMiddleware(paseto, redis))
+	v.Get("/", aufgabenHandler.GetVelocity)
+
+	// project scoped calendar feed for Meister
+	pcf := api.Group("/project/:project_id/calendar-feed", middleware.AuthMiddleware(paseto, redis))
+	pcf.Post("/", aufgabenHandler.CreateProjectCalendarFeed)
+
+	// calendar feeds of the user, the feed itself is fetched by token without a session
+	cf := api.Group("/calendar/feeds", middleware.AuthMiddleware(paseto, redis))
+	cf.Post("/", aufgabenHandler.CreateCalendarFeed)
+	cf.Get("/", aufgabenHandler.ListCalendarFeeds)
+	cf.Delete("/:feed_id", aufgabenHandler.RevokeCalendarFeed)
+	api.Get("/calendar/feed/:token", limiter.New(limiter.Config{
+		Max:        60,
+		Expiration: time.Minute,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return "calendar-feed:ip:" + c.IP()
+		},
+		LimitReached: func(c *fiber.Ctx) error {
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"status": "error",
+				"error":  "too_many_request",
+			})
+		},
+		Storage: redisStore,
+	}), aufgabenHandler.GetCalendarFeed)
+
+	// search across all projects of the user
+	a := api.Group("/aufgaben", middleware.AuthMiddleware(paseto, redis))
+	a.Get("/search", aufgabenHandler.SearchTasks)
 }
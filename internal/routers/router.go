@@ -13,13 +13,17 @@ type CfgRedisStorage struct {
 	Password string
 }
 
+type CfgBlobStorage struct {
+	Root string
+}
+
 // SetupRoutes richtet die API-Routen ein.
-func SetupRoutes(app *fiber.App, db *pgxpool.Pool, redis *redis.Client, i18n *i18n.I18nService, paseto *utils.PasetoMaker, cfgStorage CfgRedisStorage) {
+func SetupRoutes(app *fiber.App, db *pgxpool.Pool, redis *redis.Client, i18n *i18n.I18nService, paseto *utils.PasetoMaker, cfgStorage CfgRedisStorage, cfgBlob CfgBlobStorage) {
 	api := app.Group("/api/v1")
 
 	AuthRouter(api, db, redis, i18n, paseto)
 	UserRouter(api, db, redis, i18n, paseto)
 	ProjectRouter(api, db, redis, i18n, paseto, cfgStorage)
-	AufgabenRouter(api, db, redis, i18n, paseto, cfgStorage)
+	AufgabenRouter(api, db, redis, i18n, paseto, cfgStorage, cfgBlob)
 	HealthRouter(api, db, redis)
 }
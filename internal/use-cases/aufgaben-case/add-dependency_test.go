@@ -0,0 +1,172 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestAddDependency_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	blockerID := "task-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Title: "Deploy", Status: entity.AufgabenTodo}
+	blocker := &entity.AufgabenEntity{ID: blockerID, ProjectID: projectID, Title: "Build", Status: entity.AufgabenInProgress}
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, blockerID).Return(blocker, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("IsTransitivelyBlockedBy", ctx, tx, blockerID, taskID).Return(false, (*app_errors.AppError)(nil))
+
+	repo.On("InsertDependency", ctx, tx, mock.MatchedBy(func(d *entity.AufgabenDependency) bool {
+		return d.AufgabenID == taskID && d.BlockedByID == blockerID && d.CreatedBy == userID
+	})).Return((*app_errors.AppError)(nil))
+
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == taskID && e.Action == entity.ActionDependencyAdded
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddDependency(ctx, userID, projectID, taskID, &aufgaben_dto.AddDependencyRequest{BlockedByID: blockerID})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, taskID, resp.AufgabenID)
+	assert.Equal(t, blockerID, resp.BlockedByID)
+	assert.True(t, resp.IsBlocked)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
+
+// Test 2: Task can't depend on itself
+func TestAddDependency_SelfDependency(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddDependency(ctx, userID, projectID, taskID, &aufgaben_dto.AddDependencyRequest{BlockedByID: taskID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.dependency_cycle", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 3: Blocker already waits on the task (cycle), checked under the project lock
+func TestAddDependency_Cycle(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	blockerID := "task-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, blockerID).Return(&entity.AufgabenEntity{ID: blockerID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("IsTransitivelyBlockedBy", ctx, tx, blockerID, taskID).Return(true, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddDependency(ctx, userID, projectID, taskID, &aufgaben_dto.AddDependencyRequest{BlockedByID: blockerID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, app_errors.ErrConflict, err.Type)
+	assert.Equal(t, "conflict.dependency_cycle", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertDependency", mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// Test 4: Blocker belongs to another project
+func TestAddDependency_BlockerInOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	blockerID := "task-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, blockerID).Return(&entity.AufgabenEntity{ID: blockerID, ProjectID: "project-2", Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddDependency(ctx, userID, projectID, taskID, &aufgaben_dto.AddDependencyRequest{BlockedByID: blockerID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "task_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "IsTransitivelyBlockedBy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
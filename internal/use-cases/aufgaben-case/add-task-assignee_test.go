@@ -0,0 +1,142 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - owner adds a reviewer
+func TestAddTaskAssignee_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	ownerID := "user-1"
+	reviewerID := "user-2"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	req := &aufgaben_dto.AddTaskAssigneeRequest{UserID: reviewerID, Role: "Reviewer"}
+
+	repo.On("CheckProjectMember", ctx, projectID, ownerID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, ownerID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, reviewerID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("AddTaskAssignee", ctx, tx, mock.MatchedBy(func(a *entity.AufgabenAssigneeEntity) bool {
+		return a.UserID == reviewerID && a.Role == entity.AssigneeReviewer && *a.AddedBy == ownerID
+	})).Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionParticipantAdded && *e.TargetAssigneeID == reviewerID && *e.NewValue == "Reviewer"
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddTaskAssignee(ctx, ownerID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, reviewerID, resp.UserID)
+	assert.Equal(t, "Reviewer", resp.Role)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
+
+// Test 2: Collaborator can't bring other people onto the task
+func TestAddTaskAssignee_NotOwner(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	ownerID := "user-1"
+	collaboratorID := "user-2"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	req := &aufgaben_dto.AddTaskAssigneeRequest{UserID: "user-3", Role: "Collaborator"}
+
+	repo.On("CheckProjectMember", ctx, projectID, collaboratorID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, collaboratorID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AddTaskAssignee(ctx, collaboratorID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden.not_task_assignee", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AddTaskAssignee", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test 3: User is already a participant
+func TestAddTaskAssignee_AlreadyAssignee(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	ownerID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	req := &aufgaben_dto.AddTaskAssigneeRequest{UserID: ownerID, Role: "Collaborator"}
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, ownerID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("AddTaskAssignee", ctx, tx, mock.Anything).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", nil))
+
+	// Execute
+	resp, err := service.AddTaskAssignee(ctx, meisterID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.already_task_assignee", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
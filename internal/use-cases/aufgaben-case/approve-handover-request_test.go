@@ -0,0 +1,237 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - approval hands the task over to the requested target
+func TestApproveHandoverRequest_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	meisterID := "meister-1"
+	requesterID := "user-1"
+	targetID := "user-2"
+	projectID := "project-1"
+	taskID := "task-1"
+	requestID := "request-1"
+	dueDate := time.Now().Add(48 * time.Hour)
+
+	req := &aufgaben_dto.ApproveHandoverRequest{
+		ReasonCode: "Overload",
+		Reason:     "Requester is overloaded",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	request := &entity.HandoverRequestEntity{
+		ID:               requestID,
+		AufgabenID:       taskID,
+		ProjectID:        projectID,
+		RequestedBy:      requesterID,
+		TargetAssigneeID: targetID,
+		Note:             "Please take over",
+		Status:           entity.HandoverPending,
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(request, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Title: "Deploy", Status: entity.AufgabenInProgress, AssigneeID: &requesterID, DueDate: &dueDate}
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	decidedAt := time.Now()
+	repo.On("DecideHandoverRequest", ctx, tx, requestID, entity.HandoverApproved, meisterID, &req.Reason).Return(&decidedAt, (*app_errors.AppError)(nil))
+
+	repo.On("AssignTask", ctx, tx, projectID, taskID, targetID, &dueDate).Return(&entity.AssignTaskEntity{
+		ID:         taskID,
+		Status:     entity.AufgabenInProgress,
+		AssigneeID: targetID,
+	}, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionHandoverExecute && *e.TargetAssigneeID == targetID && e.ReasonCode == entity.ReasonOverload
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueHandoverDecisionNotify", mock.MatchedBy(func(p *worker_task.HandoverDecisionNotify) bool {
+		return p.RequestID == requestID && p.RequesterID == requesterID && p.DeciderID == meisterID && p.Outcome == "Approved"
+	})).Return(nil).Once()
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeAssigned && p.Detail == targetID
+	})).Return(nil).Once()
+
+	// Execute
+	resp, err := service.ApproveHandoverRequest(ctx, meisterID, projectID, requestID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, targetID, *resp.NewAssigneeID)
+	assert.Equal(t, string(entity.ActionHandoverExecute), resp.Action)
+	assert.Equal(t, requestID, *resp.RequestID)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 2: Task changed hands since the request was filed
+func TestApproveHandoverRequest_Outdated(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	requestID := "request-1"
+	otherAssignee := "user-3"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(&entity.HandoverRequestEntity{
+		ID:               requestID,
+		AufgabenID:       taskID,
+		ProjectID:        projectID,
+		RequestedBy:      "user-1",
+		TargetAssigneeID: "user-2",
+		Status:           entity.HandoverPending,
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &otherAssignee}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ApproveHandoverRequest(ctx, meisterID, projectID, requestID, &aufgaben_dto.ApproveHandoverRequest{ReasonCode: "Other", Reason: "ok"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.handover_request_outdated", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DecideHandoverRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "AssignTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test 3: Request expired before the worker marked it
+func TestApproveHandoverRequest_Expired(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	requestID := "request-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(&entity.HandoverRequestEntity{
+		ID:        requestID,
+		ProjectID: projectID,
+		Status:    entity.HandoverPending,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ApproveHandoverRequest(ctx, meisterID, projectID, requestID, &aufgaben_dto.ApproveHandoverRequest{ReasonCode: "Other", Reason: "ok"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.handover_request_expired", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetTaskByID", mock.Anything, mock.Anything)
+}
+
+// Test 4: Request of another project
+func TestApproveHandoverRequest_RequestOfOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	requestID := "request-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(&entity.HandoverRequestEntity{
+		ID:        requestID,
+		ProjectID: "project-2",
+		Status:    entity.HandoverPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ApproveHandoverRequest(ctx, meisterID, projectID, requestID, &aufgaben_dto.ApproveHandoverRequest{ReasonCode: "Other", Reason: "ok"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "handover_request_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
@@ -20,9 +21,11 @@ func TestArchiveTask_Success(t *testing.T) {
 	repo := new(MockAufgabenRepo)
 	txManager := new(use_cases.MockTxManager)
 	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
 	service := &AufgabenService{
 		repo:      repo,
 		txManager: txManager,
+		taskQueue: taskQueue,
 	}
 
 	userID := "user-1"
@@ -54,6 +57,10 @@ func TestArchiveTask_Success(t *testing.T) {
 
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeArchived
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
 	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
@@ -64,6 +71,7 @@ func TestArchiveTask_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
 	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
 }
 
 // Test 2: User is not a project member
@@ -9,6 +9,7 @@ import (
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -20,9 +21,11 @@ func TestAssignTask_Success(t *testing.T) {
 	repo := new(MockAufgabenRepo)
 	txManager := new(use_cases.MockTxManager)
 	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
 	service := &AufgabenService{
 		repo:      repo,
 		txManager: txManager,
+		taskQueue: taskQueue,
 	}
 
 	dueDate := time.Now().Add(24 * time.Hour)
@@ -50,6 +53,10 @@ func TestAssignTask_Success(t *testing.T) {
 
 	repo.On("GetTaskByID", ctx, taskID).Return((*entity.AufgabenEntity)(task), (*app_errors.AppError)(nil))
 
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+
 	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
 
 	assigned := &entity.AssignTaskEntity{
@@ -61,11 +68,21 @@ func TestAssignTask_Success(t *testing.T) {
 		DueDate:    dueDate,
 	}
 
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "In_Progress").Return((*app_errors.AppError)(nil))
+
 	repo.On("AssignTask", ctx, tx, projectID, taskID, userID, &dueDate).Return(assigned, (*app_errors.AppError)(nil))
 
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeAssigned
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
 	resp, err := service.AssignTask(ctx, userID, projectID, taskID, req)
 
@@ -78,6 +95,7 @@ func TestAssignTask_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
 	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
 }
 
 // Test not a project member
@@ -468,3 +486,117 @@ func TestAssignTask_DueDateIsOneHour(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test when task is still blocked by an open dependency
+func TestAssignTask_TaskBlocked(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	dueDate := time.Now().Add(24 * time.Hour)
+	req := &aufgaben_dto.AufgabenAssignRequest{
+		DueDate: dueDate,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:       taskID,
+		Title:    "Test Task",
+		Status:   entity.AufgabenTodo,
+		Priority: entity.PriorityMedium,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(2, (*app_errors.AppError)(nil))
+
+	resp, err := service.AssignTask(ctx, userID, projectID, taskID, req)
+
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, app_errors.ErrConflict, err.Type)
+	assert.Equal(t, "conflict.task_blocked", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AssignTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
+
+// Test when the In Progress column already reached its WIP limit
+func TestAssignTask_WIPLimitReached(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	dueDate := time.Now().Add(24 * time.Hour)
+	req := &aufgaben_dto.AufgabenAssignRequest{
+		DueDate: dueDate,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:       taskID,
+		Title:    "Test Task",
+		Status:   entity.AufgabenTodo,
+		Priority: entity.PriorityMedium,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	limit := 2
+	inProgress := entity.AufgabenInProgress
+	workflowKey := "In_Progress"
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return(&limit, (*app_errors.AppError)(nil))
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: "task-2", Status: entity.AufgabenInProgress, WorkflowStatus: &workflowKey, BoardRank: "1024"},
+		{ID: "task-3", Status: entity.AufgabenInProgress, BoardRank: "2048"},
+	}, (*app_errors.AppError)(nil))
+
+	resp, err := service.AssignTask(ctx, userID, projectID, taskID, req)
+
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, "conflict.wip_limit_reached", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AssignTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
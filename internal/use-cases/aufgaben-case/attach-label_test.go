@@ -0,0 +1,97 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestAttachLabel_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	labelID := "label-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetLabelByID", ctx, labelID).Return(&entity.LabelEntity{ID: labelID, ProjectID: projectID, Name: "bug"}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	attachedAt := time.Now()
+	repo.On("AttachLabel", ctx, tx, taskID, labelID, userID).Return(&attachedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == taskID && e.Action == entity.ActionLabelAdded && *e.Note == "Label: bug"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AttachLabel(ctx, userID, projectID, taskID, labelID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "bug", resp.Name)
+	assert.Equal(t, attachedAt, resp.AttachedAt)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Label belongs to another project
+func TestAttachLabel_LabelOfOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	labelID := "label-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetLabelByID", ctx, labelID).Return(&entity.LabelEntity{ID: labelID, ProjectID: "project-2", Name: "bug"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.AttachLabel(ctx, userID, projectID, taskID, labelID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "label_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
@@ -2,6 +2,7 @@ package aufgaben_case
 
 import (
 	"context"
+	"io"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/dtos"
 	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
@@ -19,7 +20,89 @@ type AufgabenServiceContract interface {
 	ReassignTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.ReassignAufgabenRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError)
 	ListAssignedTasks(ctx context.Context, userID string, filter *aufgaben_dto.AssignedAufgabenFilter) ([]*aufgaben_dto.AssignedAufgabenListItem, *dtos.CursorPaginationMeta, *app_errors.AppError)
 	ArchiveTask(ctx context.Context, userID, projectID, taskID string) *app_errors.AppError
+	UnarchiveTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.RestoreAufgabenRequest) (*aufgaben_dto.RestoreAufgabenResponse, *app_errors.AppError)
+	ReopenTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.RestoreAufgabenRequest) (*aufgaben_dto.RestoreAufgabenResponse, *app_errors.AppError)
 	UpdateDueDate(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateDueDateRequest) (*aufgaben_dto.UpdateDueDateResponse, *app_errors.AppError)
+	UpdateEstimate(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateEstimateRequest) (*aufgaben_dto.UpdateEstimateResponse, *app_errors.AppError)
+	GetVelocity(ctx context.Context, userID, projectID string, filter *aufgaben_dto.VelocityFilter) (*aufgaben_dto.VelocityResponse, *app_errors.AppError)
+	UpdateTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateAufgabenRequest) (*aufgaben_dto.UpdateAufgabenResponse, *app_errors.AppError)
 	FetchEventsForTask(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]*aufgaben_dto.AufgabenEventItem, *dtos.CursorPaginationMeta, *app_errors.AppError)
 	ForceAufgabeHandover(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.ForceAufgabeHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError)
+	CreateSubtask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateNewAufgabenRequest) (*aufgaben_dto.CreateNewAufgabenResponse, *app_errors.AppError)
+	ListSubtasks(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.SubtaskListResponse, *app_errors.AppError)
+	AddDependency(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.AddDependencyRequest) (*aufgaben_dto.AddDependencyResponse, *app_errors.AppError)
+	RemoveDependency(ctx context.Context, userID, projectID, taskID, blockerID string) *app_errors.AppError
+	ListDependencies(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.DependencyListResponse, *app_errors.AppError)
+	CreateComment(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateCommentRequest) (*aufgaben_dto.CommentItem, *app_errors.AppError)
+	EditComment(ctx context.Context, userID, projectID, taskID, commentID string, req *aufgaben_dto.UpdateCommentRequest) (*aufgaben_dto.CommentItem, *app_errors.AppError)
+	DeleteComment(ctx context.Context, userID, projectID, taskID, commentID string) *app_errors.AppError
+	ListComments(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenCommentFilter) ([]*aufgaben_dto.CommentItem, *dtos.CursorPaginationMeta, *app_errors.AppError)
+	CreateLabel(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateLabelRequest) (*aufgaben_dto.LabelItem, *app_errors.AppError)
+	ListLabels(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.LabelItem, *app_errors.AppError)
+	UpdateLabel(ctx context.Context, userID, projectID, labelID string, req *aufgaben_dto.UpdateLabelRequest) (*aufgaben_dto.LabelItem, *app_errors.AppError)
+	DeleteLabel(ctx context.Context, userID, projectID, labelID string) *app_errors.AppError
+	AttachLabel(ctx context.Context, userID, projectID, taskID, labelID string) (*aufgaben_dto.TaskLabelResponse, *app_errors.AppError)
+	DetachLabel(ctx context.Context, userID, projectID, taskID, labelID string) *app_errors.AppError
+	SearchTasksProject(ctx context.Context, userID, projectID string, filter aufgaben_dto.AufgabenSearchFilter) ([]*aufgaben_dto.AufgabenSearchItem, *dtos.PaginationMeta, *app_errors.AppError)
+	GetWorkflow(ctx context.Context, userID, projectID string) (*aufgaben_dto.WorkflowResponse, *app_errors.AppError)
+	UpdateWorkflow(ctx context.Context, userID, projectID string, req *aufgaben_dto.UpdateWorkflowRequest) (*aufgaben_dto.WorkflowResponse, *app_errors.AppError)
+	TransitionTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.TransitionTaskRequest) (*aufgaben_dto.TransitionTaskResponse, *app_errors.AppError)
+	CreateRecurrence(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateRecurrenceRequest) (*aufgaben_dto.RecurrenceItem, *app_errors.AppError)
+	ListRecurrences(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.RecurrenceItem, *app_errors.AppError)
+	UpdateRecurrence(ctx context.Context, userID, projectID, recurrenceID string, req *aufgaben_dto.UpdateRecurrenceRequest) (*aufgaben_dto.RecurrenceItem, *app_errors.AppError)
+	DeleteRecurrence(ctx context.Context, userID, projectID, recurrenceID string) *app_errors.AppError
+	CreateTemplate(ctx context.Context, userID, projectID string, req *aufgaben_dto.SaveTemplateRequest) (*aufgaben_dto.TemplateItem, *app_errors.AppError)
+	ListTemplates(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.TemplateItem, *app_errors.AppError)
+	UpdateTemplate(ctx context.Context, userID, projectID, templateID string, req *aufgaben_dto.SaveTemplateRequest) (*aufgaben_dto.TemplateItem, *app_errors.AppError)
+	DeleteTemplate(ctx context.Context, userID, projectID, templateID string) *app_errors.AppError
+	CreateAufgabenFromTemplate(ctx context.Context, userID, projectID, templateID string, req *aufgaben_dto.CreateFromTemplateRequest) (*aufgaben_dto.CreateNewAufgabenResponse, *app_errors.AppError)
+	UploadAttachment(ctx context.Context, userID, projectID, taskID string, upload *aufgaben_dto.AttachmentUpload) (*aufgaben_dto.AttachmentItem, *app_errors.AppError)
+	ListAttachments(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.AttachmentItem, *app_errors.AppError)
+	DownloadAttachment(ctx context.Context, userID, projectID, taskID, attachmentID string) (*aufgaben_dto.AttachmentItem, io.ReadCloser, *app_errors.AppError)
+	DeleteAttachment(ctx context.Context, userID, projectID, taskID, attachmentID string) *app_errors.AppError
+	WatchTask(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.WatcherItem, *app_errors.AppError)
+	UnwatchTask(ctx context.Context, userID, projectID, taskID string) *app_errors.AppError
+	ListWatchers(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.WatcherItem, *app_errors.AppError)
+	ListHandoverRequests(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.HandoverRequestItem, *app_errors.AppError)
+	ApproveHandoverRequest(ctx context.Context, userID, projectID, requestID string, req *aufgaben_dto.ApproveHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError)
+	RejectHandoverRequest(ctx context.Context, userID, projectID, requestID string, req *aufgaben_dto.RejectHandoverRequest) (*aufgaben_dto.HandoverRequestItem, *app_errors.AppError)
+	AddTaskAssignee(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.AddTaskAssigneeRequest) (*aufgaben_dto.TaskAssigneeItem, *app_errors.AppError)
+	RemoveTaskAssignee(ctx context.Context, userID, projectID, taskID, targetID string) *app_errors.AppError
+	ListTaskAssignees(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.TaskAssigneeItem, *app_errors.AppError)
+	PickNextTask(ctx context.Context, userID, projectID string, req *aufgaben_dto.PickNextAufgabeRequest) (*aufgaben_dto.AufgabenAssignResponse, *app_errors.AppError)
+	SuggestAssignees(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.AssigneeSuggestionItem, *app_errors.AppError)
+	UpdateWorkloadThreshold(ctx context.Context, userID, projectID string, req *aufgaben_dto.UpdateWorkloadThresholdRequest) (*aufgaben_dto.WorkloadThresholdResponse, *app_errors.AppError)
+	UpsertSLAPolicy(ctx context.Context, userID, projectID, priority string, req *aufgaben_dto.UpsertSLAPolicyRequest) (*aufgaben_dto.SLAPolicyItem, *app_errors.AppError)
+	ListSLAPolicies(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.SLAPolicyItem, *app_errors.AppError)
+	DeleteSLAPolicy(ctx context.Context, userID, projectID, priority string) *app_errors.AppError
+	GetSLACompliance(ctx context.Context, userID, projectID string, filter *aufgaben_dto.SLAComplianceFilter) (*aufgaben_dto.SLAComplianceResponse, *app_errors.AppError)
+	UpsertEscalationLevel(ctx context.Context, userID, projectID string, level int, req *aufgaben_dto.UpsertEscalationLevelRequest) (*aufgaben_dto.EscalationLevelItem, *app_errors.AppError)
+	ListEscalationLevels(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.EscalationLevelItem, *app_errors.AppError)
+	DeleteEscalationLevel(ctx context.Context, userID, projectID string, level int) *app_errors.AppError
+	ListFlaggedTasks(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.FlaggedTaskItem, *app_errors.AppError)
+	GetOverdueSummary(ctx context.Context, userID, projectID string) (*aufgaben_dto.OverdueSummaryResponse, *app_errors.AppError)
+	GetBoard(ctx context.Context, userID, projectID string) (*aufgaben_dto.BoardResponse, *app_errors.AppError)
+	MoveTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.MoveBoardTaskRequest) (*aufgaben_dto.MoveBoardTaskResponse, *app_errors.AppError)
+	UpsertBoardColumn(ctx context.Context, userID, projectID, statusKey string, req *aufgaben_dto.UpsertBoardColumnRequest) (*aufgaben_dto.BoardColumnLimitItem, *app_errors.AppError)
+	DeleteBoardColumn(ctx context.Context, userID, projectID, statusKey string) *app_errors.AppError
+	CreateMilestone(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateMilestoneRequest) (*aufgaben_dto.MilestoneItem, *app_errors.AppError)
+	ListMilestones(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.MilestoneItem, *app_errors.AppError)
+	UpdateMilestone(ctx context.Context, userID, projectID, milestoneID string, req *aufgaben_dto.UpdateMilestoneRequest) (*aufgaben_dto.MilestoneItem, *app_errors.AppError)
+	CloseMilestone(ctx context.Context, userID, projectID, milestoneID string, req *aufgaben_dto.CloseMilestoneRequest) (*aufgaben_dto.CloseMilestoneResponse, *app_errors.AppError)
+	SetTaskMilestone(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.SetTaskMilestoneRequest) (*aufgaben_dto.TaskMilestoneResponse, *app_errors.AppError)
+	GetMilestoneBurndown(ctx context.Context, userID, projectID, milestoneID string) (*aufgaben_dto.MilestoneBurndownResponse, *app_errors.AppError)
+	StartTimer(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.StartTimerRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError)
+	StopTimer(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.WorklogItem, *app_errors.AppError)
+	CreateWorklog(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateWorklogRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError)
+	UpdateWorklog(ctx context.Context, userID, projectID, taskID, worklogID string, req *aufgaben_dto.UpdateWorklogRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError)
+	DeleteWorklog(ctx context.Context, userID, projectID, taskID, worklogID string) *app_errors.AppError
+	ListWorklogs(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenWorklogFilter) ([]*aufgaben_dto.WorklogItem, *dtos.CursorPaginationMeta, *app_errors.AppError)
+	GetTaskTimeSummary(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.TaskTimeSummaryResponse, *app_errors.AppError)
+	GetTimeReport(ctx context.Context, userID, projectID string, filter *aufgaben_dto.TimeReportFilter) (*aufgaben_dto.TimeReportResponse, *app_errors.AppError)
+	SearchTasks(ctx context.Context, userID string, filter aufgaben_dto.AufgabenSearchFilter) ([]*aufgaben_dto.AufgabenSearchItem, *dtos.PaginationMeta, *app_errors.AppError)
+	CreateCalendarFeed(ctx context.Context, userID, baseURL string) (*aufgaben_dto.CalendarFeedItem, *app_errors.AppError)
+	CreateProjectCalendarFeed(ctx context.Context, userID, projectID, baseURL string) (*aufgaben_dto.CalendarFeedItem, *app_errors.AppError)
+	ListCalendarFeeds(ctx context.Context, userID string) ([]*aufgaben_dto.CalendarFeedItem, *app_errors.AppError)
+	RevokeCalendarFeed(ctx context.Context, userID, feedID string) *app_errors.AppError
+	GetCalendarFeed(ctx context.Context, rawToken, baseURL string, query *aufgaben_dto.CalendarFeedQuery) ([]byte, *app_errors.AppError)
 }
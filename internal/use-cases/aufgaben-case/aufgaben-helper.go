@@ -1,14 +1,37 @@
 package aufgaben_case
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/tx"
+	"github.com/Xenn-00/aufgaben-meister/internal/dtos"
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/Xenn-00/aufgaben-meister/internal/utils"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
 )
 
 // verifyProjectMember checks if user is a project member
@@ -52,8 +75,29 @@ func (s *AufgabenService) getTaskAndVerifyMember(ctx context.Context, projectID,
 	return task, nil
 }
 
-// verifyTaskAssignee checks if user is the task assignee
-func (s *AufgabenService) verifyTaskAssignee(task *entity.AufgabenEntity, userID string) *app_errors.AppError {
+// verifyTaskAssignee checks if user is one of the task assignees, whatever their role
+func (s *AufgabenService) verifyTaskAssignee(ctx context.Context, task *entity.AufgabenEntity, userID string) *app_errors.AppError {
+	if task.AssigneeID != nil && *task.AssigneeID == userID {
+		return nil
+	}
+
+	role, err := s.repo.GetTaskAssigneeRole(ctx, task.ID, userID)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return app_errors.NewAppError(
+			fiber.StatusForbidden,
+			app_errors.ErrForbidden,
+			"forbidden.not_task_assignee",
+			nil,
+		)
+	}
+	return nil
+}
+
+// verifyTaskOwner checks if user is the owner of the task, i.e. the assignee the task status follows
+func (s *AufgabenService) verifyTaskOwner(task *entity.AufgabenEntity, userID string) *app_errors.AppError {
 	if task.AssigneeID == nil || *task.AssigneeID != userID {
 		return app_errors.NewAppError(
 			fiber.StatusForbidden,
@@ -92,3 +136,1799 @@ func (s *AufgabenService) createAndInsertEvent(ctx context.Context, tx tx.Tx, ev
 
 	return eventID.String(), nil
 }
+
+// verifyTaskInProject checks if task belongs to the given project
+func (s *AufgabenService) verifyTaskInProject(task *entity.AufgabenEntity, projectID string) *app_errors.AppError {
+	if task.ProjectID != projectID {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_not_found", nil)
+	}
+	return nil
+}
+
+// invalidateTaskDetails removes cached task details, a stale cache is only logged
+func (s *AufgabenService) invalidateTaskDetails(ctx context.Context, taskID string) {
+	cacheKey := fmt.Sprintf("aufgaben:details:%s", taskID)
+	if err := s.cache.Del(ctx, cacheKey); err != nil {
+		log.Error().Err(err).Msgf("Fehler beim Löschen des Redis-Cache für %s", cacheKey)
+	}
+}
+
+// buildSubtaskProgress maps the subtask rollup into its response form
+func buildSubtaskProgress(progress *entity.SubtaskProgress) *aufgaben_dto.SubtaskProgressItem {
+	item := &aufgaben_dto.SubtaskProgressItem{
+		Total: progress.Total,
+		Done:  progress.Done,
+	}
+	if progress.Total > 0 {
+		item.Percent = progress.Done * 100 / progress.Total
+	}
+	return item
+}
+
+// verifyTaskUnblocked checks on the tx if every blocker of the task is already done, the project's dependency lock
+// holds off new dependencies and reopened blockers until the tx ends
+func (s *AufgabenService) verifyTaskUnblocked(ctx context.Context, t tx.Tx, projectID, taskID string) *app_errors.AppError {
+	if err := s.repo.LockProjectDependencies(ctx, t, projectID); err != nil {
+		return err
+	}
+
+	openBlockers, err := s.repo.CountOpenBlockers(ctx, t, taskID)
+	if err != nil {
+		return err
+	}
+	if openBlockers > 0 {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_blocked", fmt.Errorf("Task is still blocked by %d open task(s)", openBlockers))
+	}
+	return nil
+}
+
+// notifyUnblockedDependents enqueues a notification for every assigned task that was unblocked by the completed one
+func (s *AufgabenService) notifyUnblockedDependents(completed *entity.AufgabenEntity, unblocked []entity.UnblockedAufgaben) {
+	for _, aufgabe := range unblocked {
+		if aufgabe.AssigneeID == nil {
+			continue
+		}
+
+		payloadTask := &worker_task.DependencyUnblockedNotify{
+			AufgabeID:        aufgabe.ID,
+			AufgabeTitle:     aufgabe.Title,
+			ProjectID:        aufgabe.ProjectID,
+			ProjectName:      aufgabe.ProjectName,
+			AssigneeID:       *aufgabe.AssigneeID,
+			UnblockedByID:    completed.ID,
+			UnblockedByTitle: completed.Title,
+			UnblockedAt:      time.Now(),
+		}
+		if err := s.taskQueue.EnqueueDependencyUnblockedNotify(payloadTask); err != nil {
+			log.Error().Err(err).Msg("Fehler beim Stellen die Aufgabe in die Warteschlange")
+		}
+	}
+}
+
+// buildDependencyItems maps dependency links into their response form
+func buildDependencyItems(links []entity.DependencyLink) []*aufgaben_dto.DependencyItem {
+	items := make([]*aufgaben_dto.DependencyItem, 0, len(links))
+	for _, link := range links {
+		items = append(items, &aufgaben_dto.DependencyItem{
+			AufgabenID: link.AufgabenID,
+			Title:      link.Title,
+			Status:     string(link.Status),
+			AssigneeID: link.AssigneeID,
+			IsOpen:     link.Status != entity.AufgabenDone && link.ArchivedAt == nil,
+			CreatedBy:  link.CreatedBy,
+			CreatedAt:  link.CreatedAt,
+		})
+	}
+	return items
+}
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([A-Za-z0-9_.\-]{3,30})`)
+
+// parseMentions extracts unique lowercased usernames mentioned as @username in the body
+func parseMentions(body string) []string {
+	seen := make(map[string]struct{})
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		// Trailing punctuation belongs to the sentence, not to the username
+		username := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if len(username) < 3 {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
+// resolveMentions maps mentioned usernames to project members, the author never mentions themselves
+func (s *AufgabenService) resolveMentions(ctx context.Context, projectID, authorID, body string) ([]entity.MentionedUser, *app_errors.AppError) {
+	usernames := parseMentions(body)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	members, err := s.repo.FindMentionableMembers(ctx, projectID, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentioned []entity.MentionedUser
+	for _, member := range members {
+		if member.UserID == authorID {
+			continue
+		}
+		mentioned = append(mentioned, member)
+	}
+	return mentioned, nil
+}
+
+// getCommentForTask gets comment and verifies it belongs to the task
+func (s *AufgabenService) getCommentForTask(ctx context.Context, taskID, commentID string) (*entity.AufgabenCommentEntity, *app_errors.AppError) {
+	comment, err := s.repo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AufgabenID != taskID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "comment_not_found", nil)
+	}
+	return comment, nil
+}
+
+// notifyMentions enqueues a notification for every newly mentioned user
+func (s *AufgabenService) notifyMentions(task *entity.AufgabenEntity, comment *entity.AufgabenCommentEntity, userIDs []string) {
+	projectName := ""
+	if task.ProjectName != nil {
+		projectName = *task.ProjectName
+	}
+
+	for _, userID := range userIDs {
+		payloadTask := &worker_task.CommentMentionNotify{
+			CommentID:       comment.ID,
+			AufgabeID:       task.ID,
+			AufgabeTitle:    task.Title,
+			ProjectID:       task.ProjectID,
+			ProjectName:     projectName,
+			AuthorID:        comment.AuthorID,
+			MentionedUserID: userID,
+			Excerpt:         buildCommentExcerpt(comment.Body),
+			MentionedAt:     time.Now(),
+		}
+		if err := s.taskQueue.EnqueueCommentMentionNotify(payloadTask); err != nil {
+			log.Error().Err(err).Msg("Fehler beim Stellen die Aufgabe in die Warteschlange")
+		}
+	}
+}
+
+// notifyWatchers enqueues one notification per change, the worker resolves and mails the watchers
+func (s *AufgabenService) notifyWatchers(task *entity.AufgabenEntity, actorID, change, detail string) {
+	projectName := ""
+	if task.ProjectName != nil {
+		projectName = *task.ProjectName
+	}
+
+	payloadTask := &worker_task.WatcherNotify{
+		AufgabeID:    task.ID,
+		AufgabeTitle: task.Title,
+		ProjectID:    task.ProjectID,
+		ProjectName:  projectName,
+		ActorID:      actorID,
+		Change:       change,
+		Detail:       detail,
+		OccurredAt:   time.Now(),
+	}
+	if err := s.taskQueue.EnqueueWatcherNotify(payloadTask); err != nil {
+		log.Error().Err(err).Msg("Fehler beim Stellen die Aufgabe in die Warteschlange")
+	}
+}
+
+// buildCommentExcerpt shortens comment body for notifications
+func buildCommentExcerpt(body string) string {
+	const maxExcerpt = 200
+	runes := []rune(body)
+	if len(runes) <= maxExcerpt {
+		return body
+	}
+	return string(runes[:maxExcerpt]) + "..."
+}
+
+// buildCommentItem maps comment entity into its response form
+func buildCommentItem(comment *entity.AufgabenCommentEntity, mentioned []entity.MentionedUser) *aufgaben_dto.CommentItem {
+	item := &aufgaben_dto.CommentItem{
+		CommentID: comment.ID,
+		AufgabeID: comment.AufgabenID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		EditedAt:  comment.EditedAt,
+	}
+	for _, user := range mentioned {
+		item.Mentions = append(item.Mentions, user.Username)
+	}
+	return item
+}
+
+// currentCommentMentions keeps the stored mentions that are still written in the comment body
+func currentCommentMentions(body string, stored []entity.MentionedUser) []entity.MentionedUser {
+	usernames := parseMentions(body)
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	var mentioned []entity.MentionedUser
+	for _, user := range stored {
+		if slices.Contains(usernames, strings.ToLower(user.Username)) {
+			mentioned = append(mentioned, user)
+		}
+	}
+	return mentioned
+}
+
+// mentionedUserIDs collects user ids of mentioned users
+func mentionedUserIDs(mentioned []entity.MentionedUser) []string {
+	userIDs := make([]string, 0, len(mentioned))
+	for _, user := range mentioned {
+		userIDs = append(userIDs, user.UserID)
+	}
+	return userIDs
+}
+
+// normalizeLabelNames splits comma separated names, lowercases and deduplicates them
+func normalizeLabelNames(labels []string) []string {
+	seen := make(map[string]struct{})
+	var names []string
+	for _, raw := range labels {
+		for _, part := range strings.Split(raw, ",") {
+			name := strings.ToLower(strings.TrimSpace(part))
+			if name == "" {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// taskSortKeys are the columns a task list can be sorted by
+var taskSortKeys = []string{"created_at", "updated_at", "due_date", "priority"}
+
+// normalizeTaskSort splits comma separated sort keys, e.g. "priority,-due_date", a key may only be used once
+func normalizeTaskSort(sort []string) ([]string, *app_errors.AppError) {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, raw := range sort {
+		for _, part := range strings.Split(raw, ",") {
+			key := strings.ToLower(strings.TrimSpace(part))
+			if key == "" {
+				continue
+			}
+			name := strings.TrimPrefix(key, "-")
+			if !slices.Contains(taskSortKeys, name) {
+				return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("Unknown sort key %q", name))
+			}
+			if _, ok := seen[name]; ok {
+				return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("Sort key %q is used twice", name))
+			}
+			seen[name] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 3 {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("At most 3 sort keys are allowed"))
+	}
+	return keys, nil
+}
+
+// getProjectLabel gets label and verifies it belongs to the project
+func (s *AufgabenService) getProjectLabel(ctx context.Context, projectID, labelID string) (*entity.LabelEntity, *app_errors.AppError) {
+	label, err := s.repo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+	if label.ProjectID != projectID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "label_not_found", nil)
+	}
+	return label, nil
+}
+
+// buildLabelItem maps label entity into its response form
+func buildLabelItem(label *entity.LabelEntity) *aufgaben_dto.LabelItem {
+	return &aufgaben_dto.LabelItem{
+		LabelID:   label.ID,
+		ProjectID: label.ProjectID,
+		Name:      label.Name,
+		Color:     label.Color,
+		CreatedAt: label.CreatedAt,
+	}
+}
+
+// buildLabelItems maps label entities into their response form
+func buildLabelItems(labels []entity.LabelEntity) []*aufgaben_dto.LabelItem {
+	items := make([]*aufgaben_dto.LabelItem, 0, len(labels))
+	for i := range labels {
+		items = append(items, buildLabelItem(&labels[i]))
+	}
+	return items
+}
+
+func (s *AufgabenService) searchTasks(ctx context.Context, userID string, filter *aufgaben_dto.AufgabenSearchFilter) ([]*aufgaben_dto.AufgabenSearchItem, *dtos.PaginationMeta, *app_errors.AppError) {
+	results, err := s.repo.SearchTasks(ctx, userID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var total int64
+	responses := make([]*aufgaben_dto.AufgabenSearchItem, 0, len(results))
+	for _, result := range results {
+		total = result.Total
+		responses = append(responses, &aufgaben_dto.AufgabenSearchItem{
+			AufgabenID:     result.ID,
+			ProjectID:      result.ProjectID,
+			ProjectName:    result.ProjectName,
+			Title:          result.Title,
+			Status:         string(result.Status),
+			Priority:       string(result.Priority),
+			AssigneeID:     result.AssigneeID,
+			DueDate:        result.DueDate,
+			Rank:           result.Rank,
+			Snippet:        result.Snippet,
+			CommentSnippet: result.CommentSnippet,
+		})
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	paginationMeta := &dtos.PaginationMeta{
+		Page:       filter.Page,
+		Limit:      filter.Limit,
+		Total:      int(total),
+		TotalPages: totalPages,
+	}
+
+	return responses, paginationMeta, nil
+}
+
+// diffTaskContent applies the edit request on top of the task and returns the resulting values with changed fields
+func diffTaskContent(task *entity.AufgabenEntity, req *aufgaben_dto.UpdateAufgabenRequest) (string, *string, entity.AufgabenPriority, []aufgaben_dto.FieldChangeItem) {
+	title := task.Title
+	description := task.Description
+	priority := task.Priority
+	var changes []aufgaben_dto.FieldChangeItem
+
+	if req.Title != nil {
+		newTitle := strings.TrimSpace(*req.Title)
+		if newTitle != "" && newTitle != title {
+			oldTitle := title
+			changes = append(changes, aufgaben_dto.FieldChangeItem{Field: "title", OldValue: &oldTitle, NewValue: &newTitle})
+			title = newTitle
+		}
+	}
+
+	if req.Description != nil {
+		// Empty description clears it
+		var newDescription *string
+		if trimmed := strings.TrimSpace(*req.Description); trimmed != "" {
+			newDescription = &trimmed
+		}
+		if !equalOptionalString(description, newDescription) {
+			changes = append(changes, aufgaben_dto.FieldChangeItem{Field: "description", OldValue: description, NewValue: newDescription})
+			description = newDescription
+		}
+	}
+
+	if req.Priority != nil {
+		newPriority := entity.AufgabenPriority(*req.Priority)
+		if newPriority != priority {
+			oldValue := string(priority)
+			newValue := string(newPriority)
+			changes = append(changes, aufgaben_dto.FieldChangeItem{Field: "priority", OldValue: &oldValue, NewValue: &newValue})
+			priority = newPriority
+		}
+	}
+
+	return title, description, priority, changes
+}
+
+func equalOptionalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+// defaultWorkflow describes the built-in behavior, used by projects without a custom workflow
+func defaultWorkflow(projectID string) *entity.ProjectWorkflow {
+	return &entity.ProjectWorkflow{
+		ProjectID: projectID,
+		Statuses: []entity.WorkflowStatusEntity{
+			{Key: string(entity.AufgabenTodo), Name: "Todo", Category: entity.AufgabenTodo, Position: 0},
+			{Key: string(entity.AufgabenInProgress), Name: "In Progress", Category: entity.AufgabenInProgress, Position: 1},
+			{Key: string(entity.AufgabenDone), Name: "Done", Category: entity.AufgabenDone, Position: 2},
+		},
+		Transitions: []entity.WorkflowTransitionEntity{
+			{FromKey: string(entity.AufgabenTodo), ToKey: string(entity.AufgabenInProgress)},
+			{FromKey: string(entity.AufgabenInProgress), ToKey: string(entity.AufgabenTodo)},
+			{FromKey: string(entity.AufgabenInProgress), ToKey: string(entity.AufgabenDone)},
+			{FromKey: string(entity.AufgabenDone), ToKey: string(entity.AufgabenTodo), AllowedRoles: []entity.UserRole{entity.MEISTER}},
+			{FromKey: string(entity.AufgabenDone), ToKey: string(entity.AufgabenInProgress), AllowedRoles: []entity.UserRole{entity.MEISTER}},
+		},
+		IsDefault: true,
+	}
+}
+
+// getProjectWorkflow returns the project's workflow, falling back to the default one
+func (s *AufgabenService) getProjectWorkflow(ctx context.Context, projectID string) (*entity.ProjectWorkflow, *app_errors.AppError) {
+	workflow, err := s.repo.GetProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return defaultWorkflow(projectID), nil
+	}
+	return workflow, nil
+}
+
+func findWorkflowStatus(workflow *entity.ProjectWorkflow, key string) *entity.WorkflowStatusEntity {
+	for i := range workflow.Statuses {
+		if workflow.Statuses[i].Key == key {
+			return &workflow.Statuses[i]
+		}
+	}
+	return nil
+}
+
+// currentWorkflowStatus resolves where the task stands in the workflow, a missing or stale key falls back to the first status of the task's category
+func currentWorkflowStatus(workflow *entity.ProjectWorkflow, task *entity.AufgabenEntity) *entity.WorkflowStatusEntity {
+	if task.WorkflowStatus != nil {
+		if status := findWorkflowStatus(workflow, *task.WorkflowStatus); status != nil && status.Category == task.Status {
+			return status
+		}
+	}
+	for i := range workflow.Statuses {
+		if workflow.Statuses[i].Category == task.Status {
+			return &workflow.Statuses[i]
+		}
+	}
+	return nil
+}
+
+func findWorkflowTransition(workflow *entity.ProjectWorkflow, fromKey, toKey string) *entity.WorkflowTransitionEntity {
+	for i := range workflow.Transitions {
+		if workflow.Transitions[i].FromKey == fromKey && workflow.Transitions[i].ToKey == toKey {
+			return &workflow.Transitions[i]
+		}
+	}
+	return nil
+}
+
+// resolveWorkflowTransition picks the first target the performer may move the task to, in workflow order
+func (s *AufgabenService) resolveWorkflowTransition(ctx context.Context, projectID, userID string, workflow *entity.ProjectWorkflow, task *entity.AufgabenEntity, match func(status *entity.WorkflowStatusEntity) bool) (*entity.WorkflowStatusEntity, *app_errors.AppError) {
+	current := currentWorkflowStatus(workflow, task)
+	if current == nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.transition_not_allowed", fmt.Errorf("task status %s is not part of the workflow", task.Status))
+	}
+
+	var userRole *entity.UserRole
+	roleDenied := false
+	for i := range workflow.Statuses {
+		target := &workflow.Statuses[i]
+		if target.Key == current.Key || !match(target) {
+			continue
+		}
+
+		transition := findWorkflowTransition(workflow, current.Key, target.Key)
+		if transition == nil {
+			continue
+		}
+
+		if len(transition.AllowedRoles) > 0 {
+			// Role is only looked up when a transition is restricted
+			if userRole == nil {
+				role, err := s.repo.GetUserRole(ctx, projectID, userID)
+				if err != nil {
+					return nil, err
+				}
+				userRole = role
+			}
+			if !slices.Contains(transition.AllowedRoles, *userRole) {
+				roleDenied = true
+				continue
+			}
+		}
+
+		return target, nil
+	}
+
+	if roleDenied {
+		return nil, app_errors.NewAppError(fiber.StatusForbidden, app_errors.ErrForbidden, "forbidden.transition_role", nil)
+	}
+	return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.transition_not_allowed", fmt.Errorf("no transition from %s", current.Key))
+}
+
+// verifyCategoryTransition checks a status change done by a built-in action (assign, complete, ...) against the project's workflow
+func (s *AufgabenService) verifyCategoryTransition(ctx context.Context, projectID, userID string, task *entity.AufgabenEntity, category entity.AufgabenStatus) (*entity.WorkflowStatusEntity, *app_errors.AppError) {
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resolveWorkflowTransition(ctx, projectID, userID, workflow, task, func(status *entity.WorkflowStatusEntity) bool {
+		return status.Category == category
+	})
+}
+
+// boardColumnOf resolves the workflow column a board task is shown in
+func boardColumnOf(workflow *entity.ProjectWorkflow, task *entity.BoardTaskEntity) *entity.WorkflowStatusEntity {
+	return currentWorkflowStatus(workflow, &entity.AufgabenEntity{Status: task.Status, WorkflowStatus: task.WorkflowStatus})
+}
+
+// boardColumnTasks locks and returns the tasks of one column in board order, leaving out the given task
+func (s *AufgabenService) boardColumnTasks(ctx context.Context, t tx.Tx, projectID string, workflow *entity.ProjectWorkflow, column *entity.WorkflowStatusEntity, excludeTaskID string) ([]entity.BoardTaskEntity, *app_errors.AppError) {
+	tasks, err := s.repo.LockBoardTasks(ctx, t, projectID, column.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	columnTasks := make([]entity.BoardTaskEntity, 0, len(tasks))
+	for i := range tasks {
+		if tasks[i].ID == excludeTaskID {
+			continue
+		}
+		if status := boardColumnOf(workflow, &tasks[i]); status != nil && status.Key == column.Key {
+			columnTasks = append(columnTasks, tasks[i])
+		}
+	}
+	return columnTasks, nil
+}
+
+// verifyWIPLimit checks on the tx that the task still fits into the target column, leaving work (complete, unassign) is never blocked.
+// The column stays locked until the tx ends, so concurrent moves into it are counted one after another.
+func (s *AufgabenService) verifyWIPLimit(ctx context.Context, t tx.Tx, projectID, taskID string, target *entity.WorkflowStatusEntity) *app_errors.AppError {
+	limit, err := s.repo.GetBoardColumnWIPLimit(ctx, projectID, target.Key)
+	if err != nil {
+		return err
+	}
+	if limit == nil {
+		return nil
+	}
+
+	if err := s.repo.LockBoardColumn(ctx, t, projectID, target.Key); err != nil {
+		return err
+	}
+
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	columnTasks, err := s.boardColumnTasks(ctx, t, projectID, workflow, target, taskID)
+	if err != nil {
+		return err
+	}
+
+	if len(columnTasks) >= *limit {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.wip_limit_reached", fmt.Errorf("column %s already holds %d of %d tasks", target.Key, len(columnTasks), *limit))
+	}
+	return nil
+}
+
+func buildBoardTaskItem(task *entity.BoardTaskEntity) aufgaben_dto.BoardTaskItem {
+	return aufgaben_dto.BoardTaskItem{
+		AufgabenID: task.ID,
+		Title:      task.Title,
+		Priority:   string(task.Priority),
+		AssigneeID: task.AssigneeID,
+		DueDate:    task.DueDate,
+		Rank:       task.BoardRank,
+	}
+}
+
+func buildBoardColumnLimitItem(column *entity.BoardColumnEntity) *aufgaben_dto.BoardColumnLimitItem {
+	return &aufgaben_dto.BoardColumnLimitItem{
+		StatusKey: column.StatusKey,
+		WIPLimit:  column.WIPLimit,
+		UpdatedBy: column.UpdatedBy,
+		CreatedAt: column.CreatedAt,
+		UpdatedAt: column.UpdatedAt,
+	}
+}
+
+// getProjectMilestone gets milestone and verifies it belongs to the project
+func (s *AufgabenService) getProjectMilestone(ctx context.Context, projectID, milestoneID string) (*entity.MilestoneEntity, *app_errors.AppError) {
+	milestone, err := s.repo.GetMilestoneByID(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if milestone.ProjectID != projectID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "milestone_not_found", nil)
+	}
+	return milestone, nil
+}
+
+// parseMilestoneDates parses the date range of a milestone, the end day is part of the milestone
+func parseMilestoneDates(startDate, endDate string) (time.Time, time.Time, *app_errors.AppError) {
+	start, parseErr := time.Parse(time.DateOnly, startDate)
+	if parseErr != nil {
+		return time.Time{}, time.Time{}, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", parseErr)
+	}
+	end, parseErr := time.Parse(time.DateOnly, endDate)
+	if parseErr != nil {
+		return time.Time{}, time.Time{}, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", parseErr)
+	}
+	if end.Before(start) || end.Sub(start) > 366*24*time.Hour {
+		return time.Time{}, time.Time{}, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_date_range", nil)
+	}
+	return start, end, nil
+}
+
+func buildMilestoneItem(milestone *entity.MilestoneEntity) *aufgaben_dto.MilestoneItem {
+	return &aufgaben_dto.MilestoneItem{
+		MilestoneID: milestone.ID,
+		ProjectID:   milestone.ProjectID,
+		Name:        milestone.Name,
+		Goal:        milestone.Goal,
+		StartDate:   milestone.StartDate.Format(time.DateOnly),
+		EndDate:     milestone.EndDate.Format(time.DateOnly),
+		TotalTasks:  milestone.TotalTasks,
+		DoneTasks:   milestone.DoneTasks,
+		ClosedAt:    milestone.ClosedAt,
+		ClosedBy:    milestone.ClosedBy,
+		CreatedBy:   milestone.CreatedBy,
+		CreatedAt:   milestone.CreatedAt,
+		UpdatedAt:   milestone.UpdatedAt,
+	}
+}
+
+// buildBurndownResponse lists every day of the milestone. The ideal line falls from the scope of the first day
+// to zero on the last day, days without data (not started yet) only carry the ideal line.
+func buildBurndownResponse(milestone *entity.MilestoneEntity, days []entity.BurndownDay) *aufgaben_dto.MilestoneBurndownResponse {
+	actual := make(map[string]entity.BurndownDay, len(days))
+	for _, day := range days {
+		actual[day.Day.Format(time.DateOnly)] = day
+	}
+
+	var baseline entity.BurndownDay
+	if len(days) > 0 {
+		baseline = days[0]
+	}
+
+	total := int(milestone.EndDate.Sub(milestone.StartDate).Hours()/24) + 1
+	ideal := func(scope float64, i int) float64 {
+		if total <= 1 {
+			return 0
+		}
+		return math.Round(scope*float64(total-1-i)/float64(total-1)*100) / 100
+	}
+
+	resp := &aufgaben_dto.MilestoneBurndownResponse{
+		MilestoneID: milestone.ID,
+		Name:        milestone.Name,
+		StartDate:   milestone.StartDate.Format(time.DateOnly),
+		EndDate:     milestone.EndDate.Format(time.DateOnly),
+		ClosedAt:    milestone.ClosedAt,
+		Days:        make([]aufgaben_dto.BurndownDayItem, 0, total),
+	}
+
+	for i := 0; i < total; i++ {
+		date := milestone.StartDate.AddDate(0, 0, i).Format(time.DateOnly)
+		item := aufgaben_dto.BurndownDayItem{
+			Date:                 date,
+			IdealRemainingTasks:  ideal(float64(baseline.ScopeTasks), i),
+			IdealRemainingPoints: ideal(baseline.ScopePoints, i),
+			IdealRemainingHours:  ideal(baseline.ScopeHours, i),
+		}
+		if day, ok := actual[date]; ok {
+			remainingTasks := day.ScopeTasks - day.DoneTasks
+			remainingPoints := day.ScopePoints - day.DonePoints
+			remainingHours := day.ScopeHours - day.DoneHours
+			item.ScopeTasks = &day.ScopeTasks
+			item.DoneTasks = &day.DoneTasks
+			item.RemainingTasks = &remainingTasks
+			item.ScopePoints = &day.ScopePoints
+			item.DonePoints = &day.DonePoints
+			item.RemainingPoints = &remainingPoints
+			item.ScopeHours = &day.ScopeHours
+			item.DoneHours = &day.DoneHours
+			item.RemainingHours = &remainingHours
+		}
+		resp.Days = append(resp.Days, item)
+	}
+
+	return resp
+}
+
+// overdueState tells if a task is past its due date while still open, and for how many seconds.
+// It matches the overdue filter of the task lists: done and archived tasks are never overdue.
+func overdueState(dueDate *time.Time, status entity.AufgabenStatus, now time.Time) (bool, *int64) {
+	if dueDate == nil || !dueDate.Before(now) {
+		return false, nil
+	}
+	if status == entity.AufgabenDone || status == entity.AufgabenArchived {
+		return false, nil
+	}
+
+	since := int64(now.Sub(*dueDate) / time.Second)
+	return true, &since
+}
+
+// overdueAgeBucket groups how long a task is overdue into the buckets of the overdue summary
+func overdueAgeBucket(since int64) string {
+	switch age := time.Duration(since) * time.Second; {
+	case age < 24*time.Hour:
+		return "under_1d"
+	case age < 3*24*time.Hour:
+		return "1d_3d"
+	case age < 7*24*time.Hour:
+		return "3d_7d"
+	default:
+		return "over_7d"
+	}
+}
+
+const overdueSummaryOldestLimit = 10
+
+// buildOverdueSummary aggregates the overdue tasks of a project, tasks come in longest overdue first
+func buildOverdueSummary(projectID string, tasks []entity.AufgabenEntity, now time.Time) *aufgaben_dto.OverdueSummaryResponse {
+	priorities := []entity.AufgabenPriority{entity.PriorityUrgent, entity.PriorityHigh, entity.PriorityMedium, entity.PriorityLow}
+	byPriority := make(map[entity.AufgabenPriority]int, len(priorities))
+
+	buckets := []string{"under_1d", "1d_3d", "3d_7d", "over_7d"}
+	byAge := make(map[string]int, len(buckets))
+
+	// unassigned tasks are grouped under the empty key
+	byAssignee := make(map[string]*aufgaben_dto.OverdueAssigneeItem)
+	var assigneeOrder []string
+
+	resp := &aufgaben_dto.OverdueSummaryResponse{
+		ProjectID:   projectID,
+		GeneratedAt: now,
+		Oldest:      []*aufgaben_dto.OverdueSummaryTaskItem{},
+	}
+
+	for _, task := range tasks {
+		isOverdue, since := overdueState(task.DueDate, task.Status, now)
+		if !isOverdue {
+			continue
+		}
+
+		resp.Total++
+		byPriority[task.Priority]++
+		byAge[overdueAgeBucket(*since)]++
+
+		key := ""
+		if task.AssigneeID != nil {
+			key = *task.AssigneeID
+		}
+		item, ok := byAssignee[key]
+		if !ok {
+			item = &aufgaben_dto.OverdueAssigneeItem{AssigneeID: task.AssigneeID}
+			byAssignee[key] = item
+			assigneeOrder = append(assigneeOrder, key)
+		}
+		item.Count++
+		item.OverdueSince = max(item.OverdueSince, *since)
+
+		if len(resp.Oldest) < overdueSummaryOldestLimit {
+			resp.Oldest = append(resp.Oldest, &aufgaben_dto.OverdueSummaryTaskItem{
+				AufgabenID:      task.ID,
+				Title:           task.Title,
+				Status:          string(task.Status),
+				Priority:        string(task.Priority),
+				AssigneeID:      task.AssigneeID,
+				DueDate:         *task.DueDate,
+				OverdueSince:    *since,
+				EscalationLevel: task.EscalationLevel,
+			})
+		}
+	}
+
+	resp.ByPriority = make([]aufgaben_dto.OverdueCountItem, 0, len(priorities))
+	for _, priority := range priorities {
+		resp.ByPriority = append(resp.ByPriority, aufgaben_dto.OverdueCountItem{Key: string(priority), Count: byPriority[priority]})
+	}
+
+	resp.ByAge = make([]aufgaben_dto.OverdueCountItem, 0, len(buckets))
+	for _, bucket := range buckets {
+		resp.ByAge = append(resp.ByAge, aufgaben_dto.OverdueCountItem{Key: bucket, Count: byAge[bucket]})
+	}
+
+	// most overdue tasks first, ties go to whoever is overdue the longest
+	resp.ByAssignee = make([]aufgaben_dto.OverdueAssigneeItem, 0, len(assigneeOrder))
+	for _, key := range assigneeOrder {
+		resp.ByAssignee = append(resp.ByAssignee, *byAssignee[key])
+	}
+	slices.SortStableFunc(resp.ByAssignee, func(a, b aufgaben_dto.OverdueAssigneeItem) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return cmp.Compare(b.OverdueSince, a.OverdueSince)
+	})
+
+	return resp
+}
+
+// buildWorkflowDefinition validates the requested workflow and maps it into its entity form
+func buildWorkflowDefinition(projectID string, req *aufgaben_dto.UpdateWorkflowRequest) (*entity.ProjectWorkflow, *app_errors.AppError) {
+	invalid := func(format string, args ...any) *app_errors.AppError {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrValidation, "request.invalid_workflow", fmt.Errorf(format, args...))
+	}
+
+	workflow := &entity.ProjectWorkflow{ProjectID: projectID}
+	categories := make(map[entity.AufgabenStatus]bool)
+	for i, status := range req.Statuses {
+		key := strings.TrimSpace(status.Key)
+		if findWorkflowStatus(workflow, key) != nil {
+			return nil, invalid("duplicate status key %s", key)
+		}
+		category := entity.AufgabenStatus(status.Category)
+		categories[category] = true
+		workflow.Statuses = append(workflow.Statuses, entity.WorkflowStatusEntity{
+			Key:      key,
+			Name:     strings.TrimSpace(status.Name),
+			Category: category,
+			Position: i,
+		})
+	}
+
+	// Built-in actions need somewhere to go
+	for _, category := range []entity.AufgabenStatus{entity.AufgabenTodo, entity.AufgabenInProgress, entity.AufgabenDone} {
+		if !categories[category] {
+			return nil, invalid("workflow needs at least one %s status", category)
+		}
+	}
+
+	for _, transition := range req.Transitions {
+		from := strings.TrimSpace(transition.From)
+		to := strings.TrimSpace(transition.To)
+		if findWorkflowStatus(workflow, from) == nil || findWorkflowStatus(workflow, to) == nil {
+			return nil, invalid("transition %s -> %s uses an unknown status", from, to)
+		}
+		if from == to {
+			return nil, invalid("transition %s -> %s points to itself", from, to)
+		}
+		if findWorkflowTransition(workflow, from, to) != nil {
+			return nil, invalid("duplicate transition %s -> %s", from, to)
+		}
+
+		var roles []entity.UserRole
+		for _, role := range transition.Roles {
+			if !slices.Contains(roles, entity.UserRole(role)) {
+				roles = append(roles, entity.UserRole(role))
+			}
+		}
+		workflow.Transitions = append(workflow.Transitions, entity.WorkflowTransitionEntity{
+			FromKey:      from,
+			ToKey:        to,
+			AllowedRoles: roles,
+		})
+	}
+
+	return workflow, nil
+}
+
+// buildWorkflowResponse maps the workflow into its response form
+func buildWorkflowResponse(workflow *entity.ProjectWorkflow) *aufgaben_dto.WorkflowResponse {
+	resp := &aufgaben_dto.WorkflowResponse{
+		ProjectID:   workflow.ProjectID,
+		IsDefault:   workflow.IsDefault,
+		Statuses:    make([]aufgaben_dto.WorkflowStatusItem, 0, len(workflow.Statuses)),
+		Transitions: make([]aufgaben_dto.WorkflowTransitionItem, 0, len(workflow.Transitions)),
+	}
+	for _, status := range workflow.Statuses {
+		resp.Statuses = append(resp.Statuses, aufgaben_dto.WorkflowStatusItem{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: string(status.Category),
+			Position: status.Position,
+		})
+	}
+	for _, transition := range workflow.Transitions {
+		roles := make([]string, 0, len(transition.AllowedRoles))
+		for _, role := range transition.AllowedRoles {
+			roles = append(roles, string(role))
+		}
+		resp.Transitions = append(resp.Transitions, aufgaben_dto.WorkflowTransitionItem{
+			From:  transition.FromKey,
+			To:    transition.ToKey,
+			Roles: roles,
+		})
+	}
+	return resp
+}
+
+// getWorklogForTask fetches a worklog and makes sure it belongs to the task
+func (s *AufgabenService) getWorklogForTask(ctx context.Context, taskID, worklogID string) (*entity.WorklogEntity, *app_errors.AppError) {
+	worklog, err := s.repo.GetWorklogByID(ctx, worklogID)
+	if err != nil {
+		return nil, err
+	}
+	if worklog.AufgabenID != taskID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "worklog_not_found", nil)
+	}
+	return worklog, nil
+}
+
+// worklogMinutes rounds the logged range to whole minutes, nil while the timer is running
+func worklogMinutes(worklog *entity.WorklogEntity) *int {
+	if worklog.EndedAt == nil {
+		return nil
+	}
+	minutes := int(math.Round(worklog.EndedAt.Sub(worklog.StartedAt).Minutes()))
+	return &minutes
+}
+
+func buildWorklogItem(worklog *entity.WorklogEntity) *aufgaben_dto.WorklogItem {
+	return &aufgaben_dto.WorklogItem{
+		WorklogID:       worklog.ID,
+		AufgabenID:      worklog.AufgabenID,
+		UserID:          worklog.UserID,
+		Source:          string(worklog.Source),
+		StartedAt:       worklog.StartedAt,
+		EndedAt:         worklog.EndedAt,
+		DurationMinutes: worklogMinutes(worklog),
+		Running:         worklog.EndedAt == nil,
+		Note:            worklog.Note,
+		CreatedAt:       worklog.CreatedAt,
+		UpdatedAt:       worklog.UpdatedAt,
+	}
+}
+
+// buildWorklogTotals maps totals to response items and sums them up
+func buildWorklogTotals(totals []entity.WorklogTotal) ([]aufgaben_dto.WorklogTotalItem, int) {
+	items := make([]aufgaben_dto.WorklogTotalItem, 0, len(totals))
+	sum := 0
+	for _, total := range totals {
+		items = append(items, aufgaben_dto.WorklogTotalItem{
+			ID:           total.ID,
+			Name:         total.Name,
+			TotalMinutes: total.TotalMinutes,
+		})
+		sum += total.TotalMinutes
+	}
+	return items, sum
+}
+
+// normalizeWorklogNote trims the note, an empty note clears it
+func normalizeWorklogNote(note *string) *string {
+	if note == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*note)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// buildEstimate pairs value and unit, an estimate without value is no estimate
+func buildEstimate(estimate *float64, unit *string) (*float64, *entity.EstimateUnit) {
+	if estimate == nil || unit == nil {
+		return nil, nil
+	}
+	value := math.Round(*estimate*100) / 100
+	estimateUnit := entity.EstimateUnit(*unit)
+	return &value, &estimateUnit
+}
+
+func estimateUnitString(unit *entity.EstimateUnit) *string {
+	if unit == nil {
+		return nil
+	}
+	value := string(*unit)
+	return &value
+}
+
+// formatEstimate renders an estimate for the event log, e.g. "5 Points"
+func formatEstimate(estimate *float64, unit *entity.EstimateUnit) *string {
+	if estimate == nil || unit == nil {
+		return nil
+	}
+	formatted := fmt.Sprintf("%s %s", strconv.FormatFloat(*estimate, 'f', -1, 64), *unit)
+	return &formatted
+}
+
+// buildVelocityResponse averages completed work, the running week is listed but only averaged when it is the only one
+func buildVelocityResponse(projectID string, from, to time.Time, weeks []entity.VelocityWeek) *aufgaben_dto.VelocityResponse {
+	resp := &aufgaben_dto.VelocityResponse{
+		ProjectID: projectID,
+		From:      from.Format(time.DateOnly),
+		To:        to.Format(time.DateOnly),
+		Weeks:     make([]aufgaben_dto.VelocityWeekItem, 0, len(weeks)),
+	}
+
+	for _, week := range weeks {
+		resp.Weeks = append(resp.Weeks, aufgaben_dto.VelocityWeekItem{
+			WeekStart:       week.WeekStart.Format(time.DateOnly),
+			PlannedPoints:   week.PlannedPoints,
+			PlannedHours:    week.PlannedHours,
+			PlannedTasks:    week.PlannedTasks,
+			CompletedPoints: week.CompletedPoints,
+			CompletedHours:  week.CompletedHours,
+			CompletedTasks:  week.CompletedTasks,
+		})
+	}
+
+	finished := weeks
+	if len(finished) > 1 {
+		finished = finished[:len(finished)-1]
+	}
+	if len(finished) == 0 {
+		return resp
+	}
+
+	var points, hours float64
+	var tasks int
+	for _, week := range finished {
+		points += week.CompletedPoints
+		hours += week.CompletedHours
+		tasks += week.CompletedTasks
+	}
+	count := float64(len(finished))
+	resp.AveragePoints = math.Round(points/count*100) / 100
+	resp.AverageHours = math.Round(hours/count*100) / 100
+	resp.AverageTasks = math.Round(float64(tasks)/count*100) / 100
+
+	return resp
+}
+
+// getProjectRecurrence fetches a recurrence and makes sure it belongs to the project
+func (s *AufgabenService) getProjectRecurrence(ctx context.Context, projectID, recurrenceID string) (*entity.RecurrenceEntity, *app_errors.AppError) {
+	recurrence, err := s.repo.GetRecurrenceByID(ctx, recurrenceID)
+	if err != nil {
+		return nil, err
+	}
+	if recurrence.ProjectID != projectID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "recurrence_not_found", nil)
+	}
+	return recurrence, nil
+}
+
+// nextRecurrenceRun validates the cron expression and returns its next occurrence
+func nextRecurrenceRun(cronExpr string) (time.Time, *app_errors.AppError) {
+	next, err := utils.NextOccurrence(cronExpr, time.Now())
+	if err != nil {
+		return time.Time{}, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_cron_expr", err)
+	}
+	return next, nil
+}
+
+func buildRecurrenceItem(recurrence *entity.RecurrenceEntity) *aufgaben_dto.RecurrenceItem {
+	return &aufgaben_dto.RecurrenceItem{
+		RecurrenceID:      recurrence.ID,
+		ProjectID:         recurrence.ProjectID,
+		Title:             recurrence.Title,
+		Description:       recurrence.Description,
+		Priority:          string(recurrence.Priority),
+		DefaultAssigneeID: recurrence.DefaultAssigneeID,
+		DueOffsetMinutes:  recurrence.DueOffsetMinutes,
+		CronExpr:          recurrence.CronExpr,
+		NextRunAt:         recurrence.NextRunAt,
+		LastRunAt:         recurrence.LastRunAt,
+		Active:            recurrence.Active,
+		CreatedAt:         recurrence.CreatedAt,
+		UpdatedAt:         recurrence.UpdatedAt,
+	}
+}
+
+// getProjectTemplate gets template and verifies it belongs to the project
+func (s *AufgabenService) getProjectTemplate(ctx context.Context, projectID, templateID string) (*entity.TemplateEntity, *app_errors.AppError) {
+	template, err := s.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if template.ProjectID != projectID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "template_not_found", nil)
+	}
+	return template, nil
+}
+
+// applyTemplateRequest copies the saved defaults of a create or update request into the template
+func applyTemplateRequest(template *entity.TemplateEntity, req *aufgaben_dto.SaveTemplateRequest) *app_errors.AppError {
+	name := strings.TrimSpace(req.Name)
+	title := strings.TrimSpace(req.Title)
+	if name == "" || title == "" {
+		return app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Template name and title must not be empty"))
+	}
+
+	template.Name = name
+	template.Title = title
+	template.Description = nil
+	if req.Description != nil {
+		if trimmed := strings.TrimSpace(*req.Description); trimmed != "" {
+			template.Description = &trimmed
+		}
+	}
+
+	template.Priority = entity.PriorityMedium
+	if req.Priority != nil {
+		template.Priority = entity.AufgabenPriority(*req.Priority)
+	}
+
+	template.DueOffsetMinutes = req.DueOffsetMinutes
+	template.Estimate, template.EstimateUnit = buildEstimate(req.Estimate, req.EstimateUnit)
+	return nil
+}
+
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// templateVariables lists the unique placeholder names of the given texts in order of appearance
+func templateVariables(texts ...*string) []string {
+	seen := make(map[string]struct{})
+	names := []string{}
+	for _, text := range texts {
+		if text == nil {
+			continue
+		}
+		for _, match := range templateVariablePattern.FindAllStringSubmatch(*text, -1) {
+			if _, ok := seen[match[1]]; ok {
+				continue
+			}
+			seen[match[1]] = struct{}{}
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// builtinTemplateVariables are always available, request variables with the same name take precedence
+func builtinTemplateVariables(now time.Time) map[string]string {
+	year, week := now.ISOWeek()
+	return map[string]string{
+		"date":  now.Format(time.DateOnly),
+		"week":  fmt.Sprintf("%d-W%02d", year, week),
+		"month": now.Format("2006-01"),
+		"year":  strconv.Itoa(now.Year()),
+	}
+}
+
+// renderTemplateText fills the placeholders of text, placeholders without a value are returned as missing
+func renderTemplateText(text string, variables map[string]string) (string, []string) {
+	var missing []string
+	rendered := templateVariablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := templateVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+	return rendered, missing
+}
+
+// buildTemplateAufgabenRequest merges template defaults with the overrides and fills the placeholders
+func buildTemplateAufgabenRequest(template *entity.TemplateEntity, req *aufgaben_dto.CreateFromTemplateRequest, now time.Time) (*aufgaben_dto.CreateNewAufgabenRequest, *app_errors.AppError) {
+	variables := builtinTemplateVariables(now)
+	for name, value := range req.Variables {
+		variables[name] = value
+	}
+
+	title := template.Title
+	if req.Title != nil {
+		title = *req.Title
+	}
+	description := template.Description
+	if req.Description != nil {
+		description = req.Description
+	}
+
+	title, missing := renderTemplateText(title, variables)
+	title = strings.TrimSpace(title)
+	if description != nil {
+		rendered, missingInDescription := renderTemplateText(*description, variables)
+		for _, name := range missingInDescription {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+		}
+		description = nil
+		if rendered = strings.TrimSpace(rendered); rendered != "" {
+			description = &rendered
+		}
+	}
+
+	if len(missing) > 0 {
+		details := make([]app_errors.FieldError, 0, len(missing))
+		for _, name := range missing {
+			details = append(details, app_errors.FieldError{
+				Field:      "variables." + name,
+				Reason:     "required",
+				MessageKey: "validation.template_variable",
+				Params:     map[string]any{"name": name},
+			})
+		}
+		return nil, app_errors.NewValidationError(details)
+	}
+
+	if title == "" || utf8.RuneCountInString(title) > 255 {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Rendered title must be between 1 and 255 characters"))
+	}
+
+	priority := string(template.Priority)
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+
+	// Due date override wins, otherwise the offset counts from now
+	dueDate := req.DueDate
+	if dueDate == nil && template.DueOffsetMinutes != nil {
+		due := now.Add(time.Duration(*template.DueOffsetMinutes) * time.Minute)
+		dueDate = &due
+	}
+
+	estimate, estimateUnit := template.Estimate, estimateUnitString(template.EstimateUnit)
+	if req.Estimate != nil {
+		estimate, estimateUnit = req.Estimate, req.EstimateUnit
+	}
+
+	return &aufgaben_dto.CreateNewAufgabenRequest{
+		Title:        title,
+		Description:  description,
+		Priority:     &priority,
+		DueDate:      dueDate,
+		AssigneeID:   req.AssigneeID,
+		Estimate:     estimate,
+		EstimateUnit: estimateUnit,
+	}, nil
+}
+
+// buildTemplateItem maps template entity into its response form, listing the placeholders it expects
+func buildTemplateItem(template *entity.TemplateEntity) *aufgaben_dto.TemplateItem {
+	return &aufgaben_dto.TemplateItem{
+		TemplateID:       template.ID,
+		ProjectID:        template.ProjectID,
+		Name:             template.Name,
+		Title:            template.Title,
+		Description:      template.Description,
+		Priority:         string(template.Priority),
+		DueOffsetMinutes: template.DueOffsetMinutes,
+		Estimate:         template.Estimate,
+		EstimateUnit:     estimateUnitString(template.EstimateUnit),
+		Variables:        templateVariables(&template.Title, template.Description),
+		CreatedAt:        template.CreatedAt,
+		UpdatedAt:        template.UpdatedAt,
+	}
+}
+
+// maxAttachmentSize limits a single upload, the router allows a slightly bigger body for the multipart overhead
+const maxAttachmentSize int64 = 20 << 20
+
+// allowedAttachmentTypes are the accepted declared content types of an upload
+var allowedAttachmentTypes = map[string]struct{}{
+	"application/pdf":  {},
+	"application/json": {},
+	"application/zip":  {},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   {},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         {},
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": {},
+	"image/png":     {},
+	"image/jpeg":    {},
+	"image/gif":     {},
+	"image/webp":    {},
+	"text/plain":    {},
+	"text/markdown": {},
+	"text/csv":      {},
+}
+
+// verifyAttachmentContent checks size and type of an upload, images and pdfs have to match their sniffed type
+func verifyAttachmentContent(upload *aufgaben_dto.AttachmentUpload) (string, io.Reader, *app_errors.AppError) {
+	if upload.Size <= 0 || upload.Size > maxAttachmentSize {
+		return "", nil, app_errors.NewAppError(fiber.StatusRequestEntityTooLarge, app_errors.ErrValidation, "request.attachment_too_large", fmt.Errorf("Attachment size %d is out of range", upload.Size))
+	}
+
+	contentType, _, err := mime.ParseMediaType(upload.ContentType)
+	if err != nil {
+		return "", nil, app_errors.NewAppError(fiber.StatusUnsupportedMediaType, app_errors.ErrValidation, "request.unsupported_attachment_type", err)
+	}
+	if _, ok := allowedAttachmentTypes[contentType]; !ok {
+		return "", nil, app_errors.NewAppError(fiber.StatusUnsupportedMediaType, app_errors.ErrValidation, "request.unsupported_attachment_type", fmt.Errorf("Content type %s is not allowed", contentType))
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(upload.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", err)
+	}
+	head = head[:n]
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if sniffed == "text/html" || ((strings.HasPrefix(contentType, "image/") || contentType == "application/pdf") && sniffed != contentType) {
+		return "", nil, app_errors.NewAppError(fiber.StatusUnsupportedMediaType, app_errors.ErrValidation, "request.unsupported_attachment_type", fmt.Errorf("Declared %s but content is %s", contentType, sniffed))
+	}
+
+	// The sniffed bytes are put back in front of the remaining content
+	return contentType, io.MultiReader(bytes.NewReader(head), upload.Content), nil
+}
+
+// normalizeAttachmentName keeps the base name without control characters, it is only used for display and download
+func normalizeAttachmentName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[len(runes)-255:])
+	}
+	return name
+}
+
+// getAttachmentForTask gets attachment and verifies it belongs to the task
+func (s *AufgabenService) getAttachmentForTask(ctx context.Context, taskID, attachmentID string) (*entity.AttachmentEntity, *app_errors.AppError) {
+	attachment, err := s.repo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.AufgabenID != taskID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "attachment_not_found", nil)
+	}
+	return attachment, nil
+}
+
+// removeBlob deletes stored content, a leftover blob is only logged
+func (s *AufgabenService) removeBlob(ctx context.Context, key string) {
+	if err := s.storage.Delete(ctx, key); err != nil {
+		log.Error().Err(err).Msgf("Fehler beim Löschen des Anhangs %s", key)
+	}
+}
+
+// buildAttachmentItem maps attachment entity into its response form, the storage key stays internal
+func buildAttachmentItem(attachment *entity.AttachmentEntity) *aufgaben_dto.AttachmentItem {
+	return &aufgaben_dto.AttachmentItem{
+		AttachmentID: attachment.ID,
+		AufgabenID:   attachment.AufgabenID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		UploadedBy:   attachment.UploadedBy,
+		CreatedAt:    attachment.CreatedAt,
+	}
+}
+
+// buildWatcherItem maps watcher entity into its response form
+func buildWatcherItem(watcher *entity.WatcherEntity) *aufgaben_dto.WatcherItem {
+	return &aufgaben_dto.WatcherItem{
+		AufgabenID:    watcher.AufgabenID,
+		UserID:        watcher.UserID,
+		Username:      watcher.Username,
+		WatchingSince: watcher.CreatedAt,
+	}
+}
+
+func buildTaskAssigneeItem(assignee *entity.AufgabenAssigneeEntity) *aufgaben_dto.TaskAssigneeItem {
+	return &aufgaben_dto.TaskAssigneeItem{
+		AufgabenID: assignee.AufgabenID,
+		UserID:     assignee.UserID,
+		Username:   assignee.Username,
+		Role:       string(assignee.Role),
+		AddedBy:    assignee.AddedBy,
+		AddedAt:    assignee.CreatedAt,
+	}
+}
+
+// verifyParticipantManager checks if user may change the participants of the task, i.e. is the Meister or the owner
+func (s *AufgabenService) verifyParticipantManager(ctx context.Context, projectID string, task *entity.AufgabenEntity, userID string) *app_errors.AppError {
+	userRole, err := s.repo.GetUserRole(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if userRole != nil && *userRole == entity.MEISTER {
+		return nil
+	}
+	return s.verifyTaskOwner(task, userID)
+}
+
+// workloadScore weighs what a member carries. Overdue work and recent Overload unassigns weigh more than plain open tasks,
+// urgent and high tasks count on top of being open
+func workloadScore(w *entity.MemberWorkload) float64 {
+	return float64(w.OpenAssignments) +
+		2*float64(w.OverdueCount) +
+		3*float64(w.RecentOverloads) +
+		1.5*float64(w.UrgentOpen) +
+		0.5*float64(w.HighOpen)
+}
+
+func buildAssigneeSuggestionItem(w *entity.MemberWorkload, threshold int) *aufgaben_dto.AssigneeSuggestionItem {
+	score := workloadScore(w)
+	return &aufgaben_dto.AssigneeSuggestionItem{
+		UserID:          w.UserID,
+		Username:        w.Username,
+		Role:            string(w.Role),
+		OpenAssignments: w.OpenAssignments,
+		OverdueCount:    w.OverdueCount,
+		RecentOverloads: w.RecentOverloads,
+		UrgentOpen:      w.UrgentOpen,
+		HighOpen:        w.HighOpen,
+		LoadScore:       score,
+		AboveThreshold:  threshold > 0 && score > float64(threshold),
+	}
+}
+
+// checkTargetWorkload returns a warning when the target carries more than the project's threshold.
+// The warning is advisory only, a failed lookup never blocks the handover
+func (s *AufgabenService) checkTargetWorkload(ctx context.Context, projectID, targetID string) *aufgaben_dto.WorkloadWarning {
+	threshold, err := s.repo.GetWorkloadThreshold(ctx, projectID)
+	if err != nil {
+		log.Error().Err(err.Err).Msg("Fehler beim Lesen der Auslastungsgrenze")
+		return nil
+	}
+	if threshold == 0 {
+		return nil
+	}
+
+	workloads, err := s.repo.ListMemberWorkloads(ctx, projectID, &targetID)
+	if err != nil {
+		log.Error().Err(err.Err).Msg("Fehler beim Lesen der Auslastung")
+		return nil
+	}
+	if len(workloads) == 0 {
+		return nil
+	}
+
+	score := workloadScore(&workloads[0])
+	if score <= float64(threshold) {
+		return nil
+	}
+
+	return &aufgaben_dto.WorkloadWarning{
+		UserID:    targetID,
+		LoadScore: score,
+		Threshold: threshold,
+	}
+}
+
+func buildSLAPolicyItem(policy *entity.SLAPolicyEntity) *aufgaben_dto.SLAPolicyItem {
+	return &aufgaben_dto.SLAPolicyItem{
+		Priority:              string(policy.Priority),
+		AssignWithinMinutes:   policy.AssignWithinMinutes,
+		CompleteWithinMinutes: policy.CompleteWithinMinutes,
+		UpdatedBy:             policy.UpdatedBy,
+		CreatedAt:             policy.CreatedAt,
+		UpdatedAt:             policy.UpdatedAt,
+	}
+}
+
+func buildEscalationLevelItem(level *entity.EscalationLevelEntity) *aufgaben_dto.EscalationLevelItem {
+	return &aufgaben_dto.EscalationLevelItem{
+		Level:             level.Level,
+		AfterReminders:    level.AfterReminders,
+		AfterHoursOverdue: level.AfterHoursOverdue,
+		Action:            string(level.Action),
+		UpdatedBy:         level.UpdatedBy,
+		CreatedAt:         level.CreatedAt,
+		UpdatedAt:         level.UpdatedAt,
+	}
+}
+
+// slaCompliance is the share of measured tasks that met the target in percent, nothing measured means nothing was missed
+func slaCompliance(measured, breaches int) float64 {
+	if measured == 0 {
+		return 100
+	}
+	return math.Round(float64(measured-breaches)/float64(measured)*1000) / 10
+}
+
+// handoverRequestTTL is how long the Meister has to decide on a handover request
+const handoverRequestTTL = 72 * time.Hour
+
+// verifyHandoverTarget checks that the task actually changes hands
+func verifyHandoverTarget(task *entity.AufgabenEntity, targetID string) *app_errors.AppError {
+	if task.AssigneeID != nil && *task.AssigneeID == targetID {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.target_invalid", fmt.Errorf("Target and current assignee can't be the same person"))
+	}
+	return nil
+}
+
+// executeHandover moves the task to the target and records the handover, shared by forced and approved handovers
+func (s *AufgabenService) executeHandover(ctx context.Context, tx tx.Tx, userID, projectID string, task *entity.AufgabenEntity, req *aufgaben_dto.ForceAufgabeHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError) {
+	newAufgabe, err := s.repo.AssignTask(ctx, tx, projectID, task.ID, req.TargetID, task.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert handover event
+	previousAssignee := ""
+	if task.AssigneeID != nil {
+		previousAssignee = *task.AssigneeID
+	}
+	note := fmt.Sprintf("Force handover assignment from %s to %s, note: %s", previousAssignee, req.TargetID, req.Note)
+
+	handoverEvent := &entity.AddAssignment{
+		AufgabenID:       task.ID,
+		ActorID:          userID,
+		TargetAssigneeID: &req.TargetID,
+		Action:           entity.ActionHandoverExecute,
+		Note:             &note,
+		ReasonText:       &req.Reason,
+		ReasonCode:       entity.ReasonCodeEvent(req.ReasonCode),
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, handoverEvent); err != nil {
+		return nil, err
+	}
+
+	resp := &aufgaben_dto.ReassignAufgabenResponse{
+		AufgabenID:    newAufgabe.ID,
+		Status:        string(newAufgabe.Status),
+		NewAssigneeID: &newAufgabe.AssigneeID,
+		Note:          *handoverEvent.Note,
+		Action:        string(handoverEvent.Action),
+		Reason:        handoverEvent.ReasonText,
+	}
+
+	return resp, nil
+}
+
+// getPendingHandoverRequest locks a request of the project that can still be decided
+func (s *AufgabenService) getPendingHandoverRequest(ctx context.Context, tx tx.Tx, projectID, requestID string) (*entity.HandoverRequestEntity, *app_errors.AppError) {
+	request, err := s.repo.GetHandoverRequestForUpdate(ctx, tx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ProjectID != projectID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "handover_request_not_found", nil)
+	}
+
+	if request.Status != entity.HandoverPending {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.handover_request_closed", fmt.Errorf("Handover request is already %s", request.Status))
+	}
+
+	// The worker may not have marked it yet
+	if !request.ExpiresAt.After(time.Now()) {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.handover_request_expired", nil)
+	}
+
+	return request, nil
+}
+
+// notifyHandoverDecision enqueues the outcome of a handover request for its requester
+func (s *AufgabenService) notifyHandoverDecision(task *entity.AufgabenEntity, request *entity.HandoverRequestEntity, deciderID string, outcome entity.HandoverRequestStatus, reason string, decidedAt time.Time) {
+	projectName := ""
+	if task.ProjectName != nil {
+		projectName = *task.ProjectName
+	}
+
+	payloadTask := &worker_task.HandoverDecisionNotify{
+		RequestID:        request.ID,
+		AufgabeID:        task.ID,
+		AufgabeTitle:     task.Title,
+		ProjectID:        task.ProjectID,
+		ProjectName:      projectName,
+		RequesterID:      request.RequestedBy,
+		TargetAssigneeID: request.TargetAssigneeID,
+		DeciderID:        deciderID,
+		Outcome:          string(outcome),
+		Reason:           reason,
+		DecidedAt:        decidedAt,
+	}
+	if err := s.taskQueue.EnqueueHandoverDecisionNotify(payloadTask); err != nil {
+		log.Error().Err(err).Msg("Fehler beim Stellen die Aufgabe in die Warteschlange")
+	}
+}
+
+// buildHandoverRequestItem maps handover request entity into its response form
+func buildHandoverRequestItem(request *entity.HandoverRequestEntity) *aufgaben_dto.HandoverRequestItem {
+	return &aufgaben_dto.HandoverRequestItem{
+		RequestID:        request.ID,
+		AufgabenID:       request.AufgabenID,
+		AufgabeTitle:     request.AufgabeTitle,
+		RequestedBy:      request.RequestedBy,
+		TargetAssigneeID: request.TargetAssigneeID,
+		Note:             request.Note,
+		Status:           string(request.Status),
+		DecidedBy:        request.DecidedBy,
+		DecisionReason:   request.DecisionReason,
+		DecidedAt:        request.DecidedAt,
+		ExpiresAt:        request.ExpiresAt,
+		CreatedAt:        request.CreatedAt,
+	}
+}
+
+const (
+	// calendarFeedLimit caps the tasks of one feed, calendar apps poll the whole feed every time
+	calendarFeedLimit = 500
+	// calendarFeedPastDays is how far back the project feed reaches, older due dates are history
+	calendarFeedPastDays = 90
+)
+
+// calendarFeedTask is what a feed needs of a task, no matter if it comes from the assigned or the project list
+type calendarFeedTask struct {
+	ID          string
+	ProjectID   string
+	ProjectName string
+	Title       string
+	Description *string
+	Status      entity.AufgabenStatus
+	Priority    entity.AufgabenPriority
+	DueDate     time.Time
+}
+
+// hashCalendarFeedToken is how the token is stored, the raw token only ever lives in the feed URL
+func hashCalendarFeedToken(rawToken string) string {
+	sum := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func calendarFeedURL(baseURL, rawToken string) string {
+	return fmt.Sprintf("%s/api/v1/calendar/feed/%s.ics", strings.TrimRight(baseURL, "/"), rawToken)
+}
+
+func calendarTaskURL(baseURL, projectID, taskID string) string {
+	return fmt.Sprintf("%s/api/v1/project/%s/aufgaben/%s", strings.TrimRight(baseURL, "/"), projectID, taskID)
+}
+
+func buildCalendarFeedItem(feed *entity.CalendarFeedEntity) *aufgaben_dto.CalendarFeedItem {
+	scope := "user"
+	if feed.ProjectID != nil {
+		scope = "project"
+	}
+
+	return &aufgaben_dto.CalendarFeedItem{
+		FeedID:      feed.ID,
+		Scope:       scope,
+		ProjectID:   feed.ProjectID,
+		ProjectName: feed.ProjectName,
+		CreatedAt:   feed.CreatedAt,
+		LastUsedAt:  feed.LastUsedAt,
+	}
+}
+
+// issueCalendarFeed creates a new feed token for the scope and revokes the one issued before, a nil project is the personal feed
+func (s *AufgabenService) issueCalendarFeed(ctx context.Context, userID string, projectID *string, baseURL string) (*aufgaben_dto.CalendarFeedItem, *app_errors.AppError) {
+	rawToken, genErr := gonanoid.New(32)
+	if genErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "token_generation_failed", genErr)
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.RevokeActiveCalendarFeed(ctx, tx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	feed, err := s.repo.InsertCalendarFeed(ctx, tx, &entity.CalendarFeedEntity{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		ProjectID: projectID,
+		TokenHash: hashCalendarFeedToken(rawToken),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	resp := buildCalendarFeedItem(feed)
+	url := calendarFeedURL(baseURL, rawToken)
+	resp.URL = &url
+
+	return resp, nil
+}
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// writeICalLine ends the content line with CRLF and folds it after 75 octets as RFC 5545 asks, never inside a UTF-8 sequence
+func writeICalLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space of a continuation line counts towards its 75 octets
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icalPriority maps onto the 1 (highest) to 9 (lowest) scale of RFC 5545, 0 would mean undefined
+func icalPriority(priority entity.AufgabenPriority) int {
+	switch priority {
+	case entity.PriorityUrgent:
+		return 1
+	case entity.PriorityHigh:
+		return 3
+	case entity.PriorityMedium:
+		return 5
+	default:
+		return 9
+	}
+}
+
+func icalTodoStatus(status entity.AufgabenStatus) string {
+	switch status {
+	case entity.AufgabenInProgress:
+		return "IN-PROCESS"
+	case entity.AufgabenDone:
+		return "COMPLETED"
+	case entity.AufgabenArchived:
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// buildCalendarFeed renders the tasks as iCalendar, as VEVENT at the due date or as VTODO due at it.
+// A VEVENT has no status for task progress, so the task status also goes into the categories and the description.
+func buildCalendarFeed(name, baseURL, component string, tasks []calendarFeedTask, now time.Time) []byte {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//Aufgaben Meister//Calendar Feed//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+icalTextEscaper.Replace(name))
+	writeICalLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeICalLine(&b, "X-PUBLISHED-TTL:PT1H")
+
+	kind := "VEVENT"
+	if component == "todo" {
+		kind = "VTODO"
+	}
+
+	stamp := formatICalTime(now)
+	for _, task := range tasks {
+		description := fmt.Sprintf("Project: %s\nStatus: %s\nPriority: %s", task.ProjectName, task.Status, task.Priority)
+		if task.Description != nil && *task.Description != "" {
+			description += "\n\n" + *task.Description
+		}
+
+		writeICalLine(&b, "BEGIN:"+kind)
+		writeICalLine(&b, "UID:"+task.ID+"@aufgaben-meister")
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		if kind == "VTODO" {
+			writeICalLine(&b, "DUE:"+formatICalTime(task.DueDate))
+			writeICalLine(&b, "STATUS:"+icalTodoStatus(task.Status))
+		} else {
+			// A due date is a point in time, it must not block the calendar
+			writeICalLine(&b, "DTSTART:"+formatICalTime(task.DueDate))
+			writeICalLine(&b, "TRANSP:TRANSPARENT")
+			writeICalLine(&b, "STATUS:CONFIRMED")
+		}
+		writeICalLine(&b, "SUMMARY:"+icalTextEscaper.Replace(task.Title))
+		writeICalLine(&b, "DESCRIPTION:"+icalTextEscaper.Replace(description))
+		writeICalLine(&b, "CATEGORIES:"+icalTextEscaper.Replace(string(task.Status))+","+icalTextEscaper.Replace(string(task.Priority)))
+		writeICalLine(&b, fmt.Sprintf("PRIORITY:%d", icalPriority(task.Priority)))
+		writeICalLine(&b, "URL:"+calendarTaskURL(baseURL, task.ProjectID, task.ID))
+		writeICalLine(&b, "END:"+kind)
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
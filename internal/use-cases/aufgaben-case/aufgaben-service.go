@@ -1,12 +1,17 @@
 package aufgaben_case
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"io"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/cache"
+	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/storage"
 	"github.com/Xenn-00/aufgaben-meister/internal/abstraction/tx"
 	"github.com/Xenn-00/aufgaben-meister/internal/dtos"
 	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
@@ -27,14 +32,16 @@ type AufgabenService struct {
 	txManager tx.TxManager
 	repo      aufgaben_repo.AufgabenRepoContract
 	taskQueue queue.TaskQueueClient
+	storage   storage.BlobStorage
 }
 
-func NewAufgabenService(db *pgxpool.Pool, redis *redis.Client) AufgabenServiceContract {
+func NewAufgabenService(db *pgxpool.Pool, redis *redis.Client, blobStorage storage.BlobStorage) AufgabenServiceContract {
 	return &AufgabenService{
 		cache:     cache.NewRedisCache(redis),
 		txManager: tx.NewPgxTxManager(db),
 		repo:      aufgaben_repo.NewAufgabenRepo(db),
 		taskQueue: queue.NewTaskQueue(redis),
+		storage:   blobStorage,
 	}
 }
 
@@ -45,6 +52,11 @@ func (s *AufgabenService) CreateNewAufgaben(ctx context.Context, userID, project
 		return nil, err
 	}
 
+	return s.insertNewAufgabe(ctx, userID, projectID, req)
+}
+
+// insertNewAufgabe builds and stores a top level task, the creator has to be verified as project member already
+func (s *AufgabenService) insertNewAufgabe(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateNewAufgabenRequest) (*aufgaben_dto.CreateNewAufgabenResponse, *app_errors.AppError) {
 	// Check request validity
 	var assigneeID *string
 	if req.AssigneeID != nil {
@@ -76,6 +88,7 @@ func (s *AufgabenService) CreateNewAufgaben(ctx context.Context, userID, project
 		DueDate:     req.DueDate,
 		CreatedAt:   time.Now(),
 	}
+	task.Estimate, task.EstimateUnit = buildEstimate(req.Estimate, req.EstimateUnit)
 
 	// Insert task
 
@@ -85,15 +98,17 @@ func (s *AufgabenService) CreateNewAufgaben(ctx context.Context, userID, project
 
 	// Build response
 	resp := &aufgaben_dto.CreateNewAufgabenResponse{
-		AufgabenID:  task.ID,
-		ProjectID:   projectID,
-		Title:       task.Title,
-		Description: task.Description,
-		Status:      string(task.Status),
-		Priority:    string(task.Priority),
-		AssigneeID:  task.AssigneeID,
-		CreateAt:    task.CreatedAt,
-		DueDate:     task.DueDate,
+		AufgabenID:   task.ID,
+		ProjectID:    projectID,
+		Title:        task.Title,
+		Description:  task.Description,
+		Status:       string(task.Status),
+		Priority:     string(task.Priority),
+		AssigneeID:   task.AssigneeID,
+		CreateAt:     task.CreatedAt,
+		DueDate:      task.DueDate,
+		Estimate:     task.Estimate,
+		EstimateUnit: estimateUnitString(task.EstimateUnit),
 	}
 
 	return resp, nil
@@ -113,38 +128,88 @@ func (s *AufgabenService) ListTasksProject(ctx context.Context, userID, projectI
 		}
 	}
 
+	if filter.AssigneeID != nil && filter.Unassigned != nil && *filter.Unassigned {
+		return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("Assignee and unassigned filter exclude each other"))
+	}
+
+	if filter.DueAfter != nil && filter.DueBefore != nil && *filter.DueBefore < *filter.DueAfter {
+		return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_date_range", nil)
+	}
+
+	sortKeys, err := normalizeTaskSort(filter.Sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter.Sort = sortKeys
+
+	// Label names are matched case-insensitively
+	filter.Labels = normalizeLabelNames(filter.Labels)
+
+	// The cursor has to be a task of this project, an unknown one would silently end the list
+	if filter.Cursor != nil {
+		cursorTask, err := s.repo.GetTaskByID(ctx, *filter.Cursor)
+		if err != nil {
+			if err.Code == fiber.StatusNotFound {
+				return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("Cursor is not a task of this project"))
+			}
+			return nil, nil, err
+		}
+		if cursorTask.ProjectID != projectID {
+			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", fmt.Errorf("Cursor is not a task of this project"))
+		}
+	}
+
 	// Call repo
 	tasks, err := s.repo.ListTasks(ctx, projectID, &filter)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	totalTasks, err := s.repo.CountTasks(ctx, projectID)
+	totalTasks, err := s.repo.CountTasks(ctx, projectID, &filter)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Repo reads one task more than the limit, it only tells if there is a next page
+	var nextCursor *string
+	if len(tasks) > filter.Limit {
+		tasks = tasks[:filter.Limit]
+		nextCursor = &tasks[len(tasks)-1].ID
+	}
+
 	// Build resp
+	now := time.Now()
 	var responses []*aufgaben_dto.AufgabenItem
 	for _, task := range tasks {
+		isOverdue, overdueSince := overdueState(task.DueDate, task.Status, now)
 		responses = append(responses, &aufgaben_dto.AufgabenItem{
-			AufgabenID:  task.ID,
-			Title:       task.Title,
-			Description: task.Description,
-			Status:      string(task.Status),
-			Priority:    string(task.Priority),
-			AssigneeID:  task.AssigneeID,
-			DueDate:     task.DueDate,
+			AufgabenID:   task.ID,
+			ParentID:     task.ParentID,
+			Title:        task.Title,
+			Description:  task.Description,
+			Status:       string(task.Status),
+			Priority:     string(task.Priority),
+			AssigneeID:   task.AssigneeID,
+			DueDate:      task.DueDate,
+			Estimate:     task.Estimate,
+			EstimateUnit: estimateUnitString(task.EstimateUnit),
+			RecurrenceID: task.RecurrenceID,
+			IsOverdue:    isOverdue,
+			OverdueSince: overdueSince,
 		})
 	}
 
 	totalPages := int(math.Ceil(float64(totalTasks) / float64(filter.Limit)))
 
 	paginationMeta := &dtos.PaginationMeta{
-		Page:       filter.Page,
 		Limit:      filter.Limit,
 		Total:      int(totalTasks),
 		TotalPages: totalPages,
+		NextCursor: nextCursor,
+	}
+	// Keyset pages have no page number
+	if filter.Cursor == nil {
+		paginationMeta.Page = filter.Page
 	}
 
 	return responses, paginationMeta, nil
@@ -169,11 +234,31 @@ func (s *AufgabenService) AssignTask(ctx context.Context, userID, projectID, tas
 	if !req.DueDate.After(time.Now().Add(1 * time.Hour)) {
 		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Due date must be in the future"))
 	}
+	// Check the status change against the project's workflow
+	target, err := s.verifyCategoryTransition(ctx, projectID, userID, task, entity.AufgabenInProgress)
+	if err != nil {
+		return nil, err
+	}
 
 	// Prepare transaction to update task
 	tx, txErr := s.txManager.Begin(ctx)
 	if txErr != nil {
-		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Check if task is still blocked by open dependencies, the task row stays locked until commit
+	if err := s.repo.LockTask(ctx, tx, taskID); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskUnblocked(ctx, tx, projectID, taskID); err != nil {
+		return nil, err
+	}
+
+	// Check the target column still has room
+	if err := s.verifyWIPLimit(ctx, tx, projectID, taskID, target); err != nil {
+		return nil, err
 	}
 
 	assigned, err := s.repo.AssignTask(ctx, tx, projectID, taskID, userID, &req.DueDate)
@@ -181,6 +266,10 @@ func (s *AufgabenService) AssignTask(ctx context.Context, userID, projectID, tas
 		return nil, err
 	}
 
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
+
 	note := fmt.Sprintf("First assignment by: %s", assigned.AssigneeID)
 	assignEvent := &entity.AddAssignment{
 		AufgabenID: assigned.ID,
@@ -197,6 +286,9 @@ func (s *AufgabenService) AssignTask(ctx context.Context, userID, projectID, tas
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
 
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeAssigned, assigned.AssigneeID)
+
 	resp := &aufgaben_dto.AufgabenAssignResponse{
 		AufgabenID: assigned.ID,
 		ProjectID:  projectID,
@@ -223,6 +315,8 @@ func (s *AufgabenService) GetAufgabeDetails(ctx context.Context, userID, project
 	if cacheData != nil && cacheErr == nil {
 		// cache.Get returns *any, so dereference and type assert to the expected type
 		if cached, ok := (*cacheData).(*aufgaben_dto.AufgabenItem); ok && cached != nil {
+			// Overdue is a matter of time, it is never served from the cache
+			cached.IsOverdue, cached.OverdueSince = overdueState(cached.DueDate, entity.AufgabenStatus(cached.Status), time.Now())
 			return cached, nil
 		}
 		// If cached data has unexpected type, log and continue to fetch from DB
@@ -237,16 +331,43 @@ func (s *AufgabenService) GetAufgabeDetails(ctx context.Context, userID, project
 		return nil, err
 	}
 
+	// Roll up progress from subtasks
+	progress, err := s.repo.GetSubtaskProgress(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.repo.ListLabelsForTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build resp
 	resp := &aufgaben_dto.AufgabenItem{
-		AufgabenID:  task.ID,
-		Title:       task.Title,
-		Description: task.Description,
-		Status:      string(task.Status),
-		Priority:    string(task.Priority),
-		AssigneeID:  task.AssigneeID,
-		DueDate:     task.DueDate,
+		AufgabenID:     task.ID,
+		ParentID:       task.ParentID,
+		Title:          task.Title,
+		Description:    task.Description,
+		Status:         string(task.Status),
+		Priority:       string(task.Priority),
+		AssigneeID:     task.AssigneeID,
+		DueDate:        task.DueDate,
+		WorkflowStatus: task.WorkflowStatus,
+		Estimate:       task.Estimate,
+		EstimateUnit:   estimateUnitString(task.EstimateUnit),
+		RecurrenceID:   task.RecurrenceID,
+		MilestoneID:    task.MilestoneID,
+
+		EscalationLevel:      task.EscalationLevel,
+		FlaggedForUnassignAt: task.FlaggedForUnassignAt,
+	}
+	if progress.Total > 0 {
+		resp.Subtasks = buildSubtaskProgress(progress)
 	}
+	if len(labels) > 0 {
+		resp.Labels = buildLabelItems(labels)
+	}
+	resp.IsOverdue, resp.OverdueSince = overdueState(task.DueDate, task.Status, time.Now())
 
 	// cache task details in redis
 	if err := s.cache.Set(ctx, cacheKey, resp, 5*time.Minute); err != nil {
@@ -270,15 +391,40 @@ func (s *AufgabenService) ForwardProgressTask(ctx context.Context, userID, proje
 		return nil, err
 	}
 
-	// Check authorithy
-	if err := s.verifyTaskAssignee(task, userID); err != nil {
+	// Check authorithy, every participant of the task may complete it
+	if err := s.verifyTaskAssignee(ctx, task, userID); err != nil {
+		return nil, err
+	}
+
+	// Parent can't be completed while its subtasks are still open
+	progress, err := s.repo.GetSubtaskProgress(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if progress.Done < progress.Total {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.subtasks_still_open", fmt.Errorf("%d of %d subtasks are still open", progress.Total-progress.Done, progress.Total))
+	}
+
+	// Check the status change against the project's workflow
+	target, err := s.verifyCategoryTransition(ctx, projectID, userID, task, entity.AufgabenDone)
+	if err != nil {
 		return nil, err
 	}
 
 	// Prepare transaction to update task
 	tx, txErr := s.txManager.Begin(ctx)
 	if txErr != nil {
-		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Check if task is still blocked by open dependencies, the task row stays locked until commit
+	if err := s.repo.LockTask(ctx, tx, taskID); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskUnblocked(ctx, tx, projectID, taskID); err != nil {
+		return nil, err
 	}
 
 	forward, err := s.repo.ForwardProgress(ctx, tx, taskID)
@@ -286,10 +432,14 @@ func (s *AufgabenService) ForwardProgressTask(ctx context.Context, userID, proje
 		return nil, err
 	}
 
-	note := fmt.Sprintf("Assignment done by: %s, at: %s", forward.AssigneeID, time.Now().Local())
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Assignment done by: %s, at: %s", userID, time.Now().Local())
 	completeEvent := &entity.AddAssignment{
 		AufgabenID: forward.ID,
-		ActorID:    forward.AssigneeID,
+		ActorID:    userID,
 		Action:     entity.ActionComplete,
 		Note:       &note,
 	}
@@ -298,10 +448,41 @@ func (s *AufgabenService) ForwardProgressTask(ctx context.Context, userID, proje
 		return nil, err
 	}
 
+	// Completing a subtask is also part of the parent's trail
+	if task.ParentID != nil {
+		subtaskNote := fmt.Sprintf("Subtask completed: %s (%s)", task.Title, task.ID)
+		subtaskEvent := &entity.AddAssignment{
+			AufgabenID: *task.ParentID,
+			ActorID:    userID,
+			Action:     entity.ActionSubtaskComplete,
+			Note:       &subtaskNote,
+		}
+
+		if _, err := s.createAndInsertEvent(ctx, tx, subtaskEvent); err != nil {
+			return nil, err
+		}
+	}
+
+	// Collect dependents that are no longer blocked after this completion
+	unblocked, err := s.repo.ListUnblockedDependents(ctx, tx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
 
+	if task.ParentID != nil {
+		s.invalidateTaskDetails(ctx, *task.ParentID)
+	}
+
+	// Enqueue this task so that assignees of unblocked tasks can be notified
+	s.notifyUnblockedDependents(task, unblocked)
+
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeCompleted, "")
+
 	resp := &aufgaben_dto.AufgabenForwardProgressResponse{
 		AufgabenID:  forward.ID,
 		Status:      string(forward.Status),
@@ -324,8 +505,14 @@ func (s *AufgabenService) UnassignTask(ctx context.Context, userID, projectID, t
 	if err := s.validateTaskAvailability(task); err != nil {
 		return nil, err
 	}
-	// Check authorithy
-	if err := s.verifyTaskAssignee(task, userID); err != nil {
+	// Check authorithy, only the owner can give the task back. Collaborators and reviewers leave through RemoveTaskAssignee
+	if err := s.verifyTaskOwner(task, userID); err != nil {
+		return nil, err
+	}
+
+	// Check the status change against the project's workflow
+	target, err := s.verifyCategoryTransition(ctx, projectID, userID, task, entity.AufgabenTodo)
+	if err != nil {
 		return nil, err
 	}
 
@@ -351,6 +538,10 @@ func (s *AufgabenService) UnassignTask(ctx context.Context, userID, projectID, t
 		return nil, err
 	}
 
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
+
 	unassignEvent := &entity.AddAssignment{
 		AufgabenID:       taskID,
 		ActorID:          userID,
@@ -401,9 +592,16 @@ func (s *AufgabenService) ForceUnassignTask(ctx context.Context, userID, project
 	}
 
 	// Check target validity
-	if err := s.verifyTaskAssignee(task, req.TargetID); err != nil {
+	if err := s.verifyTaskOwner(task, req.TargetID); err != nil {
 		return nil, err
 	}
+
+	// Check the status change against the project's workflow
+	target, err := s.verifyCategoryTransition(ctx, projectID, userID, task, entity.AufgabenTodo)
+	if err != nil {
+		return nil, err
+	}
+
 	// Rollback assignment status
 	tx, txErr := s.txManager.Begin(ctx)
 	if txErr != nil {
@@ -426,6 +624,10 @@ func (s *AufgabenService) ForceUnassignTask(ctx context.Context, userID, project
 		return nil, err
 	}
 
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
+
 	unassignEvent := &entity.AddAssignment{
 		AufgabenID:       taskID,
 		ActorID:          userID,
@@ -521,6 +723,10 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 		if err := tx.Commit(ctx); err != nil {
 			return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 		}
+
+		// Enqueue this task so that watchers can be notified
+		s.notifyWatchers(task, userID, worker_task.WatcherChangeAssigned, req.TargetID)
+
 		resp = &aufgaben_dto.ReassignAufgabenResponse{
 			AufgabenID:    newAufgaben.ID,
 			Status:        string(newAufgaben.Status),
@@ -531,14 +737,9 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 		}
 
 	case entity.MITARBEITER:
-		// Check authorithy
-		if task.AssigneeID == nil || *task.AssigneeID != userID {
-			return nil, app_errors.NewAppError(
-				fiber.StatusForbidden,
-				app_errors.ErrForbidden,
-				"forbidden.not_task_assignee",
-				nil,
-			)
+		// Check authorithy, only the owner can hand the task over
+		if err := s.verifyTaskOwner(task, userID); err != nil {
+			return nil, err
 		}
 		// Insert assignment event
 		note := fmt.Sprintf("Request handover this task to: %s. ", req.TargetID)
@@ -546,6 +747,32 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 			note = req.Note
 		}
 
+		// Record the request so that the Meister can decide on it before it expires
+		requestID, idErr := uuid.NewV7()
+		if idErr != nil {
+			return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+		}
+
+		requestedAt := time.Now()
+		handoverRequest := &entity.HandoverRequestEntity{
+			ID:               requestID.String(),
+			AufgabenID:       taskID,
+			ProjectID:        projectID,
+			RequestedBy:      userID,
+			TargetAssigneeID: req.TargetID,
+			Note:             note,
+			Status:           entity.HandoverPending,
+			ExpiresAt:        requestedAt.Add(handoverRequestTTL),
+			CreatedAt:        requestedAt,
+		}
+
+		if err := s.repo.InsertHandoverRequest(ctx, tx, handoverRequest); err != nil {
+			if err.Type == app_errors.ErrConflict {
+				return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.handover_request_pending", nil)
+			}
+			return nil, err
+		}
+
 		reAssignmentEvent := &entity.AddAssignment{
 			AufgabenID:       taskID,
 			ActorID:          userID,
@@ -564,6 +791,7 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 
 		// Enqueue this task so that meister can be reminded
 		payloadTask := &worker_task.HandoverRequestNotifyMeister{
+			RequestID:        handoverRequest.ID,
 			AufgabeID:        taskID,
 			ProjectName:      *task.ProjectName,
 			ProjectID:        projectID,
@@ -571,8 +799,9 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 			AufgabeStatus:    string(task.Status),
 			AssigneeID:       userID,
 			TargetAssigneeID: req.TargetID,
-			RequestedAt:      time.Now(),
+			RequestedAt:      requestedAt,
 			DueDate:          *task.DueDate,
+			ExpiresAt:        handoverRequest.ExpiresAt,
 			Note:             note,
 		}
 		if err := s.taskQueue.EnqueueHandoverRequestNotifyMeister(payloadTask); err != nil {
@@ -584,6 +813,7 @@ func (s *AufgabenService) ReassignTask(ctx context.Context, userID, projectID, t
 			Note:       *reAssignmentEvent.Note,
 			Status:     string(task.Status),
 			Action:     string(reAssignmentEvent.Action),
+			RequestID:  &handoverRequest.ID,
 		}
 	}
 
@@ -616,6 +846,8 @@ func (s *AufgabenService) ListAssignedTasks(ctx context.Context, userID string,
 			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", nil)
 		}
 	}
+	// 4. normalize label names, they are matched case-insensitively
+	filter.Labels = normalizeLabelNames(filter.Labels)
 	// Call repo
 	tasks, err := s.repo.ListAssignedTasks(ctx, userID, filter)
 	if err != nil {
@@ -634,8 +866,10 @@ func (s *AufgabenService) ListAssignedTasks(ctx context.Context, userID string,
 		nextCursor = &tasks[len(tasks)-1].ID
 	}
 
+	now := time.Now()
 	var data []*aufgaben_dto.AssignedAufgabenListItem
 	for _, task := range tasks {
+		isOverdue, overdueSince := overdueState(&task.DueDate, task.Status, now)
 		data = append(data, &aufgaben_dto.AssignedAufgabenListItem{
 			AufgabenID:  task.ID,
 			ProjectName: task.ProjectName,
@@ -644,13 +878,20 @@ func (s *AufgabenService) ListAssignedTasks(ctx context.Context, userID string,
 			Status:      string(task.Status),
 			Priority:    string(task.Priority),
 			DueDate:     task.DueDate,
+			Role:        string(task.Role),
+
+			IsOverdue:    isOverdue,
+			OverdueSince: overdueSince,
 		})
 	}
 
+	// Keep next_cursor out of the response on the last page
 	cursor := &dtos.CursorPaginationMeta{
-		Limit:      filter.Limit,
-		NextCursor: *nextCursor,
-		HasMore:    hasMore,
+		Limit:   filter.Limit,
+		HasMore: hasMore,
+	}
+	if nextCursor != nil {
+		cursor.NextCursor = *nextCursor
 	}
 
 	return data, cursor, nil
@@ -705,177 +946,166 @@ func (s *AufgabenService) ArchiveTask(ctx context.Context, userID, projectID, ta
 		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", nil)
 	}
 
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeArchived, "")
+
 	return nil
 }
 
-func (s *AufgabenService) UpdateDueDate(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateDueDateRequest) (*aufgaben_dto.UpdateDueDateResponse, *app_errors.AppError) {
+func (s *AufgabenService) UnarchiveTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.RestoreAufgabenRequest) (*aufgaben_dto.RestoreAufgabenResponse, *app_errors.AppError) {
 	// TODO
 	// Check if performer is really project member or not
-	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.GetTaskByID(ctx, taskID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if task is still relevant
-	if err := s.validateTaskAvailability(task); err != nil {
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
 		return nil, err
 	}
 
-	// Update due date
+	// Only Meister can restore a task
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Task has to be archived
+	if task.ArchivedAt == nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_not_archived", nil)
+	}
+
 	tx, txErr := s.txManager.Begin(ctx)
 	if txErr != nil {
-		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
 	}
 	defer tx.Rollback(ctx)
 
-	updatedDueDate, err := s.repo.UpdateDueDate(ctx, tx, taskID, req.DueDate)
+	// A restored task may block others again, blocker checks of the project wait for this tx
+	if err := s.repo.LockProjectDependencies(ctx, tx, projectID); err != nil {
+		return nil, err
+	}
+
+	status, err := s.repo.UnarchiveTask(ctx, tx, taskID)
 	if err != nil {
 		return nil, err
 	}
 
-	note := fmt.Sprintf("Task due date updated to: %s", req.DueDate)
-	updateEvent := &entity.AddAssignment{
+	note := fmt.Sprintf("Unarchive task by: %s", userID)
+	if req.Note != nil {
+		note = *req.Note
+	}
+	restoreEvent := &entity.AddAssignment{
 		AufgabenID: taskID,
 		ActorID:    userID,
-		Action:     entity.ActionDueDateUpdate,
+		Action:     entity.ActionTaskUnarchived,
 		Note:       &note,
+		ReasonCode: entity.ReasonCodeEvent(req.ReasonCode),
+		ReasonText: &req.Reason,
 	}
 
-	if _, err := s.createAndInsertEvent(ctx, tx, updateEvent); err != nil {
+	if _, err := s.createAndInsertEvent(ctx, tx, restoreEvent); err != nil {
 		return nil, err
 	}
 
-	// Commit
 	if err := tx.Commit(ctx); err != nil {
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
 
+	s.invalidateTaskDetails(ctx, taskID)
+
 	// Build response
-	resp := &aufgaben_dto.UpdateDueDateResponse{
+	resp := &aufgaben_dto.RestoreAufgabenResponse{
 		AufgabenID: taskID,
-		DueDate:    *updatedDueDate,
+		Status:     string(status),
+		Action:     string(entity.ActionTaskUnarchived),
+		Reason:     req.Reason,
 	}
 
 	return resp, nil
 }
 
-func (s *AufgabenService) FetchEventsForTask(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]*aufgaben_dto.AufgabenEventItem, *dtos.CursorPaginationMeta, *app_errors.AppError) {
+func (s *AufgabenService) ReopenTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.RestoreAufgabenRequest) (*aufgaben_dto.RestoreAufgabenResponse, *app_errors.AppError) {
 	// TODO
 	// Check if performer is really project member or not
 	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
-		return nil, nil, err
-	}
-
-	// Check if task exists
-	if _, err := s.repo.GetTaskByID(ctx, taskID); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// We want to allow fetching events even the task is archived or done
-	// We need to verify filters first
-	if filters.Limit == 0 {
-		filters.Limit = 20
-	} else if filters.Limit > 100 {
-		filters.Limit = 100
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
 	}
 
-	if filters.Cursor != nil {
-		_, err := uuid.Parse(*filters.Cursor)
-		if err != nil {
-			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", nil)
-		}
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
 	}
 
-	// Call repo
-	events, err := s.repo.ListEventsForTask(ctx, taskID, filters)
-	if err != nil {
-		return nil, nil, err
+	// Only Meister can restore a task
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
 	}
 
-	// Build response cursor
-	hasMore := false
-	if len(events) > filters.Limit {
-		hasMore = true
-		events = events[:filters.Limit]
+	// Task has to be done, archived task must be unarchived first
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
 	}
-
-	var nextCursor *string
-	if hasMore {
-		nextCursor = &events[len(events)-1].ID
+	if task.Status != entity.AufgabenDone {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_not_done", nil)
 	}
 
-	var data []*aufgaben_dto.AufgabenEventItem
-	for _, event := range events {
-		data = append(data, &aufgaben_dto.AufgabenEventItem{
-			EventID:     event.ID,
-			AufgabeID:   event.AufgabenID,
-			ActorID:     event.ActorID,
-			EventAction: string(event.Action),
-			Note:        event.Note,
-			TargetID:    event.TargetAssigneeID,
-			ReasonCode:  (*string)(event.ReasonCode),
-			ReasonText:  event.ReasonText,
-			EventTime:   event.CreatedAt,
-		})
+	// Reopened task goes back to work if it still has an assignee
+	reopenCategory := entity.AufgabenTodo
+	if task.AssigneeID != nil {
+		reopenCategory = entity.AufgabenInProgress
 	}
 
-	return data, &dtos.CursorPaginationMeta{
-		Limit:      filters.Limit,
-		NextCursor: *nextCursor,
-		HasMore:    hasMore,
-	}, nil
-}
-
-func (s *AufgabenService) ForceAufgabeHandover(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.ForceAufgabeHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError) {
-	// TODO
-	// Check if creator is really project member or not, doesn't care about user role
-	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	target, err := s.verifyCategoryTransition(ctx, projectID, userID, task, reopenCategory)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the performer has valid authority
-	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
-		return nil, err
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
 	}
+	defer tx.Rollback(ctx)
 
-	// Check if task is still relevant
-	if err := s.validateTaskAvailability(task); err != nil {
+	// A reopened task blocks its dependents again, blocker checks of the project wait for this tx
+	if err := s.repo.LockProjectDependencies(ctx, tx, projectID); err != nil {
 		return nil, err
 	}
 
-	// Check target validity, target should be different from current assignee
-	if *task.AssigneeID == req.TargetID {
-		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.target_invalid", fmt.Errorf("Target and current assignee can't be the same person"))
-	}
-
-	// Handover assignment
-	tx, txErr := s.txManager.Begin(ctx)
-	if txErr != nil {
-		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	if err := s.verifyWIPLimit(ctx, tx, projectID, taskID, target); err != nil {
+		return nil, err
 	}
-	defer tx.Rollback(ctx)
 
-	newAufgabe, err := s.repo.AssignTask(ctx, tx, projectID, taskID, req.TargetID, task.DueDate)
+	status, err := s.repo.ReopenTask(ctx, tx, taskID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Insert handover event
-
-	note := fmt.Sprintf("Force handover assignment from %s to %s, note: %s", *task.AssigneeID, req.TargetID, req.Note)
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
 
-	handoverEvent := &entity.AddAssignment{
-		AufgabenID:       taskID,
-		ActorID:          userID,
-		TargetAssigneeID: &req.TargetID,
-		Action:           entity.ActionHandoverExecute,
-		Note:             &note,
-		ReasonText:       &req.Reason,
-		ReasonCode:       entity.ReasonCodeEvent(req.ReasonCode),
+	note := fmt.Sprintf("Reopen task by: %s", userID)
+	if req.Note != nil {
+		note = *req.Note
+	}
+	restoreEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionTaskReopened,
+		Note:       &note,
+		ReasonCode: entity.ReasonCodeEvent(req.ReasonCode),
+		ReasonText: &req.Reason,
 	}
 
-	if _, err := s.createAndInsertEvent(ctx, tx, handoverEvent); err != nil {
+	if _, err := s.createAndInsertEvent(ctx, tx, restoreEvent); err != nil {
 		return nil, err
 	}
 
@@ -883,14 +1113,3970 @@ func (s *AufgabenService) ForceAufgabeHandover(ctx context.Context, userID, proj
 		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
 	}
 
-	resp := &aufgaben_dto.ReassignAufgabenResponse{
-		AufgabenID:    newAufgabe.ID,
-		Status:        string(newAufgabe.Status),
-		NewAssigneeID: &newAufgabe.AssigneeID,
-		Note:          *handoverEvent.Note,
-		Action:        string(handoverEvent.Action),
-		Reason:        handoverEvent.ReasonText,
-	}
+	s.invalidateTaskDetails(ctx, taskID)
+
+	// Build response
+	resp := &aufgaben_dto.RestoreAufgabenResponse{
+		AufgabenID: taskID,
+		Status:     string(status),
+		Action:     string(entity.ActionTaskReopened),
+		Reason:     req.Reason,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) UpdateDueDate(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateDueDateRequest) (*aufgaben_dto.UpdateDueDateResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if task is still relevant
+	if err := s.validateTaskAvailability(task); err != nil {
+		return nil, err
+	}
+
+	// Update due date
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updatedDueDate, err := s.repo.UpdateDueDate(ctx, tx, taskID, req.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Task due date updated to: %s", req.DueDate)
+	fieldName := "due_date"
+	newValue := updatedDueDate.Format(time.RFC3339)
+	updateEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionDueDateUpdate,
+		Note:       &note,
+		FieldName:  &fieldName,
+		OldValue:   formatOptionalTime(task.DueDate),
+		NewValue:   &newValue,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, updateEvent); err != nil {
+		return nil, err
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeDueDateChanged, newValue)
+
+	// Build response
+	resp := &aufgaben_dto.UpdateDueDateResponse{
+		AufgabenID: taskID,
+		DueDate:    *updatedDueDate,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) UpdateEstimate(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateEstimateRequest) (*aufgaben_dto.UpdateEstimateResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, done tasks may still be re-estimated so velocity stays correct
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	estimate, unit := buildEstimate(req.Estimate, req.EstimateUnit)
+	oldValue := formatEstimate(task.Estimate, task.EstimateUnit)
+	newValue := formatEstimate(estimate, unit)
+	if equalOptionalString(oldValue, newValue) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrValidation, "request.no_changes", nil)
+	}
+
+	// Update estimate
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	updatedAt, err := s.repo.UpdateEstimate(ctx, tx, taskID, estimate, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	note := "Task estimate updated"
+	fieldName := "estimate"
+	updateEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionTaskUpdated,
+		Note:       &note,
+		FieldName:  &fieldName,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, updateEvent); err != nil {
+		return nil, err
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	// Build response
+	resp := &aufgaben_dto.UpdateEstimateResponse{
+		AufgabenID:   taskID,
+		Estimate:     estimate,
+		EstimateUnit: estimateUnitString(unit),
+		UpdatedAt:    *updatedAt,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) UpdateTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.UpdateAufgabenRequest) (*aufgaben_dto.UpdateAufgabenResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not, task has to be still relevant
+	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Collect changed fields, unchanged values don't produce events
+	title, description, priority, changes := diffTaskContent(task, req)
+	if len(changes) == 0 {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrValidation, "request.no_changes", nil)
+	}
+
+	// Update task content
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	updatedAt, err := s.repo.UpdateTaskContent(ctx, tx, taskID, title, description, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	// One event per changed field, so the audit trail keeps old and new value
+	for _, change := range changes {
+		note := fmt.Sprintf("Task %s updated", change.Field)
+		updateEvent := &entity.AddAssignment{
+			AufgabenID: taskID,
+			ActorID:    userID,
+			Action:     entity.ActionTaskUpdated,
+			Note:       &note,
+			FieldName:  &change.Field,
+			OldValue:   change.OldValue,
+			NewValue:   change.NewValue,
+		}
+
+		if _, err := s.createAndInsertEvent(ctx, tx, updateEvent); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	// Build response
+	resp := &aufgaben_dto.UpdateAufgabenResponse{
+		AufgabenID:  taskID,
+		Title:       title,
+		Description: description,
+		Priority:    string(priority),
+		Changes:     changes,
+		UpdatedAt:   *updatedAt,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) FetchEventsForTask(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]*aufgaben_dto.AufgabenEventItem, *dtos.CursorPaginationMeta, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if task exists
+	if _, err := s.repo.GetTaskByID(ctx, taskID); err != nil {
+		return nil, nil, err
+	}
+
+	// We want to allow fetching events even the task is archived or done
+	// We need to verify filters first
+	if filters.Limit == 0 {
+		filters.Limit = 20
+	} else if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	if filters.Cursor != nil {
+		_, err := uuid.Parse(*filters.Cursor)
+		if err != nil {
+			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", nil)
+		}
+	}
+
+	// Call repo
+	events, err := s.repo.ListEventsForTask(ctx, taskID, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build response cursor
+	hasMore := false
+	if len(events) > filters.Limit {
+		hasMore = true
+		events = events[:filters.Limit]
+	}
+
+	var nextCursor *string
+	if hasMore {
+		nextCursor = &events[len(events)-1].ID
+	}
+
+	var data []*aufgaben_dto.AufgabenEventItem
+	for _, event := range events {
+		data = append(data, &aufgaben_dto.AufgabenEventItem{
+			EventID:     event.ID,
+			AufgabeID:   event.AufgabenID,
+			ActorID:     event.ActorID,
+			EventAction: string(event.Action),
+			Note:        event.Note,
+			TargetID:    event.TargetAssigneeID,
+			ReasonCode:  (*string)(event.ReasonCode),
+			ReasonText:  event.ReasonText,
+			Field:       event.FieldName,
+			OldValue:    event.OldValue,
+			NewValue:    event.NewValue,
+			EventTime:   event.CreatedAt,
+		})
+	}
+
+	meta := &dtos.CursorPaginationMeta{
+		Limit:   filters.Limit,
+		HasMore: hasMore,
+	}
+	if nextCursor != nil {
+		meta.NextCursor = *nextCursor
+	}
+
+	return data, meta, nil
+}
+
+func (s *AufgabenService) ForceAufgabeHandover(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.ForceAufgabeHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError) {
+	// TODO
+	// Check if creator is really project member or not, doesn't care about user role
+	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if task is still relevant
+	if err := s.validateTaskAvailability(task); err != nil {
+		return nil, err
+	}
+
+	// Check target validity, target should be different from current assignee
+	if err := verifyHandoverTarget(task, req.TargetID); err != nil {
+		return nil, err
+	}
+
+	// Check target load before the task is added to it, an overloaded target only gets a warning
+	warning := s.checkTargetWorkload(ctx, projectID, req.TargetID)
+
+	// Handover assignment
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	resp, err := s.executeHandover(ctx, tx, userID, projectID, task, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeAssigned, req.TargetID)
+
+	resp.WorkloadWarning = warning
+
+	return resp, nil
+}
+
+func (s *AufgabenService) CreateSubtask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateNewAufgabenRequest) (*aufgaben_dto.CreateNewAufgabenResponse, *app_errors.AppError) {
+	// TODO
+	// Check if creator is really project member or not and returning parent task, doesn't care about user role
+	parent, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if parent really belongs to this project
+	if err := s.verifyTaskInProject(parent, projectID); err != nil {
+		return nil, err
+	}
+
+	// Check request validity
+	var assigneeID *string
+	if req.AssigneeID != nil {
+		if err := s.verifyProjectMember(ctx, projectID, *req.AssigneeID); err != nil {
+			return nil, err
+		}
+		assigneeID = req.AssigneeID
+	}
+
+	priority := parent.Priority
+	if req.Priority != nil {
+		priority = entity.AufgabenPriority(*req.Priority)
+	}
+
+	// Build subtask
+	aufgabenID, idErr := uuid.NewV7()
+	if idErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+	}
+	subtask := &entity.AufgabenEntity{
+		ID:          aufgabenID.String(),
+		ProjectID:   projectID,
+		ParentID:    &parent.ID,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      entity.AufgabenTodo,
+		Priority:    priority,
+		AssigneeID:  assigneeID,
+		CreatedBy:   userID,
+		DueDate:     req.DueDate,
+		CreatedAt:   time.Now(),
+	}
+	subtask.Estimate, subtask.EstimateUnit = buildEstimate(req.Estimate, req.EstimateUnit)
+
+	// Insert subtask together with the parent event
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.InsertNewAufgabenWithTx(ctx, tx, subtask); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Subtask created: %s (%s)", subtask.Title, subtask.ID)
+	subtaskEvent := &entity.AddAssignment{
+		AufgabenID:       parent.ID,
+		ActorID:          userID,
+		TargetAssigneeID: assigneeID,
+		Action:           entity.ActionSubtaskCreated,
+		Note:             &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, subtaskEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Parent rollup has changed
+	s.invalidateTaskDetails(ctx, parent.ID)
+
+	// Build response
+	resp := &aufgaben_dto.CreateNewAufgabenResponse{
+		AufgabenID:   subtask.ID,
+		ProjectID:    projectID,
+		ParentID:     subtask.ParentID,
+		Title:        subtask.Title,
+		Description:  subtask.Description,
+		Status:       string(subtask.Status),
+		Priority:     string(subtask.Priority),
+		AssigneeID:   subtask.AssigneeID,
+		CreateAt:     subtask.CreatedAt,
+		DueDate:      subtask.DueDate,
+		Estimate:     subtask.Estimate,
+		EstimateUnit: estimateUnitString(subtask.EstimateUnit),
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) ListSubtasks(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.SubtaskListResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if parent exists, listing is allowed even the parent is archived or done
+	parent, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(parent, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	subtasks, err := s.repo.ListSubtasks(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build response, progress is rolled up from the listed children
+	progress := &entity.SubtaskProgress{Total: len(subtasks)}
+	items := make([]*aufgaben_dto.AufgabenItem, 0, len(subtasks))
+	now := time.Now()
+	for _, subtask := range subtasks {
+		if subtask.Status == entity.AufgabenDone {
+			progress.Done++
+		}
+		isOverdue, overdueSince := overdueState(subtask.DueDate, subtask.Status, now)
+		items = append(items, &aufgaben_dto.AufgabenItem{
+			AufgabenID:   subtask.ID,
+			ParentID:     subtask.ParentID,
+			Title:        subtask.Title,
+			Description:  subtask.Description,
+			Status:       string(subtask.Status),
+			Priority:     string(subtask.Priority),
+			AssigneeID:   subtask.AssigneeID,
+			DueDate:      subtask.DueDate,
+			Estimate:     subtask.Estimate,
+			EstimateUnit: estimateUnitString(subtask.EstimateUnit),
+			IsOverdue:    isOverdue,
+			OverdueSince: overdueSince,
+		})
+	}
+
+	resp := &aufgaben_dto.SubtaskListResponse{
+		ParentID: parent.ID,
+		Progress: *buildSubtaskProgress(progress),
+		Subtasks: items,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) AddDependency(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.AddDependencyRequest) (*aufgaben_dto.AddDependencyResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not and returning corresponding task, doesn't care about user role
+	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// A task can't block itself
+	if req.BlockedByID == taskID {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.dependency_cycle", fmt.Errorf("Task can't be blocked by itself"))
+	}
+
+	// Check if blocker exists in the same project and is still relevant
+	blocker, err := s.repo.GetTaskByID(ctx, req.BlockedByID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(blocker, projectID); err != nil {
+		return nil, err
+	}
+
+	if blocker.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Insert dependency
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Check for cycle under the project lock, blocker must not already wait (transitively) on this task.
+	// Without the lock A blocked-by B and B blocked-by A could both pass the check concurrently.
+	if err := s.repo.LockProjectDependencies(ctx, tx, projectID); err != nil {
+		return nil, err
+	}
+
+	isCycle, err := s.repo.IsTransitivelyBlockedBy(ctx, tx, req.BlockedByID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if isCycle {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.dependency_cycle", fmt.Errorf("Dependency would create a cycle"))
+	}
+
+	dependency := &entity.AufgabenDependency{
+		AufgabenID:  taskID,
+		BlockedByID: req.BlockedByID,
+		CreatedBy:   userID,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.repo.InsertDependency(ctx, tx, dependency); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Blocked by: %s (%s)", blocker.Title, blocker.ID)
+	dependencyEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionDependencyAdded,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, dependencyEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	resp := &aufgaben_dto.AddDependencyResponse{
+		AufgabenID:  taskID,
+		BlockedByID: blocker.ID,
+		IsBlocked:   blocker.Status != entity.AufgabenDone,
+		CreatedAt:   dependency.CreatedAt,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) RemoveDependency(ctx context.Context, userID, projectID, taskID, blockerID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not and returning corresponding task, doesn't care about user role
+	task, err := s.getTaskAndVerifyMember(ctx, projectID, userID, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	// Remove dependency
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.DeleteDependency(ctx, tx, taskID, blockerID); err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("No longer blocked by: %s", blockerID)
+	dependencyEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionDependencyRemoved,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, dependencyEvent); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) ListDependencies(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.DependencyListResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, listing is allowed even the task is archived or done
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo for both directions
+	blockers, err := s.repo.ListBlockers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocking, err := s.repo.ListBlocking(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build response
+	blockedBy := buildDependencyItems(blockers)
+	isBlocked := false
+	for _, item := range blockedBy {
+		if item.IsOpen {
+			isBlocked = true
+			break
+		}
+	}
+
+	resp := &aufgaben_dto.DependencyListResponse{
+		AufgabenID: taskID,
+		IsBlocked:  isBlocked,
+		BlockedBy:  blockedBy,
+		Blocking:   buildDependencyItems(blocking),
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) CreateComment(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateCommentRequest) (*aufgaben_dto.CommentItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, discussion stays open on done tasks but not on archived ones
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Comment body must not be empty"))
+	}
+
+	// Resolve @username mentions to project members
+	mentioned, err := s.resolveMentions(ctx, projectID, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	commentID, idErr := uuid.NewV7()
+	if idErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+	}
+
+	comment := &entity.AufgabenCommentEntity{
+		ID:         commentID.String(),
+		AufgabenID: taskID,
+		AuthorID:   userID,
+		Body:       body,
+		CreatedAt:  time.Now(),
+	}
+
+	// Insert comment with its mentions
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.InsertComment(ctx, tx, comment); err != nil {
+		return nil, err
+	}
+
+	var newlyMentioned []string
+	if len(mentioned) > 0 {
+		newlyMentioned, err = s.repo.InsertCommentMentions(ctx, tx, comment.ID, mentionedUserIDs(mentioned))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Enqueue this task so that mentioned users and watchers can be notified
+	s.notifyMentions(task, comment, newlyMentioned)
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeCommented, buildCommentExcerpt(comment.Body))
+
+	return buildCommentItem(comment, mentioned), nil
+}
+
+func (s *AufgabenService) EditComment(ctx context.Context, userID, projectID, taskID, commentID string, req *aufgaben_dto.UpdateCommentRequest) (*aufgaben_dto.CommentItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if comment belongs to this task and performer is the author
+	comment, err := s.getCommentForTask(ctx, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorID != userID {
+		return nil, app_errors.NewAppError(fiber.StatusForbidden, app_errors.ErrForbidden, "forbidden.not_comment_author", nil)
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Comment body must not be empty"))
+	}
+
+	// Resolve @username mentions to project members
+	mentioned, err := s.resolveMentions(ctx, projectID, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update comment, only users mentioned for the first time are notified
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	editedAt, err := s.repo.UpdateCommentBody(ctx, tx, commentID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var newlyMentioned []string
+	if len(mentioned) > 0 {
+		newlyMentioned, err = s.repo.InsertCommentMentions(ctx, tx, commentID, mentionedUserIDs(mentioned))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	comment.Body = body
+	comment.EditedAt = editedAt
+
+	// Enqueue this task so that newly mentioned users can be notified
+	s.notifyMentions(task, comment, newlyMentioned)
+
+	return buildCommentItem(comment, mentioned), nil
+}
+
+func (s *AufgabenService) DeleteComment(ctx context.Context, userID, projectID, taskID, commentID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	// Check if comment belongs to this task
+	comment, err := s.getCommentForTask(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	// Only the author or the project meister may delete a comment
+	if comment.AuthorID != userID {
+		if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+			return err
+		}
+	}
+
+	// Call repo
+	if err := s.repo.SoftDeleteComment(ctx, commentID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) ListComments(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenCommentFilter) ([]*aufgaben_dto.CommentItem, *dtos.CursorPaginationMeta, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if task exists, comments stay readable even the task is archived or done
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, nil, err
+	}
+
+	// We need to verify filters first
+	if filters.Limit == 0 {
+		filters.Limit = 20
+	} else if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	if filters.Cursor != nil {
+		if _, err := uuid.Parse(*filters.Cursor); err != nil {
+			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", nil)
+		}
+	}
+
+	// Call repo
+	comments, err := s.repo.ListCommentsForTask(ctx, taskID, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build response cursor
+	hasMore := false
+	if len(comments) > filters.Limit {
+		hasMore = true
+		comments = comments[:filters.Limit]
+	}
+
+	meta := &dtos.CursorPaginationMeta{
+		Limit:   filters.Limit,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		meta.NextCursor = comments[len(comments)-1].ID
+	}
+
+	// Load the mentions of the whole page at once
+	mentionsByComment := make(map[string][]entity.MentionedUser)
+	if len(comments) > 0 {
+		commentIDs := make([]string, 0, len(comments))
+		for _, comment := range comments {
+			commentIDs = append(commentIDs, comment.ID)
+		}
+
+		mentions, err := s.repo.ListCommentMentions(ctx, commentIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mention := range mentions {
+			mentionsByComment[mention.CommentID] = append(mentionsByComment[mention.CommentID], mention.MentionedUser)
+		}
+	}
+
+	data := make([]*aufgaben_dto.CommentItem, 0, len(comments))
+	for i := range comments {
+		mentioned := currentCommentMentions(comments[i].Body, mentionsByComment[comments[i].ID])
+		data = append(data, buildCommentItem(&comments[i], mentioned))
+	}
+
+	return data, meta, nil
+}
+
+func (s *AufgabenService) CreateLabel(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateLabelRequest) (*aufgaben_dto.LabelItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages the label set of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Label name must not be empty"))
+	}
+
+	labelID, idErr := uuid.NewV7()
+	if idErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+	}
+
+	label := &entity.LabelEntity{
+		ID:        labelID.String(),
+		ProjectID: projectID,
+		Name:      name,
+		Color:     req.Color,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+	}
+
+	// Call repo, name is unique per project
+	if err := s.repo.InsertLabel(ctx, label); err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.label_name_taken", err.Err)
+		}
+		return nil, err
+	}
+
+	return buildLabelItem(label), nil
+}
+
+func (s *AufgabenService) ListLabels(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.LabelItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	labels, err := s.repo.ListLabels(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildLabelItems(labels), nil
+}
+
+func (s *AufgabenService) UpdateLabel(ctx context.Context, userID, projectID, labelID string, req *aufgaben_dto.UpdateLabelRequest) (*aufgaben_dto.LabelItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages the label set of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	if req.Name == nil && req.Color == nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Nothing to update"))
+	}
+
+	var name *string
+	if req.Name != nil {
+		trimmed := strings.TrimSpace(*req.Name)
+		if trimmed == "" {
+			return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Label name must not be empty"))
+		}
+		name = &trimmed
+	}
+
+	// Check if label belongs to this project
+	if _, err := s.getProjectLabel(ctx, projectID, labelID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	label, err := s.repo.UpdateLabel(ctx, labelID, name, req.Color)
+	if err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.label_name_taken", err.Err)
+		}
+		return nil, err
+	}
+
+	return buildLabelItem(label), nil
+}
+
+func (s *AufgabenService) DeleteLabel(ctx context.Context, userID, projectID, labelID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Only meister manages the label set of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Check if label belongs to this project
+	label, err := s.getProjectLabel(ctx, projectID, labelID)
+	if err != nil {
+		return err
+	}
+
+	// Delete label, every task that carried it gets a removal event
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	taskIDs, err := s.repo.DeleteLabel(ctx, tx, labelID)
+	if err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("Label: %s (deleted)", label.Name)
+	for _, taskID := range taskIDs {
+		labelEvent := &entity.AddAssignment{
+			AufgabenID: taskID,
+			ActorID:    userID,
+			Action:     entity.ActionLabelRemoved,
+			Note:       &note,
+		}
+
+		if _, err := s.createAndInsertEvent(ctx, tx, labelEvent); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	for _, taskID := range taskIDs {
+		s.invalidateTaskDetails(ctx, taskID)
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) AttachLabel(ctx context.Context, userID, projectID, taskID, labelID string) (*aufgaben_dto.TaskLabelResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if label belongs to the same project
+	label, err := s.getProjectLabel(ctx, projectID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach label
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	attachedAt, err := s.repo.AttachLabel(ctx, tx, taskID, labelID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Label: %s", label.Name)
+	labelEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionLabelAdded,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, labelEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	resp := &aufgaben_dto.TaskLabelResponse{
+		AufgabenID: taskID,
+		LabelID:    label.ID,
+		Name:       label.Name,
+		AttachedAt: *attachedAt,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) DetachLabel(ctx context.Context, userID, projectID, taskID, labelID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	if task.ArchivedAt != nil {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if label belongs to the same project
+	label, err := s.getProjectLabel(ctx, projectID, labelID)
+	if err != nil {
+		return err
+	}
+
+	// Detach label
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.DetachLabel(ctx, tx, taskID, labelID); err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("Label: %s", label.Name)
+	labelEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionLabelRemoved,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, labelEvent); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	return nil
+}
+
+func (s *AufgabenService) SearchTasksProject(ctx context.Context, userID, projectID string, filter aufgaben_dto.AufgabenSearchFilter) ([]*aufgaben_dto.AufgabenSearchItem, *dtos.PaginationMeta, *app_errors.AppError) {
+	// TODO
+	// Check if user is really project member or not, doesn't care about user role
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	// Scope search to this project
+	filter.ProjectID = &projectID
+
+	return s.searchTasks(ctx, userID, &filter)
+}
+
+func (s *AufgabenService) SearchTasks(ctx context.Context, userID string, filter aufgaben_dto.AufgabenSearchFilter) ([]*aufgaben_dto.AufgabenSearchItem, *dtos.PaginationMeta, *app_errors.AppError) {
+	// TODO
+	// If narrowed to one project, user has to be member there. Otherwise repo only returns tasks from user's projects
+	if filter.ProjectID != nil {
+		if err := s.verifyProjectMember(ctx, *filter.ProjectID, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return s.searchTasks(ctx, userID, &filter)
+}
+
+func (s *AufgabenService) GetWorkflow(ctx context.Context, userID, projectID string) (*aufgaben_dto.WorkflowResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildWorkflowResponse(workflow), nil
+}
+
+func (s *AufgabenService) UpdateWorkflow(ctx context.Context, userID, projectID string, req *aufgaben_dto.UpdateWorkflowRequest) (*aufgaben_dto.WorkflowResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister defines the workflow of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	workflow, err := buildWorkflowDefinition(projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the whole definition at once
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	resetTaskIDs, err := s.repo.ReplaceProjectWorkflow(ctx, tx, userID, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	for _, taskID := range resetTaskIDs {
+		s.invalidateTaskDetails(ctx, taskID)
+	}
+
+	return buildWorkflowResponse(workflow), nil
+}
+
+func (s *AufgabenService) TransitionTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.TransitionTaskRequest) (*aufgaben_dto.TransitionTaskResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := currentWorkflowStatus(workflow, task)
+	target := findWorkflowStatus(workflow, strings.TrimSpace(req.Status))
+	if target == nil {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "workflow_status_not_found", nil)
+	}
+
+	if current != nil && current.Key == target.Key {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.status_unchanged", nil)
+	}
+
+	// Changing the category has side effects (assignee, completion, reminders), those go through their own actions
+	if target.Category != task.Status {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.transition_requires_action", fmt.Errorf("moving from %s to %s changes the task category", task.Status, target.Category))
+	}
+
+	if _, err := s.resolveWorkflowTransition(ctx, projectID, userID, workflow, task, func(status *entity.WorkflowStatusEntity) bool {
+		return status.Key == target.Key
+	}); err != nil {
+		return nil, err
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.verifyWIPLimit(ctx, tx, projectID, taskID, target); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, taskID, target.Key); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Status changed from %s to %s", current.Key, target.Key)
+	if req.Note != nil {
+		note = *req.Note
+	}
+	fieldName := "workflow_status"
+	statusEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionStatusChanged,
+		Note:       &note,
+		FieldName:  &fieldName,
+		OldValue:   &current.Key,
+		NewValue:   &target.Key,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, statusEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	resp := &aufgaben_dto.TransitionTaskResponse{
+		AufgabenID:     taskID,
+		Status:         string(target.Category),
+		WorkflowStatus: target.Key,
+		PreviousStatus: current.Key,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) StartTimer(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.StartTimerRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, time can only be tracked on open tasks
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTaskAvailability(task); err != nil {
+		return nil, err
+	}
+
+	// Only one running timer per user
+	running, err := s.repo.GetRunningWorklog(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if running != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.timer_already_running", nil)
+	}
+
+	worklogID, _ := uuid.NewV7()
+	now := time.Now()
+	worklog := &entity.WorklogEntity{
+		ID:         worklogID.String(),
+		AufgabenID: taskID,
+		UserID:     userID,
+		Source:     entity.WorklogTimer,
+		StartedAt:  now,
+		Note:       normalizeWorklogNote(req.Note),
+		CreatedAt:  now,
+	}
+
+	// Call repo, the partial unique index catches concurrent starts
+	if err := s.repo.InsertWorklog(ctx, worklog); err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.timer_already_running", err.Err)
+		}
+		return nil, err
+	}
+
+	return buildWorklogItem(worklog), nil
+}
+
+func (s *AufgabenService) StopTimer(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.WorklogItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, a running timer can always be stopped
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// The running timer has to belong to this task
+	running, err := s.repo.GetRunningWorklog(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if running == nil || running.AufgabenID != taskID {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "timer_not_running", nil)
+	}
+
+	// Call repo
+	endedAt, err := s.repo.StopWorklog(ctx, running.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	running.EndedAt = endedAt
+	running.UpdatedAt = endedAt
+
+	return buildWorklogItem(running), nil
+}
+
+func (s *AufgabenService) CreateWorklog(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.CreateWorklogRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, time may still be booked on done tasks but not on archived ones
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	endedAt := req.StartedAt.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	if endedAt.After(time.Now()) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.worklog_in_future", nil)
+	}
+
+	worklogID, _ := uuid.NewV7()
+	worklog := &entity.WorklogEntity{
+		ID:         worklogID.String(),
+		AufgabenID: taskID,
+		UserID:     userID,
+		Source:     entity.WorklogManual,
+		StartedAt:  req.StartedAt,
+		EndedAt:    &endedAt,
+		Note:       normalizeWorklogNote(req.Note),
+		CreatedAt:  time.Now(),
+	}
+
+	// Call repo
+	if err := s.repo.InsertWorklog(ctx, worklog); err != nil {
+		return nil, err
+	}
+
+	return buildWorklogItem(worklog), nil
+}
+
+func (s *AufgabenService) UpdateWorklog(ctx context.Context, userID, projectID, taskID, worklogID string, req *aufgaben_dto.UpdateWorklogRequest) (*aufgaben_dto.WorklogItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if worklog belongs to this task
+	worklog, err := s.getWorklogForTask(ctx, taskID, worklogID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the author or the project meister may edit a worklog
+	if worklog.UserID != userID {
+		if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+			return nil, err
+		}
+	}
+
+	// A running timer has to be stopped first
+	if worklog.EndedAt == nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.worklog_running", nil)
+	}
+
+	startedAt := worklog.StartedAt
+	if req.StartedAt != nil {
+		startedAt = *req.StartedAt
+	}
+	duration := worklog.EndedAt.Sub(worklog.StartedAt)
+	if req.DurationMinutes != nil {
+		duration = time.Duration(*req.DurationMinutes) * time.Minute
+	}
+	endedAt := startedAt.Add(duration)
+	note := worklog.Note
+	if req.Note != nil {
+		note = normalizeWorklogNote(req.Note)
+	}
+
+	if startedAt.Equal(worklog.StartedAt) && endedAt.Equal(*worklog.EndedAt) && equalOptionalString(note, worklog.Note) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.no_changes", nil)
+	}
+
+	if endedAt.After(time.Now()) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.worklog_in_future", nil)
+	}
+
+	worklog.StartedAt = startedAt
+	worklog.EndedAt = &endedAt
+	worklog.Note = note
+
+	// Call repo
+	updatedAt, err := s.repo.UpdateWorklog(ctx, worklog)
+	if err != nil {
+		return nil, err
+	}
+	worklog.UpdatedAt = updatedAt
+
+	return buildWorklogItem(worklog), nil
+}
+
+func (s *AufgabenService) DeleteWorklog(ctx context.Context, userID, projectID, taskID, worklogID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	if task.ArchivedAt != nil {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if worklog belongs to this task
+	worklog, err := s.getWorklogForTask(ctx, taskID, worklogID)
+	if err != nil {
+		return err
+	}
+
+	// Only the author or the project meister may delete a worklog
+	if worklog.UserID != userID {
+		if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+			return err
+		}
+	}
+
+	// Call repo
+	if err := s.repo.DeleteWorklog(ctx, worklogID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) ListWorklogs(ctx context.Context, userID, projectID, taskID string, filters *aufgaben_dto.AufgabenWorklogFilter) ([]*aufgaben_dto.WorklogItem, *dtos.CursorPaginationMeta, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if task exists, worklogs stay readable even the task is archived or done
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, nil, err
+	}
+
+	// We need to verify filters first
+	if filters.Limit == 0 {
+		filters.Limit = 20
+	} else if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	if filters.Cursor != nil {
+		if _, err := uuid.Parse(*filters.Cursor); err != nil {
+			return nil, nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", nil)
+		}
+	}
+
+	// Call repo
+	worklogs, err := s.repo.ListWorklogsForTask(ctx, taskID, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build response cursor
+	hasMore := false
+	if len(worklogs) > filters.Limit {
+		hasMore = true
+		worklogs = worklogs[:filters.Limit]
+	}
+
+	meta := &dtos.CursorPaginationMeta{
+		Limit:   filters.Limit,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		meta.NextCursor = worklogs[len(worklogs)-1].ID
+	}
+
+	data := make([]*aufgaben_dto.WorklogItem, 0, len(worklogs))
+	for i := range worklogs {
+		data = append(data, buildWorklogItem(&worklogs[i]))
+	}
+
+	return data, meta, nil
+}
+
+func (s *AufgabenService) GetTaskTimeSummary(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.TaskTimeSummaryResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	totals, err := s.repo.GetTaskWorklogTotals(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, sum := buildWorklogTotals(totals)
+
+	return &aufgaben_dto.TaskTimeSummaryResponse{
+		AufgabenID:   taskID,
+		TotalMinutes: sum,
+		Members:      members,
+	}, nil
+}
+
+func (s *AufgabenService) GetTimeReport(ctx context.Context, userID, projectID string, filter *aufgaben_dto.TimeReportFilter) (*aufgaben_dto.TimeReportResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Date range is inclusive and limited to one year
+	from, parseErr := time.Parse(time.DateOnly, filter.From)
+	if parseErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", parseErr)
+	}
+	to, parseErr := time.Parse(time.DateOnly, filter.To)
+	if parseErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", parseErr)
+	}
+	if to.Before(from) || to.Sub(from) > 366*24*time.Hour {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_date_range", nil)
+	}
+
+	// Call repo
+	memberTotals, taskTotals, err := s.repo.GetProjectTimeReport(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	members, sum := buildWorklogTotals(memberTotals)
+	tasks, _ := buildWorklogTotals(taskTotals)
+
+	return &aufgaben_dto.TimeReportResponse{
+		ProjectID:    projectID,
+		From:         filter.From,
+		To:           filter.To,
+		TotalMinutes: sum,
+		Members:      members,
+		Tasks:        tasks,
+	}, nil
+}
+
+func (s *AufgabenService) GetVelocity(ctx context.Context, userID, projectID string, filter *aufgaben_dto.VelocityFilter) (*aufgaben_dto.VelocityResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if filter.Weeks == 0 {
+		filter.Weeks = 8
+	} else if filter.Weeks > 52 {
+		filter.Weeks = 52
+	}
+
+	// Range ends with the current week
+	to := time.Now()
+	from := to.AddDate(0, 0, -7*(filter.Weeks-1))
+
+	// Call repo
+	weeks, err := s.repo.GetProjectVelocity(ctx, projectID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildVelocityResponse(projectID, from, to, weeks), nil
+}
+
+func (s *AufgabenService) CreateRecurrence(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateRecurrenceRequest) (*aufgaben_dto.RecurrenceItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages recurring tasks of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Default assignee has to be a project member
+	if req.DefaultAssigneeID != nil {
+		if err := s.verifyProjectMember(ctx, projectID, *req.DefaultAssigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Recurrence title must not be empty"))
+	}
+
+	cronExpr := strings.TrimSpace(req.CronExpr)
+	nextRunAt, err := nextRecurrenceRun(cronExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := entity.PriorityMedium
+	if req.Priority != nil {
+		priority = entity.AufgabenPriority(*req.Priority)
+	}
+
+	recurrenceID, _ := uuid.NewV7()
+	recurrence := &entity.RecurrenceEntity{
+		ID:                recurrenceID.String(),
+		ProjectID:         projectID,
+		Title:             title,
+		Description:       req.Description,
+		Priority:          priority,
+		DefaultAssigneeID: req.DefaultAssigneeID,
+		DueOffsetMinutes:  req.DueOffsetMinutes,
+		CronExpr:          cronExpr,
+		NextRunAt:         nextRunAt,
+		Active:            true,
+		CreatedBy:         userID,
+		CreatedAt:         time.Now(),
+	}
+
+	// Call repo
+	if err := s.repo.InsertRecurrence(ctx, recurrence); err != nil {
+		return nil, err
+	}
+
+	return buildRecurrenceItem(recurrence), nil
+}
+
+func (s *AufgabenService) ListRecurrences(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.RecurrenceItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	recurrences, err := s.repo.ListRecurrences(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.RecurrenceItem, 0, len(recurrences))
+	for i := range recurrences {
+		data = append(data, buildRecurrenceItem(&recurrences[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) UpdateRecurrence(ctx context.Context, userID, projectID, recurrenceID string, req *aufgaben_dto.UpdateRecurrenceRequest) (*aufgaben_dto.RecurrenceItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages recurring tasks of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if recurrence belongs to this project
+	recurrence, err := s.getProjectRecurrence(ctx, projectID, recurrenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Recurrence title must not be empty"))
+		}
+		recurrence.Title = title
+	}
+
+	if req.Description != nil {
+		// Empty description clears it
+		recurrence.Description = nil
+		if trimmed := strings.TrimSpace(*req.Description); trimmed != "" {
+			recurrence.Description = &trimmed
+		}
+	}
+
+	if req.Priority != nil {
+		recurrence.Priority = entity.AufgabenPriority(*req.Priority)
+	}
+
+	if req.DefaultAssigneeID != nil {
+		recurrence.DefaultAssigneeID = nil
+		if *req.DefaultAssigneeID != "" {
+			if err := s.verifyProjectMember(ctx, projectID, *req.DefaultAssigneeID); err != nil {
+				return nil, err
+			}
+			recurrence.DefaultAssigneeID = req.DefaultAssigneeID
+		}
+	}
+
+	if req.DueOffsetMinutes != nil {
+		recurrence.DueOffsetMinutes = req.DueOffsetMinutes
+	}
+
+	// A new rule or a reactivated series starts counting from now, missed occurrences are not back filled
+	reschedule := false
+	if req.CronExpr != nil {
+		cronExpr := strings.TrimSpace(*req.CronExpr)
+		if cronExpr != recurrence.CronExpr {
+			recurrence.CronExpr = cronExpr
+			reschedule = true
+		}
+	}
+
+	if req.Active != nil {
+		if *req.Active && !recurrence.Active {
+			reschedule = true
+		}
+		recurrence.Active = *req.Active
+	}
+
+	if reschedule {
+		nextRunAt, err := nextRecurrenceRun(recurrence.CronExpr)
+		if err != nil {
+			return nil, err
+		}
+		recurrence.NextRunAt = nextRunAt
+	}
+
+	// Call repo
+	updatedAt, err := s.repo.UpdateRecurrence(ctx, recurrence)
+	if err != nil {
+		return nil, err
+	}
+	recurrence.UpdatedAt = updatedAt
+
+	return buildRecurrenceItem(recurrence), nil
+}
+
+func (s *AufgabenService) DeleteRecurrence(ctx context.Context, userID, projectID, recurrenceID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Only meister manages recurring tasks of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Check if recurrence belongs to this project
+	if _, err := s.getProjectRecurrence(ctx, projectID, recurrenceID); err != nil {
+		return err
+	}
+
+	// Call repo, already generated tasks are kept
+	if err := s.repo.DeleteRecurrence(ctx, recurrenceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) CreateTemplate(ctx context.Context, userID, projectID string, req *aufgaben_dto.SaveTemplateRequest) (*aufgaben_dto.TemplateItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages the templates of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	templateID, idErr := uuid.NewV7()
+	if idErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+	}
+
+	template := &entity.TemplateEntity{
+		ID:        templateID.String(),
+		ProjectID: projectID,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+	}
+	if err := applyTemplateRequest(template, req); err != nil {
+		return nil, err
+	}
+
+	// Call repo, name is unique per project
+	if err := s.repo.InsertTemplate(ctx, template); err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.template_name_taken", err.Err)
+		}
+		return nil, err
+	}
+
+	return buildTemplateItem(template), nil
+}
+
+func (s *AufgabenService) ListTemplates(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.TemplateItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	templates, err := s.repo.ListTemplates(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.TemplateItem, 0, len(templates))
+	for i := range templates {
+		data = append(data, buildTemplateItem(&templates[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) UpdateTemplate(ctx context.Context, userID, projectID, templateID string, req *aufgaben_dto.SaveTemplateRequest) (*aufgaben_dto.TemplateItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister manages the templates of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if template belongs to this project
+	template, err := s.getProjectTemplate(ctx, projectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request replaces all saved defaults
+	if err := applyTemplateRequest(template, req); err != nil {
+		return nil, err
+	}
+
+	// Call repo, name is unique per project
+	updatedAt, err := s.repo.UpdateTemplate(ctx, template)
+	if err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.template_name_taken", err.Err)
+		}
+		return nil, err
+	}
+	template.UpdatedAt = updatedAt
+
+	return buildTemplateItem(template), nil
+}
+
+func (s *AufgabenService) DeleteTemplate(ctx context.Context, userID, projectID, templateID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Only meister manages the templates of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Check if template belongs to this project
+	if _, err := s.getProjectTemplate(ctx, projectID, templateID); err != nil {
+		return err
+	}
+
+	// Call repo, tasks created from the template are not linked to it
+	if err := s.repo.DeleteTemplate(ctx, templateID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) CreateAufgabenFromTemplate(ctx context.Context, userID, projectID, templateID string, req *aufgaben_dto.CreateFromTemplateRequest) (*aufgaben_dto.CreateNewAufgabenResponse, *app_errors.AppError) {
+	// TODO
+	// Check if creator is really project member or not, doesn't care about user role
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if template belongs to this project
+	template, err := s.getProjectTemplate(ctx, projectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge overrides and fill placeholders
+	createReq, err := buildTemplateAufgabenRequest(template, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.insertNewAufgabe(ctx, userID, projectID, createReq)
+}
+
+func (s *AufgabenService) UploadAttachment(ctx context.Context, userID, projectID, taskID string, upload *aufgaben_dto.AttachmentUpload) (*aufgaben_dto.AttachmentItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check size and content type
+	contentType, content, err := verifyAttachmentContent(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentID, idErr := uuid.NewV7()
+	if idErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", idErr)
+	}
+
+	attachment := &entity.AttachmentEntity{
+		ID:          attachmentID.String(),
+		AufgabenID:  taskID,
+		ProjectID:   projectID,
+		FileName:    normalizeAttachmentName(upload.FileName),
+		ContentType: contentType,
+		SizeBytes:   upload.Size,
+		StorageKey:  fmt.Sprintf("%s/%s", projectID, attachmentID.String()),
+		UploadedBy:  userID,
+		CreatedAt:   time.Now(),
+	}
+
+	// Store content first, the blob is removed again when the metadata can't be saved
+	if err := s.storage.Put(ctx, attachment.StorageKey, content); err != nil {
+		return nil, err
+	}
+
+	stored := false
+	defer func() {
+		if !stored {
+			s.removeBlob(ctx, attachment.StorageKey)
+		}
+	}()
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Check project quota
+	quota, err := s.repo.LockAttachmentQuota(ctx, tx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quota.UsedBytes+attachment.SizeBytes > quota.QuotaBytes {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.attachment_quota_exceeded", nil)
+	}
+
+	if err := s.repo.InsertAttachment(ctx, tx, attachment); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Attachment: %s", attachment.FileName)
+	attachmentEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionAttachmentAdded,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, attachmentEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+	stored = true
+
+	return buildAttachmentItem(attachment), nil
+}
+
+func (s *AufgabenService) ListAttachments(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.AttachmentItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	attachments, err := s.repo.ListAttachmentsForTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.AttachmentItem, 0, len(attachments))
+	for i := range attachments {
+		data = append(data, buildAttachmentItem(&attachments[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) DownloadAttachment(ctx context.Context, userID, projectID, taskID, attachmentID string) (*aufgaben_dto.AttachmentItem, io.ReadCloser, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if attachment belongs to this task
+	attachment, err := s.getAttachmentForTask(ctx, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Open content, the caller closes it
+	content, err := s.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildAttachmentItem(attachment), content, nil
+}
+
+func (s *AufgabenService) DeleteAttachment(ctx context.Context, userID, projectID, taskID, attachmentID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	if task.ArchivedAt != nil {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Check if attachment belongs to this task
+	attachment, err := s.getAttachmentForTask(ctx, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	// Only uploader or meister can delete the attachment
+	if attachment.UploadedBy != userID {
+		if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+			return err
+		}
+	}
+
+	// Delete attachment
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.DeleteAttachment(ctx, tx, attachmentID); err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("Attachment: %s", attachment.FileName)
+	attachmentEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionAttachmentRemoved,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, attachmentEvent); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Content goes after the metadata, a failed delete only leaves an unreferenced blob
+	s.removeBlob(ctx, attachment.StorageKey)
+
+	return nil
+}
+
+func (s *AufgabenService) WatchTask(ctx context.Context, userID, projectID, taskID string) (*aufgaben_dto.WatcherItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists, done tasks can still be watched but archived ones not
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Subscribe, watching twice keeps the first subscription
+	watchingSince, err := s.repo.AddWatcher(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &aufgaben_dto.WatcherItem{
+		AufgabenID:    taskID,
+		UserID:        userID,
+		WatchingSince: *watchingSince,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) UnwatchTask(ctx context.Context, userID, projectID, taskID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	// Every watcher can only unsubscribe themselves
+	return s.repo.RemoveWatcher(ctx, taskID, userID)
+}
+
+func (s *AufgabenService) ListWatchers(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.WatcherItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	watchers, err := s.repo.ListWatchers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.WatcherItem, 0, len(watchers))
+	for i := range watchers {
+		data = append(data, buildWatcherItem(&watchers[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) ListHandoverRequests(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.HandoverRequestItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister decides on handover requests
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	requests, err := s.repo.ListPendingHandoverRequests(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.HandoverRequestItem, 0, len(requests))
+	for i := range requests {
+		data = append(data, buildHandoverRequestItem(&requests[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) ApproveHandoverRequest(ctx context.Context, userID, projectID, requestID string, req *aufgaben_dto.ApproveHandoverRequest) (*aufgaben_dto.ReassignAufgabenResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister decides on handover requests
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the request, it has to be still pending
+	request, err := s.getPendingHandoverRequest(ctx, tx, projectID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if task is still relevant
+	task, err := s.repo.GetTaskByID(ctx, request.AufgabenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTaskAvailability(task); err != nil {
+		return nil, err
+	}
+
+	// Task may have changed hands since the request was filed
+	if task.AssigneeID == nil || *task.AssigneeID != request.RequestedBy {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.handover_request_outdated", nil)
+	}
+
+	if err := verifyHandoverTarget(task, request.TargetAssigneeID); err != nil {
+		return nil, err
+	}
+
+	decidedAt, err := s.repo.DecideHandoverRequest(ctx, tx, requestID, entity.HandoverApproved, userID, &req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same handover as a forced one, the target is the one from the request
+	note := request.Note
+	if req.Note != "" {
+		note = req.Note
+	}
+	handover := &aufgaben_dto.ForceAufgabeHandoverRequest{
+		TargetID:   request.TargetAssigneeID,
+		Note:       note,
+		ReasonCode: req.ReasonCode,
+		Reason:     req.Reason,
+	}
+
+	resp, err := s.executeHandover(ctx, tx, userID, projectID, task, handover)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Enqueue this task so that the requester and watchers can be notified
+	s.notifyHandoverDecision(task, request, userID, entity.HandoverApproved, req.Reason, *decidedAt)
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeAssigned, request.TargetAssigneeID)
+
+	resp.RequestID = &request.ID
+
+	return resp, nil
+}
+
+func (s *AufgabenService) RejectHandoverRequest(ctx context.Context, userID, projectID, requestID string, req *aufgaben_dto.RejectHandoverRequest) (*aufgaben_dto.HandoverRequestItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister decides on handover requests
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the request, it has to be still pending
+	request, err := s.getPendingHandoverRequest(ctx, tx, projectID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.GetTaskByID(ctx, request.AufgabenID)
+	if err != nil {
+		return nil, err
+	}
+
+	decidedAt, err := s.repo.DecideHandoverRequest(ctx, tx, requestID, entity.HandoverRejected, userID, &req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert rejection event
+	note := fmt.Sprintf("Handover request to %s rejected", request.TargetAssigneeID)
+	rejectEvent := &entity.AddAssignment{
+		AufgabenID:       request.AufgabenID,
+		ActorID:          userID,
+		TargetAssigneeID: &request.TargetAssigneeID,
+		Action:           entity.ActionHandoverRejected,
+		Note:             &note,
+		ReasonText:       &req.Reason,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, rejectEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Enqueue this task so that the requester can be notified
+	s.notifyHandoverDecision(task, request, userID, entity.HandoverRejected, req.Reason, *decidedAt)
+
+	request.Status = entity.HandoverRejected
+	request.DecidedBy = &userID
+	request.DecisionReason = &req.Reason
+	request.DecidedAt = decidedAt
+
+	return buildHandoverRequestItem(request), nil
+}
+
+func (s *AufgabenService) AddTaskAssignee(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.AddTaskAssigneeRequest) (*aufgaben_dto.TaskAssigneeItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Only the Meister or the owner bring other people onto the task
+	if err := s.verifyParticipantManager(ctx, projectID, task, userID); err != nil {
+		return nil, err
+	}
+
+	// New participant has to be project member too
+	if err := s.verifyProjectMember(ctx, projectID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	// Add participant, the owner is set through assign and handover
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	assignee := &entity.AufgabenAssigneeEntity{
+		AufgabenID: taskID,
+		UserID:     req.UserID,
+		Role:       entity.AssigneeRole(req.Role),
+		AddedBy:    &userID,
+	}
+
+	if err := s.repo.AddTaskAssignee(ctx, tx, assignee); err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.already_task_assignee", err.Err)
+		}
+		return nil, err
+	}
+
+	fieldName := "role"
+	participantEvent := &entity.AddAssignment{
+		AufgabenID:       taskID,
+		ActorID:          userID,
+		TargetAssigneeID: &req.UserID,
+		Action:           entity.ActionParticipantAdded,
+		FieldName:        &fieldName,
+		NewValue:         &req.Role,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, participantEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return buildTaskAssigneeItem(assignee), nil
+}
+
+func (s *AufgabenService) RemoveTaskAssignee(ctx context.Context, userID, projectID, taskID, targetID string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if task exists and is not archived
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return err
+	}
+
+	if task.ArchivedAt != nil {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	// Everyone can leave a task, removing somebody else is up to the Meister or the owner
+	if targetID != userID {
+		if err := s.verifyParticipantManager(ctx, projectID, task, userID); err != nil {
+			return err
+		}
+	}
+
+	// Check target is a participant, the owner leaves through unassign or handover
+	role, err := s.repo.GetTaskAssigneeRole(ctx, taskID, targetID)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_assignee_not_found", nil)
+	}
+	if *role == entity.AssigneeOwner {
+		return app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_owner_not_removable", nil)
+	}
+
+	// Remove participant
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.RemoveTaskAssignee(ctx, tx, taskID, targetID); err != nil {
+		return err
+	}
+
+	fieldName := "role"
+	oldRole := string(*role)
+	participantEvent := &entity.AddAssignment{
+		AufgabenID:       taskID,
+		ActorID:          userID,
+		TargetAssigneeID: &targetID,
+		Action:           entity.ActionParticipantRemoved,
+		FieldName:        &fieldName,
+		OldValue:         &oldRole,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, participantEvent); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	return nil
+}
+
+func (s *AufgabenService) ListTaskAssignees(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.TaskAssigneeItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	assignees, err := s.repo.ListTaskAssignees(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.TaskAssigneeItem, 0, len(assignees))
+	for i := range assignees {
+		data = append(data, buildTaskAssigneeItem(&assignees[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) PickNextTask(ctx context.Context, userID, projectID string, req *aufgaben_dto.PickNextAufgabeRequest) (*aufgaben_dto.AufgabenAssignResponse, *app_errors.AppError) {
+	// TODO
+	// Check if user is really project member or not, doesn't care about user role
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Due date from request wins over the one planned on the task (should > now() + 1 hour)
+	if req.DueDate != nil && !req.DueDate.After(time.Now().Add(1*time.Hour)) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Due date must be in the future"))
+	}
+
+	// Prepare transaction, the picked task stays locked until commit
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Blocked tasks are left out by the query, reopened blockers of the project have to be seen
+	if err := s.repo.LockProjectDependencies(ctx, tx, projectID); err != nil {
+		return nil, err
+	}
+
+	// Claim the most important unassigned task nobody else is picking right now,
+	// a candidate the workflow or the WIP limit rejects is skipped for the next one
+	var (
+		task     *entity.AufgabenEntity
+		target   *entity.WorkflowStatusEntity
+		skipIDs  []string
+		firstErr *app_errors.AppError
+	)
+	for task == nil {
+		taskID, err := s.repo.LockNextAssignableTask(ctx, tx, projectID, req.DueDate, skipIDs)
+		if err != nil {
+			return nil, err
+		}
+		if taskID == nil {
+			// Tell why the skipped candidates could not be picked
+			if firstErr != nil {
+				return nil, firstErr
+			}
+			return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "no_task_available", nil)
+		}
+
+		candidate, err := s.repo.GetTaskByID(ctx, *taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check the status change against the project's workflow
+		candidateTarget, err := s.verifyCategoryTransition(ctx, projectID, userID, candidate, entity.AufgabenInProgress)
+		if err == nil {
+			err = s.verifyWIPLimit(ctx, tx, projectID, candidate.ID, candidateTarget)
+		}
+		if err != nil {
+			if err.Code != fiber.StatusConflict && err.Code != fiber.StatusForbidden {
+				return nil, err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			skipIDs = append(skipIDs, candidate.ID)
+			continue
+		}
+
+		task, target = candidate, candidateTarget
+	}
+
+	dueDate := task.DueDate
+	if req.DueDate != nil {
+		dueDate = req.DueDate
+	}
+
+	assigned, err := s.repo.AssignTask(ctx, tx, projectID, task.ID, userID, dueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateWorkflowStatus(ctx, tx, task.ID, target.Key); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("First assignment by: %s", assigned.AssigneeID)
+	assignEvent := &entity.AddAssignment{
+		AufgabenID: assigned.ID,
+		ActorID:    assigned.AssigneeID,
+		Action:     entity.ActionAssign,
+		Note:       &note,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, assignEvent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	// Enqueue this task so that watchers can be notified
+	s.notifyWatchers(task, userID, worker_task.WatcherChangeAssigned, assigned.AssigneeID)
+
+	resp := &aufgaben_dto.AufgabenAssignResponse{
+		AufgabenID: assigned.ID,
+		ProjectID:  projectID,
+		Status:     string(assigned.Status),
+		Priority:   string(assigned.Priority),
+		AssigneeID: assigned.AssigneeID,
+		CreatedBy:  assigned.CreatedBy,
+		DueDate:    assigned.DueDate,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) SuggestAssignees(ctx context.Context, userID, projectID, taskID string) ([]*aufgaben_dto.AssigneeSuggestionItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority, workloads of other members are for the Meister only
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists and is still relevant
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTaskAvailability(task); err != nil {
+		return nil, err
+	}
+
+	threshold, err := s.repo.GetWorkloadThreshold(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := s.repo.ListMemberWorkloads(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rank candidates, lowest load first. The current assignee is not a candidate for their own task
+	data := make([]*aufgaben_dto.AssigneeSuggestionItem, 0, len(workloads))
+	for i := range workloads {
+		if task.AssigneeID != nil && *task.AssigneeID == workloads[i].UserID {
+			continue
+		}
+		data = append(data, buildAssigneeSuggestionItem(&workloads[i], threshold))
+	}
+
+	slices.SortStableFunc(data, func(a, b *aufgaben_dto.AssigneeSuggestionItem) int {
+		if a.LoadScore != b.LoadScore {
+			return cmp.Compare(a.LoadScore, b.LoadScore)
+		}
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	return data, nil
+}
+
+func (s *AufgabenService) UpdateWorkloadThreshold(ctx context.Context, userID, projectID string, req *aufgaben_dto.UpdateWorkloadThresholdRequest) (*aufgaben_dto.WorkloadThresholdResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Update threshold, 0 turns the handover warning off
+	if err := s.repo.UpdateWorkloadThreshold(ctx, projectID, *req.Threshold); err != nil {
+		return nil, err
+	}
+
+	resp := &aufgaben_dto.WorkloadThresholdResponse{
+		ProjectID: projectID,
+		Threshold: *req.Threshold,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) UpsertSLAPolicy(ctx context.Context, userID, projectID, priority string, req *aufgaben_dto.UpsertSLAPolicyRequest) (*aufgaben_dto.SLAPolicyItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// A policy without any target measures nothing
+	if req.AssignWithinMinutes == nil && req.CompleteWithinMinutes == nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("at least one of assign_within_minutes and complete_within_minutes is required"))
+	}
+
+	policy := &entity.SLAPolicyEntity{
+		ProjectID:             projectID,
+		Priority:              entity.AufgabenPriority(priority),
+		AssignWithinMinutes:   req.AssignWithinMinutes,
+		CompleteWithinMinutes: req.CompleteWithinMinutes,
+		UpdatedBy:             &userID,
+	}
+
+	if err := s.repo.UpsertSLAPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return buildSLAPolicyItem(policy), nil
+}
+
+func (s *AufgabenService) ListSLAPolicies(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.SLAPolicyItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	policies, err := s.repo.ListSLAPolicies(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.SLAPolicyItem, 0, len(policies))
+	for i := range policies {
+		data = append(data, buildSLAPolicyItem(&policies[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) DeleteSLAPolicy(ctx context.Context, userID, projectID, priority string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Breaches already recorded stay for the compliance report
+	return s.repo.DeleteSLAPolicy(ctx, projectID, entity.AufgabenPriority(priority))
+}
+
+func (s *AufgabenService) GetSLACompliance(ctx context.Context, userID, projectID string, filter *aufgaben_dto.SLAComplianceFilter) (*aufgaben_dto.SLAComplianceResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Date range is inclusive and limited to one year
+	from, parseErr := time.Parse(time.DateOnly, filter.From)
+	if parseErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", parseErr)
+	}
+	to, parseErr := time.Parse(time.DateOnly, filter.To)
+	if parseErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_query", parseErr)
+	}
+	if to.Before(from) || to.Sub(from) > 366*24*time.Hour {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidQuery, "request.invalid_date_range", nil)
+	}
+
+	// Call repo
+	rows, err := s.repo.GetSLACompliance(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := make([]aufgaben_dto.SLAComplianceItem, 0, len(rows))
+	for _, row := range rows {
+		priorities = append(priorities, aufgaben_dto.SLAComplianceItem{
+			Priority:             string(row.Priority),
+			Measured:             row.Measured,
+			AssignmentBreaches:   row.AssignmentBreaches,
+			CompletionBreaches:   row.CompletionBreaches,
+			AssignmentCompliance: slaCompliance(row.Measured, row.AssignmentBreaches),
+			CompletionCompliance: slaCompliance(row.Measured, row.CompletionBreaches),
+		})
+	}
+
+	return &aufgaben_dto.SLAComplianceResponse{
+		ProjectID:  projectID,
+		From:       filter.From,
+		To:         filter.To,
+		Priorities: priorities,
+	}, nil
+}
+
+func (s *AufgabenService) UpsertEscalationLevel(ctx context.Context, userID, projectID string, level int, req *aufgaben_dto.UpsertEscalationLevelRequest) (*aufgaben_dto.EscalationLevelItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// A level without any trigger is never reached
+	if req.AfterReminders == nil && req.AfterHoursOverdue == nil {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("at least one of after_reminders and after_hours_overdue is required"))
+	}
+
+	action := entity.EscalationNotifyMeister
+	if req.Action != nil {
+		action = entity.EscalationAction(*req.Action)
+	}
+
+	escalationLevel := &entity.EscalationLevelEntity{
+		ProjectID:         projectID,
+		Level:             level,
+		AfterReminders:    req.AfterReminders,
+		AfterHoursOverdue: req.AfterHoursOverdue,
+		Action:            action,
+		UpdatedBy:         &userID,
+	}
+
+	if err := s.repo.UpsertEscalationLevel(ctx, escalationLevel); err != nil {
+		return nil, err
+	}
+
+	return buildEscalationLevelItem(escalationLevel), nil
+}
+
+func (s *AufgabenService) ListEscalationLevels(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.EscalationLevelItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	levels, err := s.repo.ListEscalationLevels(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.EscalationLevelItem, 0, len(levels))
+	for i := range levels {
+		data = append(data, buildEscalationLevelItem(&levels[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) DeleteEscalationLevel(ctx context.Context, userID, projectID string, level int) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Tasks that already reached the level keep it until their reminders restart
+	return s.repo.DeleteEscalationLevel(ctx, projectID, level)
+}
+
+func (s *AufgabenService) ListFlaggedTasks(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.FlaggedTaskItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	tasks, err := s.repo.ListFlaggedTasks(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.FlaggedTaskItem, 0, len(tasks))
+	for _, task := range tasks {
+		data = append(data, &aufgaben_dto.FlaggedTaskItem{
+			AufgabenID:           task.ID,
+			Title:                task.Title,
+			Status:               string(task.Status),
+			Priority:             string(task.Priority),
+			AssigneeID:           task.AssigneeID,
+			DueDate:              task.DueDate,
+			EscalationLevel:      task.EscalationLevel,
+			FlaggedForUnassignAt: *task.FlaggedForUnassignAt,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) GetOverdueSummary(ctx context.Context, userID, projectID string) (*aufgaben_dto.OverdueSummaryResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	tasks, err := s.repo.ListOverdueTasks(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildOverdueSummary(projectID, tasks, time.Now()), nil
+}
+
+func (s *AufgabenService) GetBoard(ctx context.Context, userID, projectID string) (*aufgaben_dto.BoardResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Columns are the workflow statuses in workflow order
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.repo.ListBoardColumns(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.ListBoardTasks(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]aufgaben_dto.BoardColumnItem, 0, len(workflow.Statuses))
+	columnIndex := make(map[string]int, len(workflow.Statuses))
+	for _, status := range workflow.Statuses {
+		column := aufgaben_dto.BoardColumnItem{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: string(status.Category),
+			Position: status.Position,
+			Tasks:    []aufgaben_dto.BoardTaskItem{},
+		}
+		for i := range limits {
+			if limits[i].StatusKey == status.Key {
+				column.WIPLimit = &limits[i].WIPLimit
+			}
+		}
+		columnIndex[status.Key] = len(columns)
+		columns = append(columns, column)
+	}
+
+	// Tasks already come in board order
+	for i := range tasks {
+		status := boardColumnOf(workflow, &tasks[i])
+		if status == nil {
+			continue
+		}
+		idx := columnIndex[status.Key]
+		columns[idx].Tasks = append(columns[idx].Tasks, buildBoardTaskItem(&tasks[i]))
+		columns[idx].Count++
+	}
+
+	return &aufgaben_dto.BoardResponse{
+		ProjectID: projectID,
+		Columns:   columns,
+	}, nil
+}
+
+func (s *AufgabenService) MoveTask(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.MoveBoardTaskRequest) (*aufgaben_dto.MoveBoardTaskResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := currentWorkflowStatus(workflow, task)
+	target := findWorkflowStatus(workflow, strings.TrimSpace(req.Status))
+	if target == nil {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "workflow_status_not_found", nil)
+	}
+
+	// Moving into another column is a status transition, with the same rules as TransitionTask
+	previousStatus := ""
+	if current != nil {
+		previousStatus = current.Key
+	}
+	columnChanged := previousStatus != target.Key
+	if columnChanged {
+		if target.Category != task.Status {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.transition_requires_action", fmt.Errorf("moving from %s to %s changes the task category", task.Status, target.Category))
+		}
+
+		if _, err := s.resolveWorkflowTransition(ctx, projectID, userID, workflow, task, func(status *entity.WorkflowStatusEntity) bool {
+			return status.Key == target.Key
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	// Concurrent moves into the column wait here, the neighbours below are read after the previous move committed
+	if err := s.repo.LockBoardColumn(ctx, tx, projectID, target.Key); err != nil {
+		return nil, err
+	}
+
+	if columnChanged {
+		if err := s.verifyWIPLimit(ctx, tx, projectID, taskID, target); err != nil {
+			return nil, err
+		}
+	}
+
+	// Place the task between its new neighbours, no position means the bottom of the column
+	columnTasks, err := s.boardColumnTasks(ctx, tx, projectID, workflow, target, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	position := len(columnTasks)
+	if req.Position != nil && *req.Position < position {
+		position = *req.Position
+	}
+
+	var afterRank, beforeRank *string
+	if position > 0 {
+		afterRank = &columnTasks[position-1].BoardRank
+	}
+	if position < len(columnTasks) {
+		beforeRank = &columnTasks[position].BoardRank
+	}
+
+	rank, err := s.repo.MoveBoardTask(ctx, tx, taskID, target.Key, afterRank, beforeRank)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reordering inside a column is not part of the task's history
+	if columnChanged {
+		note := fmt.Sprintf("Status changed from %s to %s", previousStatus, target.Key)
+		if req.Note != nil {
+			note = *req.Note
+		}
+		fieldName := "workflow_status"
+		statusEvent := &entity.AddAssignment{
+			AufgabenID: taskID,
+			ActorID:    userID,
+			Action:     entity.ActionStatusChanged,
+			Note:       &note,
+			FieldName:  &fieldName,
+			OldValue:   &previousStatus,
+			NewValue:   &target.Key,
+		}
+
+		if _, err := s.createAndInsertEvent(ctx, tx, statusEvent); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	if columnChanged {
+		s.invalidateTaskDetails(ctx, taskID)
+	}
+
+	return &aufgaben_dto.MoveBoardTaskResponse{
+		AufgabenID:     taskID,
+		Status:         string(target.Category),
+		WorkflowStatus: target.Key,
+		PreviousStatus: previousStatus,
+		Position:       position,
+		Rank:           rank,
+	}, nil
+}
+
+func (s *AufgabenService) UpsertBoardColumn(ctx context.Context, userID, projectID, statusKey string, req *aufgaben_dto.UpsertBoardColumnRequest) (*aufgaben_dto.BoardColumnLimitItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Only statuses of the project's workflow are columns
+	workflow, err := s.getProjectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if findWorkflowStatus(workflow, statusKey) == nil {
+		return nil, app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "workflow_status_not_found", nil)
+	}
+
+	// A column already above the new limit keeps its tasks, only new ones are refused
+	column := &entity.BoardColumnEntity{
+		ProjectID: projectID,
+		StatusKey: statusKey,
+		WIPLimit:  *req.WIPLimit,
+		UpdatedBy: &userID,
+	}
+
+	if err := s.repo.UpsertBoardColumn(ctx, column); err != nil {
+		return nil, err
+	}
+
+	return buildBoardColumnLimitItem(column), nil
+}
+
+func (s *AufgabenService) DeleteBoardColumn(ctx context.Context, userID, projectID, statusKey string) *app_errors.AppError {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return err
+	}
+
+	// Removing the limit, the column itself stays part of the workflow
+	return s.repo.DeleteBoardColumn(ctx, projectID, statusKey)
+}
+
+func (s *AufgabenService) CreateMilestone(ctx context.Context, userID, projectID string, req *aufgaben_dto.CreateMilestoneRequest) (*aufgaben_dto.MilestoneItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister plans the milestones of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Milestone name must not be empty"))
+	}
+
+	startDate, endDate, err := parseMilestoneDates(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var goal *string
+	if req.Goal != nil {
+		if trimmed := strings.TrimSpace(*req.Goal); trimmed != "" {
+			goal = &trimmed
+		}
+	}
+
+	milestoneID, _ := uuid.NewV7()
+	milestone := &entity.MilestoneEntity{
+		ID:        milestoneID.String(),
+		ProjectID: projectID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+	}
+
+	// Call repo, name is unique per project
+	if err := s.repo.InsertMilestone(ctx, milestone); err != nil {
+		if err.Type == app_errors.ErrConflict {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_name_taken", err.Err)
+		}
+		return nil, err
+	}
+
+	return buildMilestoneItem(milestone), nil
+}
+
+func (s *AufgabenService) ListMilestones(ctx context.Context, userID, projectID string) ([]*aufgaben_dto.MilestoneItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	milestones, err := s.repo.ListMilestones(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.MilestoneItem, 0, len(milestones))
+	for i := range milestones {
+		data = append(data, buildMilestoneItem(&milestones[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) UpdateMilestone(ctx context.Context, userID, projectID, milestoneID string, req *aufgaben_dto.UpdateMilestoneRequest) (*aufgaben_dto.MilestoneItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister plans the milestones of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if milestone belongs to this project
+	milestone, err := s.getProjectMilestone(ctx, projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closed milestones are kept as they were for the burndown
+	if milestone.ClosedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Milestone name must not be empty"))
+		}
+		milestone.Name = name
+	}
+
+	if req.Goal != nil {
+		// Empty goal clears it
+		milestone.Goal = nil
+		if trimmed := strings.TrimSpace(*req.Goal); trimmed != "" {
+			milestone.Goal = &trimmed
+		}
+	}
+
+	// A single date is checked against the other stored one
+	if req.StartDate != nil || req.EndDate != nil {
+		startDate := milestone.StartDate.Format(time.DateOnly)
+		if req.StartDate != nil {
+			startDate = *req.StartDate
+		}
+		endDate := milestone.EndDate.Format(time.DateOnly)
+		if req.EndDate != nil {
+			endDate = *req.EndDate
+		}
+
+		start, end, err := parseMilestoneDates(startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		milestone.StartDate = start
+		milestone.EndDate = end
+	}
+
+	// Call repo
+	updatedAt, err := s.repo.UpdateMilestone(ctx, milestone)
+	if err != nil {
+		if err.Type == app_errors.ErrConflict && err.MessageKey != "conflict.milestone_closed" {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_name_taken", err.Err)
+		}
+		return nil, err
+	}
+	milestone.UpdatedAt = updatedAt
+
+	return buildMilestoneItem(milestone), nil
+}
+
+func (s *AufgabenService) CloseMilestone(ctx context.Context, userID, projectID, milestoneID string, req *aufgaben_dto.CloseMilestoneRequest) (*aufgaben_dto.CloseMilestoneResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister plans the milestones of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if milestone belongs to this project
+	milestone, err := s.getProjectMilestone(ctx, projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if milestone.ClosedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+	}
+
+	// Carry over target has to be another open milestone of the project
+	if req.CarryOverTo != nil {
+		if *req.CarryOverTo == milestoneID {
+			return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrInvalidBody, "request.invalid_body", fmt.Errorf("Milestone can not carry over into itself"))
+		}
+
+		target, err := s.getProjectMilestone(ctx, projectID, *req.CarryOverTo)
+		if err != nil {
+			return nil, err
+		}
+		if target.ClosedAt != nil {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+		}
+	}
+
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	closedAt, err := s.repo.CloseMilestone(ctx, tx, milestoneID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unfinished tasks leave the closed milestone, the event keeps them in its burndown up to the close
+	taskIDs, err := s.repo.CarryOverMilestoneTasks(ctx, tx, milestoneID, req.CarryOverTo)
+	if err != nil {
+		return nil, err
+	}
+
+	note := "Task carried over from closed milestone"
+	fieldName := "milestone"
+	for _, taskID := range taskIDs {
+		carryEvent := &entity.AddAssignment{
+			AufgabenID: taskID,
+			ActorID:    userID,
+			Action:     entity.ActionMilestoneChanged,
+			Note:       &note,
+			FieldName:  &fieldName,
+			OldValue:   &milestoneID,
+			NewValue:   req.CarryOverTo,
+		}
+
+		if _, err := s.createAndInsertEvent(ctx, tx, carryEvent); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	for _, taskID := range taskIDs {
+		s.invalidateTaskDetails(ctx, taskID)
+	}
+
+	if taskIDs == nil {
+		taskIDs = []string{}
+	}
+
+	// Build response
+	resp := &aufgaben_dto.CloseMilestoneResponse{
+		MilestoneID:      milestoneID,
+		ClosedAt:         *closedAt,
+		CarriedOverTo:    req.CarryOverTo,
+		CarriedOverTasks: taskIDs,
+	}
+
+	return resp, nil
+}
+
+func (s *AufgabenService) SetTaskMilestone(ctx context.Context, userID, projectID, taskID string, req *aufgaben_dto.SetTaskMilestoneRequest) (*aufgaben_dto.TaskMilestoneResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Only meister plans the milestones of a project
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	// Check if task exists
+	task, err := s.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTaskInProject(task, projectID); err != nil {
+		return nil, err
+	}
+
+	if task.ArchivedAt != nil {
+		return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.task_unavailable", nil)
+	}
+
+	if equalOptionalString(task.MilestoneID, req.MilestoneID) {
+		return nil, app_errors.NewAppError(fiber.StatusBadRequest, app_errors.ErrValidation, "request.no_changes", nil)
+	}
+
+	// Tasks can neither join nor leave a closed milestone, its burndown is final
+	if task.MilestoneID != nil {
+		current, err := s.repo.GetMilestoneByID(ctx, *task.MilestoneID)
+		if err != nil {
+			return nil, err
+		}
+		if current.ClosedAt != nil {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+		}
+	}
+
+	if req.MilestoneID != nil {
+		target, err := s.getProjectMilestone(ctx, projectID, *req.MilestoneID)
+		if err != nil {
+			return nil, err
+		}
+		if target.ClosedAt != nil {
+			return nil, app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict.milestone_closed", nil)
+		}
+	}
+
+	// Update milestone
+	tx, txErr := s.txManager.Begin(ctx)
+	if txErr != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", txErr)
+	}
+	defer tx.Rollback(ctx)
+
+	updatedAt, err := s.repo.SetTaskMilestone(ctx, tx, taskID, req.MilestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := "Task milestone updated"
+	fieldName := "milestone"
+	milestoneEvent := &entity.AddAssignment{
+		AufgabenID: taskID,
+		ActorID:    userID,
+		Action:     entity.ActionMilestoneChanged,
+		Note:       &note,
+		FieldName:  &fieldName,
+		OldValue:   task.MilestoneID,
+		NewValue:   req.MilestoneID,
+	}
+
+	if _, err := s.createAndInsertEvent(ctx, tx, milestoneEvent); err != nil {
+		return nil, err
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, app_errors.NewAppError(fiber.StatusInternalServerError, app_errors.ErrInternal, "internal_error", err)
+	}
+
+	s.invalidateTaskDetails(ctx, taskID)
+
+	// Build response
+	resp := &aufgaben_dto.TaskMilestoneResponse{
+		AufgabenID:  taskID,
+		MilestoneID: req.MilestoneID,
+		UpdatedAt:   *updatedAt,
+	}
 
 	return resp, nil
 }
+
+func (s *AufgabenService) GetMilestoneBurndown(ctx context.Context, userID, projectID, milestoneID string) (*aufgaben_dto.MilestoneBurndownResponse, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if milestone belongs to this project
+	milestone, err := s.getProjectMilestone(ctx, projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call repo
+	days, err := s.repo.GetMilestoneBurndown(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBurndownResponse(milestone, days), nil
+}
+
+func (s *AufgabenService) CreateCalendarFeed(ctx context.Context, userID, baseURL string) (*aufgaben_dto.CalendarFeedItem, *app_errors.AppError) {
+	// TODO
+	// Every user may have a personal feed of the tasks assigned to them, a new one replaces the old one
+	return s.issueCalendarFeed(ctx, userID, nil, baseURL)
+}
+
+func (s *AufgabenService) CreateProjectCalendarFeed(ctx context.Context, userID, projectID, baseURL string) (*aufgaben_dto.CalendarFeedItem, *app_errors.AppError) {
+	// TODO
+	// Check if performer is really project member or not
+	if err := s.verifyProjectMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Check if the performer has valid authority
+	if err := s.verifyUserRole(ctx, projectID, userID, entity.MEISTER); err != nil {
+		return nil, err
+	}
+
+	return s.issueCalendarFeed(ctx, userID, &projectID, baseURL)
+}
+
+func (s *AufgabenService) ListCalendarFeeds(ctx context.Context, userID string) ([]*aufgaben_dto.CalendarFeedItem, *app_errors.AppError) {
+	// TODO
+	// Call repo, only active feeds of the performer
+	feeds, err := s.repo.ListCalendarFeeds(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*aufgaben_dto.CalendarFeedItem, 0, len(feeds))
+	for i := range feeds {
+		data = append(data, buildCalendarFeedItem(&feeds[i]))
+	}
+
+	return data, nil
+}
+
+func (s *AufgabenService) RevokeCalendarFeed(ctx context.Context, userID, feedID string) *app_errors.AppError {
+	// TODO
+	// Call repo, a feed can only be revoked by the user it was issued to
+	return s.repo.RevokeCalendarFeed(ctx, feedID, userID)
+}
+
+func (s *AufgabenService) GetCalendarFeed(ctx context.Context, rawToken, baseURL string, query *aufgaben_dto.CalendarFeedQuery) ([]byte, *app_errors.AppError) {
+	// TODO
+	// Resolve the feed by its token, there is no session behind a calendar app
+	feed, err := s.repo.GetCalendarFeedByTokenHash(ctx, hashCalendarFeedToken(rawToken))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var name string
+	var tasks []calendarFeedTask
+	if feed.ProjectID == nil {
+		// Personal feed, the tasks assigned to the user across all projects
+		assigned, err := s.repo.ListAssignedTasks(ctx, feed.UserID, &aufgaben_dto.AssignedAufgabenFilter{Limit: calendarFeedLimit})
+		if err != nil {
+			return nil, err
+		}
+		if len(assigned) > calendarFeedLimit {
+			assigned = assigned[:calendarFeedLimit]
+		}
+
+		name = "Aufgaben Meister"
+		for _, task := range assigned {
+			tasks = append(tasks, calendarFeedTask{
+				ID:          task.ID,
+				ProjectID:   task.ProjectID,
+				ProjectName: task.ProjectName,
+				Title:       task.Title,
+				Description: task.Description,
+				Status:      task.Status,
+				Priority:    task.Priority,
+				DueDate:     task.DueDate,
+			})
+		}
+	} else {
+		// Project feed, the feed outlives a role change so the Meister role is checked on every fetch
+		if err := s.verifyProjectMember(ctx, *feed.ProjectID, feed.UserID); err != nil {
+			return nil, err
+		}
+		if err := s.verifyUserRole(ctx, *feed.ProjectID, feed.UserID, entity.MEISTER); err != nil {
+			return nil, err
+		}
+
+		dueAfter := now.AddDate(0, 0, -calendarFeedPastDays).Format("2006-01-02")
+		projectTasks, err := s.repo.ListTasks(ctx, *feed.ProjectID, &aufgaben_dto.AufgabenListFilter{
+			DueAfter: &dueAfter,
+			Sort:     []string{"due_date"},
+			Limit:    calendarFeedLimit,
+			Page:     1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(projectTasks) > calendarFeedLimit {
+			projectTasks = projectTasks[:calendarFeedLimit]
+		}
+
+		name = *feed.ProjectName
+		for _, task := range projectTasks {
+			if task.DueDate == nil {
+				continue
+			}
+			tasks = append(tasks, calendarFeedTask{
+				ID:          task.ID,
+				ProjectID:   *feed.ProjectID,
+				ProjectName: name,
+				Title:       task.Title,
+				Description: task.Description,
+				Status:      task.Status,
+				Priority:    task.Priority,
+				DueDate:     *task.DueDate,
+			})
+		}
+	}
+
+	// Last use only helps the user to spot stale feeds, it must not break the feed
+	if err := s.repo.TouchCalendarFeed(ctx, feed.ID); err != nil {
+		log.Warn().Err(err.Err).Msgf("failed to touch calendar feed %s", feed.ID)
+	}
+
+	component := "event"
+	if query.Component != nil {
+		component = *query.Component
+	}
+
+	return buildCalendarFeed(name, baseURL, component, tasks, now), nil
+}
@@ -0,0 +1,184 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Close a sprint and carry unfinished tasks into the next one
+func TestCloseMilestone_CarryOver(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	nextID := "ms-2"
+	closedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, nextID).Return(&entity.MilestoneEntity{ID: nextID, ProjectID: projectID, Name: "Sprint 2"}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("CloseMilestone", ctx, tx, milestoneID, userID).Return(&closedAt, (*app_errors.AppError)(nil))
+	repo.On("CarryOverMilestoneTasks", ctx, tx, milestoneID, &nextID).Return([]string{"task-1", "task-2"}, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionMilestoneChanged && *e.FieldName == "milestone" && *e.OldValue == milestoneID && *e.NewValue == nextID
+	})).Return((*app_errors.AppError)(nil)).Twice()
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CloseMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.CloseMilestoneRequest{CarryOverTo: &nextID})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, closedAt, resp.ClosedAt)
+	assert.Equal(t, &nextID, resp.CarriedOverTo)
+	assert.Equal(t, []string{"task-1", "task-2"}, resp.CarriedOverTasks)
+	assert.Equal(t, 2, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Without a target unfinished tasks go back to the backlog
+func TestCloseMilestone_ToBacklog(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	closedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("CloseMilestone", ctx, tx, milestoneID, userID).Return(&closedAt, (*app_errors.AppError)(nil))
+	repo.On("CarryOverMilestoneTasks", ctx, tx, milestoneID, (*string)(nil)).Return([]string{"task-1"}, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionMilestoneChanged && *e.OldValue == milestoneID && e.NewValue == nil
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CloseMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.CloseMilestoneRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Nil(t, resp.CarriedOverTo)
+	assert.Equal(t, []string{"task-1"}, resp.CarriedOverTasks)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 3: Carry over target is already closed
+func TestCloseMilestone_TargetClosed(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-2"
+	previousID := "ms-1"
+	closedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 2"}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, previousID).Return(&entity.MilestoneEntity{ID: previousID, ProjectID: projectID, Name: "Sprint 1", ClosedAt: &closedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CloseMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.CloseMilestoneRequest{CarryOverTo: &previousID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.milestone_closed", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+}
+
+// Test 4: Milestone can't carry over into itself
+func TestCloseMilestone_CarryOverIntoItself(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CloseMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.CloseMilestoneRequest{CarryOverTo: &milestoneID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,142 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func incidentTemplate(projectID string) *entity.TemplateEntity {
+	description := "- check logs of {{service}}\n- write summary"
+	offset := 24 * 60
+	estimate := 2.0
+	unit := entity.EstimateHours
+	return &entity.TemplateEntity{
+		ID:               "tpl-1",
+		ProjectID:        projectID,
+		Name:             "Incident review",
+		Title:            "Review {{ticket}} of {{service}}",
+		Description:      &description,
+		Priority:         entity.PriorityHigh,
+		DueOffsetMinutes: &offset,
+		Estimate:         &estimate,
+		EstimateUnit:     &unit,
+	}
+}
+
+// Test 1: Defaults of the template with filled placeholders
+func TestCreateAufgabenFromTemplate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, "tpl-1").Return(incidentTemplate(projectID), (*app_errors.AppError)(nil))
+	repo.On("InsertNewAufgaben", ctx, mock.MatchedBy(func(task *entity.AufgabenEntity) bool {
+		return task.Title == "Review INC-42 of billing" &&
+			*task.Description == "- check logs of billing\n- write summary" &&
+			task.Priority == entity.PriorityHigh &&
+			task.DueDate != nil && task.DueDate.After(time.Now().Add(23*time.Hour)) &&
+			*task.Estimate == 2 && *task.EstimateUnit == entity.EstimateHours
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateAufgabenFromTemplate(ctx, userID, projectID, "tpl-1", &aufgaben_dto.CreateFromTemplateRequest{
+		Variables: map[string]string{"ticket": "INC-42", "service": "billing"},
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "Review INC-42 of billing", resp.Title)
+	assert.Equal(t, string(entity.AufgabenTodo), resp.Status)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Overrides win over template defaults
+func TestCreateAufgabenFromTemplate_Overrides(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	assigneeID := "user-2"
+	title := "Hotfix review for {{date}}"
+	description := ""
+	priority := "Low"
+	dueDate := time.Now().Add(2 * time.Hour)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, "tpl-1").Return(incidentTemplate(projectID), (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, assigneeID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("InsertNewAufgaben", ctx, mock.MatchedBy(func(task *entity.AufgabenEntity) bool {
+		return task.Title == "Hotfix review for "+time.Now().Format(time.DateOnly) &&
+			task.Description == nil &&
+			task.Priority == entity.PriorityLow &&
+			task.DueDate.Equal(dueDate) &&
+			*task.AssigneeID == assigneeID
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateAufgabenFromTemplate(ctx, userID, projectID, "tpl-1", &aufgaben_dto.CreateFromTemplateRequest{
+		Title:       &title,
+		Description: &description,
+		Priority:    &priority,
+		DueDate:     &dueDate,
+		AssigneeID:  &assigneeID,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, &assigneeID, resp.AssigneeID)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Placeholder without value
+func TestCreateAufgabenFromTemplate_MissingVariable(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, "tpl-1").Return(incidentTemplate(projectID), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateAufgabenFromTemplate(ctx, userID, projectID, "tpl-1", &aufgaben_dto.CreateFromTemplateRequest{
+		Variables: map[string]string{"ticket": "INC-42"},
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Len(t, err.Details, 1)
+	assert.Equal(t, "variables.service", err.Details[0].Field)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertNewAufgaben", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,92 @@
+package aufgaben_case
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - the personal feed replaces the previous one, only the hash of the token is stored
+func TestCreateCalendarFeed_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	createdAt := time.Now()
+	var stored *entity.CalendarFeedEntity
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("RevokeActiveCalendarFeed", ctx, tx, userID, (*string)(nil)).Return((*app_errors.AppError)(nil))
+	repo.On("InsertCalendarFeed", ctx, tx, mock.MatchedBy(func(f *entity.CalendarFeedEntity) bool {
+		stored = f
+		return f.UserID == userID && f.ProjectID == nil && f.TokenHash != ""
+	})).Return(&entity.CalendarFeedEntity{ID: "feed-1", UserID: userID, CreatedAt: createdAt}, (*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateCalendarFeed(ctx, userID, "https://meister.example/")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "feed-1", resp.FeedID)
+	assert.Equal(t, "user", resp.Scope)
+	assert.NotNil(t, resp.URL)
+	assert.True(t, strings.HasPrefix(*resp.URL, "https://meister.example/api/v1/calendar/feed/"))
+	assert.True(t, strings.HasSuffix(*resp.URL, ".ics"))
+
+	rawToken := strings.TrimSuffix(strings.TrimPrefix(*resp.URL, "https://meister.example/api/v1/calendar/feed/"), ".ics")
+	assert.Len(t, rawToken, 32)
+	assert.Equal(t, hashCalendarFeedToken(rawToken), stored.TokenHash)
+	assert.NotContains(t, stored.TokenHash, rawToken)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Insert fails - nothing is committed
+func TestCreateCalendarFeed_InsertFails(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("RevokeActiveCalendarFeed", ctx, tx, userID, (*string)(nil)).Return((*app_errors.AppError)(nil))
+	repo.On("InsertCalendarFeed", ctx, tx, mock.Anything).Return((*entity.CalendarFeedEntity)(nil), app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateCalendarFeed(ctx, userID, "https://meister.example")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, app_errors.ErrConflict, err.Type)
+
+	repo.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
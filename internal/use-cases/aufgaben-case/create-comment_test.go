@@ -0,0 +1,195 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - mentioned member gets notified, author mention is ignored
+func TestCreateComment_SuccessWithMentions(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	projectName := "Project"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, ProjectName: &projectName, Title: "Deploy", Status: entity.AufgabenInProgress}
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	members := []entity.MentionedUser{
+		{UserID: userID, Username: "alice"},
+		{UserID: "user-2", Username: "bob"},
+	}
+	repo.On("FindMentionableMembers", ctx, projectID, []string{"bob", "alice"}).Return(members, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("InsertComment", ctx, tx, mock.MatchedBy(func(c *entity.AufgabenCommentEntity) bool {
+		return c.AufgabenID == taskID && c.AuthorID == userID && c.Body == "@Bob please review, cc @alice."
+	})).Return((*app_errors.AppError)(nil))
+
+	repo.On("InsertCommentMentions", ctx, tx, mock.Anything, []string{"user-2"}).Return([]string{"user-2"}, (*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueCommentMentionNotify", mock.MatchedBy(func(p *worker_task.CommentMentionNotify) bool {
+		return p.MentionedUserID == "user-2" && p.AuthorID == userID && p.ProjectName == projectName
+	})).Return(nil).Once()
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.ActorID == userID && p.Change == worker_task.WatcherChangeCommented && p.Detail == "@Bob please review, cc @alice."
+	})).Return(nil).Once()
+
+	// Execute
+	resp, err := service.CreateComment(ctx, userID, projectID, taskID, &aufgaben_dto.CreateCommentRequest{Body: "  @Bob please review, cc @alice.  "})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, taskID, resp.AufgabeID)
+	assert.Equal(t, []string{"bob"}, resp.Mentions)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 2: Comment without mentions only notifies watchers
+func TestCreateComment_SuccessWithoutMentions(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("InsertComment", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.Change == worker_task.WatcherChangeCommented
+	})).Return(nil).Once()
+
+	// Execute
+	resp, err := service.CreateComment(ctx, userID, projectID, taskID, &aufgaben_dto.CreateCommentRequest{Body: "mail me at bob@example.com"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Empty(t, resp.Mentions)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "FindMentionableMembers", mock.Anything, mock.Anything, mock.Anything)
+	taskQueue.AssertNotCalled(t, "EnqueueCommentMentionNotify", mock.Anything)
+}
+
+// Test 3: Performer is not a project member
+func TestCreateComment_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateComment(ctx, "user-1", "project-1", "task-1", &aufgaben_dto.CreateCommentRequest{Body: "Hello"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Archived task is closed for discussion
+func TestCreateComment_TaskArchived(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	archivedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, ArchivedAt: &archivedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateComment(ctx, userID, projectID, taskID, &aufgaben_dto.CreateCommentRequest{Body: "Hello"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_unavailable", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 5: Mention parsing
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected []string
+	}{
+		{body: "no mentions here", expected: nil},
+		{body: "@alice", expected: []string{"alice"}},
+		{body: "hey @Alice and @alice, also @bob_2.", expected: []string{"alice", "bob_2"}},
+		{body: "write to alice@example.com", expected: nil},
+		{body: "too short @ab", expected: nil},
+		{body: "(@carol) done", expected: []string{"carol"}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, parseMentions(tt.body), tt.body)
+	}
+}
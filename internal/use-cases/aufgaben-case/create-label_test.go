@@ -0,0 +1,103 @@
+package aufgaben_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestCreateLabel_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+	color := "#ff0000"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertLabel", ctx, mock.MatchedBy(func(l *entity.LabelEntity) bool {
+		return l.ProjectID == projectID && l.Name == "backend" && *l.Color == color
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateLabel(ctx, userID, projectID, &aufgaben_dto.CreateLabelRequest{Name: " backend ", Color: &color})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "backend", resp.Name)
+	assert.Equal(t, projectID, resp.ProjectID)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't manage labels
+func TestCreateLabel_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateLabel(ctx, userID, projectID, &aufgaben_dto.CreateLabelRequest{Name: "backend"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertLabel", mock.Anything, mock.Anything)
+}
+
+// Test 3: Duplicate name in the same project
+func TestCreateLabel_NameTaken(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertLabel", ctx, mock.Anything).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", errors.New("unique_violation")))
+
+	// Execute
+	resp, err := service.CreateLabel(ctx, userID, projectID, &aufgaben_dto.CreateLabelRequest{Name: "Backend"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.label_name_taken", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
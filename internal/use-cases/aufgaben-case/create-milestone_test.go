@@ -0,0 +1,148 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Meister plans a two week sprint
+func TestCreateMilestone_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	goal := " Ship the board "
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertMilestone", ctx, mock.MatchedBy(func(m *entity.MilestoneEntity) bool {
+		return m.Name == "Sprint 1" && *m.Goal == "Ship the board" && m.EndDate.Sub(m.StartDate).Hours() == 13*24 && m.ClosedAt == nil
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateMilestone(ctx, userID, projectID, &aufgaben_dto.CreateMilestoneRequest{
+		Name:      " Sprint 1 ",
+		Goal:      &goal,
+		StartDate: "2026-03-02",
+		EndDate:   "2026-03-15",
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "Sprint 1", resp.Name)
+	assert.Equal(t, "2026-03-02", resp.StartDate)
+	assert.Equal(t, "2026-03-15", resp.EndDate)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: End date before start date
+func TestCreateMilestone_InvalidDateRange(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateMilestone(ctx, userID, projectID, &aufgaben_dto.CreateMilestoneRequest{
+		Name:      "Sprint 1",
+		StartDate: "2026-03-15",
+		EndDate:   "2026-03-02",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_date_range", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertMilestone", mock.Anything, mock.Anything)
+}
+
+// Test 3: Name already used in the project
+func TestCreateMilestone_NameTaken(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertMilestone", ctx, mock.Anything).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", nil))
+
+	// Execute
+	resp, err := service.CreateMilestone(ctx, userID, projectID, &aufgaben_dto.CreateMilestoneRequest{
+		Name:      "Sprint 1",
+		StartDate: "2026-03-02",
+		EndDate:   "2026-03-15",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.milestone_name_taken", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Mitarbeiter can't plan milestones
+func TestCreateMilestone_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateMilestone(ctx, userID, projectID, &aufgaben_dto.CreateMilestoneRequest{
+		Name:      "Sprint 1",
+		StartDate: "2026-03-02",
+		EndDate:   "2026-03-15",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,84 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister gets a feed of the project
+func TestCreateProjectCalendarFeed_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("RevokeActiveCalendarFeed", ctx, tx, meisterID, &projectID).Return((*app_errors.AppError)(nil))
+	repo.On("InsertCalendarFeed", ctx, tx, mock.MatchedBy(func(f *entity.CalendarFeedEntity) bool {
+		return f.UserID == meisterID && f.ProjectID != nil && *f.ProjectID == projectID
+	})).Return(&entity.CalendarFeedEntity{ID: "feed-1", UserID: meisterID, ProjectID: &projectID, CreatedAt: time.Now()}, (*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateProjectCalendarFeed(ctx, meisterID, projectID, "https://meister.example")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "project", resp.Scope)
+	assert.Equal(t, &projectID, resp.ProjectID)
+	assert.NotNil(t, resp.URL)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't get a project feed
+func TestCreateProjectCalendarFeed_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateProjectCalendarFeed(ctx, userID, projectID, "https://meister.example")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+}
@@ -0,0 +1,115 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Meister creates a weekly chore
+func TestCreateRecurrence_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	assigneeID := "user-2"
+	offset := 24 * 60
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, assigneeID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("InsertRecurrence", ctx, mock.MatchedBy(func(r *entity.RecurrenceEntity) bool {
+		return r.Title == "Clean the kitchen" && r.CronExpr == "0 9 * * 1" && r.Active && r.NextRunAt.After(time.Now()) && r.NextRunAt.Weekday() == time.Monday
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateRecurrence(ctx, userID, projectID, &aufgaben_dto.CreateRecurrenceRequest{
+		Title:             "  Clean the kitchen ",
+		DefaultAssigneeID: &assigneeID,
+		DueOffsetMinutes:  &offset,
+		CronExpr:          "0 9 * * 1",
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "Medium", resp.Priority)
+	assert.Equal(t, &assigneeID, resp.DefaultAssigneeID)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Invalid cron expression
+func TestCreateRecurrence_InvalidCron(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateRecurrence(ctx, userID, projectID, &aufgaben_dto.CreateRecurrenceRequest{
+		Title:    "Monthly report",
+		CronExpr: "every monday",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_cron_expr", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertRecurrence", mock.Anything, mock.Anything)
+}
+
+// Test 3: Mitarbeiter can't create recurring tasks
+func TestCreateRecurrence_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateRecurrence(ctx, userID, projectID, &aufgaben_dto.CreateRecurrenceRequest{
+		Title:    "Monthly report",
+		CronExpr: "@monthly",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
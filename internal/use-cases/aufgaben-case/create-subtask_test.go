@@ -0,0 +1,191 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - subtask is created and parent event is recorded
+func TestCreateSubtask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	req := &aufgaben_dto.CreateNewAufgabenRequest{
+		Title: "Write migration",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	parent := &entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: projectID,
+		Title:     "Parent Task",
+		Status:    entity.AufgabenInProgress,
+		Priority:  entity.PriorityHigh,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(parent, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+
+	repo.On("InsertNewAufgabenWithTx", ctx, tx, mock.MatchedBy(func(task *entity.AufgabenEntity) bool {
+		return task.ParentID != nil && *task.ParentID == taskID && task.Status == entity.AufgabenTodo
+	})).Return((*app_errors.AppError)(nil))
+
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == taskID && e.Action == entity.ActionSubtaskCreated
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateSubtask(ctx, userID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, req.Title, resp.Title)
+	assert.Equal(t, taskID, *resp.ParentID)
+	// priority is inherited from parent when not given
+	assert.Equal(t, string(entity.PriorityHigh), resp.Priority)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
+
+// Test 2: Parent is already done
+func TestCreateSubtask_ParentDone(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	completedAt := time.Now()
+	parent := &entity.AufgabenEntity{
+		ID:          taskID,
+		ProjectID:   projectID,
+		Status:      entity.AufgabenDone,
+		CompletedAt: &completedAt,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(parent, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateSubtask(ctx, userID, projectID, taskID, &aufgaben_dto.CreateNewAufgabenRequest{Title: "Late step"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_is_archive_or_done", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Parent belongs to another project
+func TestCreateSubtask_ParentInOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	parent := &entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: "project-2",
+		Status:    entity.AufgabenTodo,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(parent, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateSubtask(ctx, userID, projectID, taskID, &aufgaben_dto.CreateNewAufgabenRequest{Title: "Step"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "task_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Assignee is not a project member
+func TestCreateSubtask_AssigneeNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	outsiderID := "user-9"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, outsiderID).Return(false, (*app_errors.AppError)(nil))
+
+	parent := &entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: projectID,
+		Status:    entity.AufgabenTodo,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(parent, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateSubtask(ctx, userID, projectID, taskID, &aufgaben_dto.CreateNewAufgabenRequest{
+		Title:      "Step",
+		AssigneeID: &outsiderID,
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, app_errors.ErrForbidden, err.Type)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,118 @@
+package aufgaben_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Meister saves a template with placeholders
+func TestCreateTemplate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+	description := "- check logs of {{service}}\n- write summary for {{date}}"
+	priority := "High"
+	estimate := 2.0
+	unit := "Hours"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertTemplate", ctx, mock.MatchedBy(func(tpl *entity.TemplateEntity) bool {
+		return tpl.Name == "Incident review" && tpl.Priority == entity.PriorityHigh && *tpl.EstimateUnit == entity.EstimateHours
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateTemplate(ctx, userID, projectID, &aufgaben_dto.SaveTemplateRequest{
+		Name:         " Incident review ",
+		Title:        "Review incident {{ticket}} of {{service}}",
+		Description:  &description,
+		Priority:     &priority,
+		Estimate:     &estimate,
+		EstimateUnit: &unit,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "Incident review", resp.Name)
+	assert.Equal(t, []string{"ticket", "service", "date"}, resp.Variables)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Name already used in the project
+func TestCreateTemplate_NameTaken(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("InsertTemplate", ctx, mock.AnythingOfType("*entity.TemplateEntity")).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", errors.New("duplicate key")))
+
+	// Execute
+	resp, err := service.CreateTemplate(ctx, userID, projectID, &aufgaben_dto.SaveTemplateRequest{
+		Name:  "Release",
+		Title: "Release {{version}}",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.template_name_taken", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't manage templates
+func TestCreateTemplate_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateTemplate(ctx, userID, projectID, &aufgaben_dto.SaveTemplateRequest{
+		Name:  "Release",
+		Title: "Release {{version}}",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertTemplate", mock.Anything, mock.Anything)
+}
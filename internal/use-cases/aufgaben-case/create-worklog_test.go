@@ -0,0 +1,103 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Member books time manually
+func TestCreateWorklog_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	startedAt := time.Now().Add(-3 * time.Hour).Truncate(time.Minute)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone}, (*app_errors.AppError)(nil))
+	repo.On("InsertWorklog", ctx, mock.MatchedBy(func(w *entity.WorklogEntity) bool {
+		return w.Source == entity.WorklogManual && w.EndedAt != nil && w.EndedAt.Sub(w.StartedAt) == 45*time.Minute
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateWorklog(ctx, userID, projectID, taskID, &aufgaben_dto.CreateWorklogRequest{StartedAt: startedAt, DurationMinutes: 45})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 45, *resp.DurationMinutes)
+	assert.Equal(t, "Manual", resp.Source)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Worklog can't end in the future
+func TestCreateWorklog_InFuture(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateWorklog(ctx, userID, projectID, taskID, &aufgaben_dto.CreateWorklogRequest{StartedAt: time.Now().Add(-10 * time.Minute), DurationMinutes: 60})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.worklog_in_future", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertWorklog", mock.Anything, mock.Anything)
+}
+
+// Test 3: Archived tasks don't accept worklogs
+func TestCreateWorklog_TaskArchived(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	archivedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, ArchivedAt: &archivedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.CreateWorklog(ctx, userID, projectID, taskID, &aufgaben_dto.CreateWorklogRequest{StartedAt: time.Now().Add(-2 * time.Hour), DurationMinutes: 30})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "conflict.task_unavailable", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,100 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Meister deletes an attachment of someone else
+func TestDeleteAttachment_Meister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	var deletedKey string
+	storage := &use_cases.MockStorage{
+		DeleteFn: func(ctx context.Context, key string) error {
+			deletedKey = key
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		storage:   storage,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	attachmentID := "att-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetAttachmentByID", ctx, attachmentID).Return(&entity.AttachmentEntity{ID: attachmentID, AufgabenID: taskID, FileName: "spec.pdf", StorageKey: "project-1/att-1", UploadedBy: "user-2"}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("DeleteAttachment", ctx, tx, attachmentID).Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionAttachmentRemoved && *e.Note == "Attachment: spec.pdf"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteAttachment(ctx, userID, projectID, taskID, attachmentID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "project-1/att-1", deletedKey)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't delete attachments of others
+func TestDeleteAttachment_NotUploader(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	storage := &use_cases.MockStorage{}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		storage:   storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	attachmentID := "att-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetAttachmentByID", ctx, attachmentID).Return(&entity.AttachmentEntity{ID: attachmentID, AufgabenID: taskID, UploadedBy: "user-2"}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteAttachment(ctx, userID, projectID, taskID, attachmentID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, 0, storage.DeleteCalled)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
@@ -0,0 +1,92 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister removes the WIP limit of a column
+func TestDeleteBoardColumn_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteBoardColumn", ctx, projectID, "In_Progress").Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteBoardColumn(ctx, meisterID, projectID, "In_Progress")
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Column without limit returns not found
+func TestDeleteBoardColumn_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteBoardColumn", ctx, projectID, "Todo").Return(app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "board_column_not_found", nil))
+
+	// Execute
+	err := service.DeleteBoardColumn(ctx, meisterID, projectID, "Todo")
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't remove WIP limits
+func TestDeleteBoardColumn_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteBoardColumn(ctx, userID, projectID, "Todo")
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteBoardColumn", mock.Anything, mock.Anything, mock.Anything)
+}
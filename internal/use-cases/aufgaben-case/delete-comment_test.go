@@ -0,0 +1,102 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Author deletes own comment
+func TestDeleteComment_ByAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetCommentByID", ctx, commentID).Return(&entity.AufgabenCommentEntity{ID: commentID, AufgabenID: taskID, AuthorID: userID}, (*app_errors.AppError)(nil))
+	repo.On("SoftDeleteComment", ctx, commentID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteComment(ctx, userID, projectID, taskID, commentID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetUserRole", ctx, projectID, userID)
+}
+
+// Test 2: Meister may delete comments of others
+func TestDeleteComment_ByMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetCommentByID", ctx, commentID).Return(&entity.AufgabenCommentEntity{ID: commentID, AufgabenID: taskID, AuthorID: "user-2"}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("SoftDeleteComment", ctx, commentID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteComment(ctx, userID, projectID, taskID, commentID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't delete comments of others
+func TestDeleteComment_Forbidden(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetCommentByID", ctx, commentID).Return(&entity.AufgabenCommentEntity{ID: commentID, AufgabenID: taskID, AuthorID: "user-2"}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteComment(ctx, userID, projectID, taskID, commentID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "SoftDeleteComment", ctx, commentID)
+}
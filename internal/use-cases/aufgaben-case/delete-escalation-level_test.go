@@ -0,0 +1,92 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister removes a level
+func TestDeleteEscalationLevel_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteEscalationLevel", ctx, projectID, 2).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteEscalationLevel(ctx, meisterID, projectID, 2)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Deleting an unknown level returns not found
+func TestDeleteEscalationLevel_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteEscalationLevel", ctx, projectID, 5).Return(app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "escalation_level_not_found", nil))
+
+	// Execute
+	err := service.DeleteEscalationLevel(ctx, meisterID, projectID, 5)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't remove levels
+func TestDeleteEscalationLevel_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteEscalationLevel(ctx, userID, projectID, 1)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteEscalationLevel", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,58 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - every labelled task gets a removal event
+func TestDeleteLabel_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	labelID := "label-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetLabelByID", ctx, labelID).Return(&entity.LabelEntity{ID: labelID, ProjectID: projectID, Name: "bug"}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("DeleteLabel", ctx, tx, labelID).Return([]string{"task-1", "task-2"}, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionLabelRemoved
+	})).Return((*app_errors.AppError)(nil)).Twice()
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteLabel(ctx, userID, projectID, labelID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
@@ -0,0 +1,67 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Meister deletes a recurring task
+func TestDeleteRecurrence_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	recurrenceID := "rec-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetRecurrenceByID", ctx, recurrenceID).Return(&entity.RecurrenceEntity{ID: recurrenceID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("DeleteRecurrence", ctx, recurrenceID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteRecurrence(ctx, userID, projectID, recurrenceID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't delete recurring tasks
+func TestDeleteRecurrence_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	recurrenceID := "rec-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteRecurrence(ctx, userID, projectID, recurrenceID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteRecurrence", ctx, recurrenceID)
+}
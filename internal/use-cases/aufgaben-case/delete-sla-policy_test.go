@@ -0,0 +1,92 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister removes the SLA of a priority
+func TestDeleteSLAPolicy_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteSLAPolicy", ctx, projectID, entity.PriorityMedium).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteSLAPolicy(ctx, meisterID, projectID, string(entity.PriorityMedium))
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Deleting a priority without policy returns not found
+func TestDeleteSLAPolicy_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("DeleteSLAPolicy", ctx, projectID, entity.PriorityLow).Return(app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "not_found.sla_policy_not_found", nil))
+
+	// Execute
+	err := service.DeleteSLAPolicy(ctx, meisterID, projectID, string(entity.PriorityLow))
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't remove SLA policies
+func TestDeleteSLAPolicy_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteSLAPolicy(ctx, userID, projectID, string(entity.PriorityUrgent))
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteSLAPolicy", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,68 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Meister deletes a template
+func TestDeleteTemplate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	templateID := "tpl-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, templateID).Return(&entity.TemplateEntity{ID: templateID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("DeleteTemplate", ctx, templateID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteTemplate(ctx, userID, projectID, templateID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Template not found
+func TestDeleteTemplate_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	templateID := "tpl-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, templateID).Return((*entity.TemplateEntity)(nil), app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "template_not_found", nil))
+
+	// Execute
+	err := service.DeleteTemplate(ctx, userID, projectID, templateID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteTemplate", ctx, templateID)
+}
@@ -0,0 +1,100 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Author deletes own worklog
+func TestDeleteWorklog_ByAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: userID}, (*app_errors.AppError)(nil))
+	repo.On("DeleteWorklog", ctx, worklogID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteWorklog(ctx, userID, projectID, taskID, worklogID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetUserRole", ctx, projectID, userID)
+}
+
+// Test 2: Mitarbeiter can't delete worklogs of others
+func TestDeleteWorklog_Forbidden(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: "user-2"}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteWorklog(ctx, userID, projectID, taskID, worklogID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteWorklog", ctx, worklogID)
+}
+
+// Test 3: Worklog of another task
+func TestDeleteWorklog_WrongTask(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: "task-2", UserID: userID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.DeleteWorklog(ctx, userID, projectID, taskID, worklogID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "worklog_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
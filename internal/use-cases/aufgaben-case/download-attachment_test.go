@@ -0,0 +1,84 @@
+package aufgaben_case
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path
+func TestDownloadAttachment_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	storage := &use_cases.MockStorage{
+		OpenFn: func(ctx context.Context, key string) (io.ReadCloser, *app_errors.AppError) {
+			return io.NopCloser(strings.NewReader("spec content")), nil
+		},
+	}
+	service := &AufgabenService{
+		repo:    repo,
+		storage: storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	attachmentID := "att-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetAttachmentByID", ctx, attachmentID).Return(&entity.AttachmentEntity{ID: attachmentID, AufgabenID: taskID, FileName: "spec.txt", ContentType: "text/plain", SizeBytes: 12, StorageKey: "project-1/att-1"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	item, content, err := service.DownloadAttachment(ctx, userID, projectID, taskID, attachmentID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "spec.txt", item.FileName)
+	data, _ := io.ReadAll(content)
+	assert.Equal(t, "spec content", string(data))
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Attachment belongs to another task
+func TestDownloadAttachment_OtherTask(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	storage := &use_cases.MockStorage{}
+	service := &AufgabenService{
+		repo:    repo,
+		storage: storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	attachmentID := "att-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetAttachmentByID", ctx, attachmentID).Return(&entity.AttachmentEntity{ID: attachmentID, AufgabenID: "task-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	item, content, err := service.DownloadAttachment(ctx, userID, projectID, taskID, attachmentID)
+
+	// Assert
+	assert.Nil(t, item)
+	assert.Nil(t, content)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "attachment_not_found", err.MessageKey)
+	assert.Equal(t, 0, storage.OpenCalled)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,134 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - only newly mentioned users are notified
+func TestEditComment_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress}, (*app_errors.AppError)(nil))
+
+	comment := &entity.AufgabenCommentEntity{ID: commentID, AufgabenID: taskID, AuthorID: userID, Body: "@bob check", CreatedAt: time.Now()}
+	repo.On("GetCommentByID", ctx, commentID).Return(comment, (*app_errors.AppError)(nil))
+
+	members := []entity.MentionedUser{
+		{UserID: "user-2", Username: "bob"},
+		{UserID: "user-3", Username: "carol"},
+	}
+	repo.On("FindMentionableMembers", ctx, projectID, []string{"bob", "carol"}).Return(members, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	editedAt := time.Now()
+	repo.On("UpdateCommentBody", ctx, tx, commentID, "@bob check with @carol").Return(&editedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertCommentMentions", ctx, tx, commentID, []string{"user-2", "user-3"}).Return([]string{"user-3"}, (*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueCommentMentionNotify", mock.Anything).Return(nil).Once()
+
+	// Execute
+	resp, err := service.EditComment(ctx, userID, projectID, taskID, commentID, &aufgaben_dto.UpdateCommentRequest{Body: "@bob check with @carol"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "@bob check with @carol", resp.Body)
+	assert.Equal(t, &editedAt, resp.EditedAt)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 2: Only the author may edit
+func TestEditComment_NotAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetCommentByID", ctx, commentID).Return(&entity.AufgabenCommentEntity{ID: commentID, AufgabenID: taskID, AuthorID: "user-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.EditComment(ctx, userID, projectID, taskID, commentID, &aufgaben_dto.UpdateCommentRequest{Body: "changed"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden.not_comment_author", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 3: Comment belongs to another task
+func TestEditComment_CommentOfOtherTask(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	commentID := "comment-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetCommentByID", ctx, commentID).Return(&entity.AufgabenCommentEntity{ID: commentID, AufgabenID: "task-2", AuthorID: userID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.EditComment(ctx, userID, projectID, taskID, commentID, &aufgaben_dto.UpdateCommentRequest{Body: "changed"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "comment_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
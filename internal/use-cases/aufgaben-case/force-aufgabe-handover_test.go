@@ -9,6 +9,7 @@ import (
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,9 +22,11 @@ func TestForceAufgabeHandover_Success(t *testing.T) {
 	repo := new(MockAufgabenRepo)
 	txManager := new(use_cases.MockTxManager)
 	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
 	service := &AufgabenService{
 		repo:      repo,
 		txManager: txManager,
+		taskQueue: taskQueue,
 	}
 
 	meisterID := "meister-1"
@@ -61,6 +64,8 @@ func TestForceAufgabeHandover_Success(t *testing.T) {
 	// verifyUserRole - must be MEISTER
 	meisterRole := entity.MEISTER
 	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+	// workload warning turned off for this project
+	repo.On("GetWorkloadThreshold", ctx, projectID).Return(0, (*app_errors.AppError)(nil))
 
 	// Transaction
 	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
@@ -81,6 +86,10 @@ func TestForceAufgabeHandover_Success(t *testing.T) {
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
 	// Commit
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeAssigned
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
 	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
@@ -96,10 +105,12 @@ func TestForceAufgabeHandover_Success(t *testing.T) {
 	assert.Equal(t, string(entity.ActionHandoverExecute), resp.Action)
 	assert.Contains(t, resp.Note, currentAssignee)
 	assert.Contains(t, resp.Note, targetID)
+	assert.Nil(t, resp.WorkloadWarning)
 
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
 	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
 }
 
 // Test 2: User is not a project member
@@ -357,3 +368,86 @@ func TestForceAufgabeHandover_TargetSameAsCurrentAssignee(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test 7: Handover goes through but warns about an overloaded target
+func TestForceAufgabeHandover_TargetOverloaded(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	currentAssignee := "user-1"
+	targetID := "user-2"
+
+	req := &aufgaben_dto.ForceAufgabeHandoverRequest{
+		TargetID:   targetID,
+		Reason:     "Better suited for this task",
+		ReasonCode: "Other",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+
+	dueDate := time.Now().Add(48 * time.Hour)
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		Title:      "Test Task",
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: &currentAssignee,
+		DueDate:    &dueDate,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	// 6 open + 2*2 overdue + 3*1 overload = 13, above the threshold of 10
+	repo.On("GetWorkloadThreshold", ctx, projectID).Return(10, (*app_errors.AppError)(nil))
+	repo.On("ListMemberWorkloads", ctx, projectID, &targetID).Return([]entity.MemberWorkload{
+		{UserID: targetID, Username: "ben", Role: entity.MITARBEITER, OpenAssignments: 6, OverdueCount: 2, RecentOverloads: 1},
+	}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+
+	repo.On("AssignTask", ctx, tx, projectID, taskID, targetID, task.DueDate).Return(&entity.AssignTaskEntity{
+		ID:         taskID,
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: targetID,
+		CreatedBy:  meisterID,
+		DueDate:    dueDate,
+	}, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.Anything).Return(nil)
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForceAufgabeHandover(ctx, meisterID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, &targetID, resp.NewAssigneeID)
+	assert.NotNil(t, resp.WorkloadWarning)
+	assert.Equal(t, targetID, resp.WorkloadWarning.UserID)
+	assert.Equal(t, 13.0, resp.WorkloadWarning.LoadScore)
+	assert.Equal(t, 10, resp.WorkloadWarning.Threshold)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
@@ -67,6 +67,10 @@ func TestForceUnassignTask_Success(t *testing.T) {
 		AssigneeID: targetID,
 	}
 
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "Todo").Return((*app_errors.AppError)(nil))
+
 	repo.On("UnassignTask", ctx, tx, mock.MatchedBy(func(m *entity.UnassignTaskEntity) bool {
 		return m.AssigneeID == unassignModel.AssigneeID
 	})).Return(entity.AufgabenTodo, (*app_errors.AppError)(nil))
@@ -8,6 +8,7 @@ import (
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -19,9 +20,11 @@ func TestForwardProgressTask_Success(t *testing.T) {
 	repo := new(MockAufgabenRepo)
 	txManager := new(use_cases.MockTxManager)
 	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
 	service := &AufgabenService{
 		repo:      repo,
 		txManager: txManager,
+		taskQueue: taskQueue,
 	}
 
 	userID := "user-1"
@@ -44,6 +47,11 @@ func TestForwardProgressTask_Success(t *testing.T) {
 
 	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
 
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+
 	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
 
 	completedAt := time.Now()
@@ -56,11 +64,22 @@ func TestForwardProgressTask_Success(t *testing.T) {
 		CompletedAt: completedAt,
 	}
 
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "Done").Return((*app_errors.AppError)(nil))
+
 	repo.On("ForwardProgress", ctx, tx, taskID).Return(completedTask, (*app_errors.AppError)(nil))
 
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
+	repo.On("ListUnblockedDependents", ctx, tx, taskID).Return([]entity.UnblockedAufgaben{}, (*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeCompleted
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
 	// Execute
 	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
@@ -75,6 +94,7 @@ func TestForwardProgressTask_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
 	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
 }
 
 // Test User is not a project member
@@ -225,6 +245,8 @@ func TestForwardProgressTask_NotTaskAssignee(t *testing.T) {
 	}
 
 	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	// Not a collaborator or reviewer either
+	repo.On("GetTaskAssigneeRole", ctx, taskID, userID).Return((*entity.AssigneeRole)(nil), (*app_errors.AppError)(nil))
 
 	// Execute
 	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
@@ -268,6 +290,7 @@ func TestForwardProgressTask_TaskNotAssigned(t *testing.T) {
 	}
 
 	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetTaskAssigneeRole", ctx, taskID, userID).Return((*entity.AssigneeRole)(nil), (*app_errors.AppError)(nil))
 
 	// Execute
 	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
@@ -281,3 +304,381 @@ func TestForwardProgressTask_TaskNotAssigned(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test 7: Parent task still has open subtasks
+func TestForwardProgressTask_SubtasksStillOpen(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		Title:      "Parent Task",
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: &userID,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{Total: 3, Done: 2}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, app_errors.ErrConflict, err.Type)
+	assert.Equal(t, "conflict.subtasks_still_open", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 8: Completing a subtask records an event on the parent
+func TestForwardProgressTask_SubtaskCompletedEmitsParentEvent(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "subtask-1"
+	parentID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		ParentID:   &parentID,
+		Title:      "Subtask",
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityLow,
+		AssigneeID: &userID,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+
+	completedTask := &entity.CompleteTaskEntity{
+		ID:          taskID,
+		Status:      entity.AufgabenDone,
+		Priority:    entity.PriorityLow,
+		AssigneeID:  userID,
+		CreatedBy:   "creator-1",
+		CompletedAt: time.Now(),
+	}
+
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "Done").Return((*app_errors.AppError)(nil))
+
+	repo.On("ForwardProgress", ctx, tx, taskID).Return(completedTask, (*app_errors.AppError)(nil))
+
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == taskID && e.Action == entity.ActionComplete
+	})).Return((*app_errors.AppError)(nil)).Once()
+
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == parentID && e.Action == entity.ActionSubtaskComplete
+	})).Return((*app_errors.AppError)(nil)).Once()
+
+	repo.On("ListUnblockedDependents", ctx, tx, taskID).Return([]entity.UnblockedAufgaben{}, (*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeCompleted
+	})).Return(nil)
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, string(entity.AufgabenDone), resp.Status)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 9: Task is still blocked by an open dependency
+func TestForwardProgressTask_TaskBlocked(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		Title:      "Blocked Task",
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: &userID,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(1, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, app_errors.ErrConflict, err.Type)
+	assert.Equal(t, "conflict.task_blocked", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ForwardProgress", mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
+
+// Test 10: Completing a blocker notifies assignees of unblocked dependents
+func TestForwardProgressTask_NotifiesUnblockedDependents(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	dependentAssignee := "user-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		ProjectID:  projectID,
+		Title:      "Blocker Task",
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: &userID,
+	}
+
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+
+	completedTask := &entity.CompleteTaskEntity{
+		ID:          taskID,
+		Status:      entity.AufgabenDone,
+		Priority:    entity.PriorityHigh,
+		AssigneeID:  userID,
+		CreatedBy:   "creator-1",
+		CompletedAt: time.Now(),
+	}
+
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "Done").Return((*app_errors.AppError)(nil))
+
+	repo.On("ForwardProgress", ctx, tx, taskID).Return(completedTask, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+
+	unblocked := []entity.UnblockedAufgaben{
+		{ID: "task-2", ProjectID: projectID, ProjectName: "Project", Title: "Dependent Task", AssigneeID: &dependentAssignee},
+		{ID: "task-3", ProjectID: projectID, ProjectName: "Project", Title: "Unassigned Dependent", AssigneeID: nil},
+	}
+	repo.On("ListUnblockedDependents", ctx, tx, taskID).Return(unblocked, (*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeCompleted
+	})).Return(nil)
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueDependencyUnblockedNotify", mock.MatchedBy(func(p *worker_task.DependencyUnblockedNotify) bool {
+		return p.AufgabeID == "task-2" && p.AssigneeID == dependentAssignee && p.UnblockedByID == taskID
+	})).Return(nil).Once()
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, string(entity.AufgabenDone), resp.Status)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 11: Completing from review is restricted to Meister
+func TestForwardProgressTask_WorkflowRoleDenied(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	reviewKey := "In_Review"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID, WorkflowStatus: &reviewKey}, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	mitarbeiterRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&mitarbeiterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 403, err.Code)
+	assert.Equal(t, "forbidden.transition_role", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 12: Completing straight from In_Progress skips the review
+func TestForwardProgressTask_WorkflowSkipsReview(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, "conflict.transition_not_allowed", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 13: A failure inside the transaction rolls it back instead of leaking it
+func TestForwardProgressTask_RollbackOnFailure(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}, (*app_errors.AppError)(nil))
+	repo.On("LockTask", ctx, tx, taskID).Return((*app_errors.AppError)(nil))
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("CountOpenBlockers", ctx, tx, taskID).Return(0, (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("ForwardProgress", ctx, tx, taskID).Return(&entity.CompleteTaskEntity{ID: taskID, Status: entity.AufgabenDone}, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "Done").Return(app_errors.NewAppError(500, app_errors.ErrInternal, "internal_error", nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ForwardProgressTask(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.Code)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
@@ -113,15 +113,63 @@ func TestGetAufgabeDetails_CacheMiss(t *testing.T) {
 	}
 
 	repo.On("GetTaskByID", ctx, taskID).Return((*entity.AufgabenEntity)(r), (*app_errors.AppError)(nil))
+	repo.On("GetSubtaskProgress", ctx, taskID).Return(&entity.SubtaskProgress{Total: 4, Done: 1}, (*app_errors.AppError)(nil))
+	repo.On("ListLabelsForTask", ctx, taskID).Return([]entity.LabelEntity{
+		{ID: "label-1", ProjectID: projectID, Name: "backend"},
+	}, (*app_errors.AppError)(nil))
 
 	resp, err := service.GetAufgabeDetails(ctx, userID, projectID, taskID)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "Test Task", resp.Title)
+	assert.NotNil(t, resp.Subtasks)
+	assert.Equal(t, 4, resp.Subtasks.Total)
+	assert.Equal(t, 1, resp.Subtasks.Done)
+	assert.Equal(t, 25, resp.Subtasks.Percent)
+	assert.Len(t, resp.Labels, 1)
+	assert.Equal(t, "backend", resp.Labels[0].Name)
 
 	assert.Equal(t, 1, cache.GetCalled)
 	assert.Equal(t, 1, cache.SetCalled)
 
 	repo.AssertExpectations(t)
 }
+
+// Test Cache hit - task went overdue after it was cached
+func TestGetAufgabeDetails_CacheHitOverdue(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAufgabenRepo)
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	dueDate := time.Now().Add(-time.Hour)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	cache := &use_cases.MockCache{
+		GetFn: func(ctx context.Context, key string) (*any, *app_errors.AppError) {
+			var v any = &aufgaben_dto.AufgabenItem{
+				AufgabenID: "task-1",
+				Title:      "Cache Task",
+				Status:     string(entity.AufgabenInProgress),
+				DueDate:    &dueDate,
+				IsOverdue:  false,
+			}
+			return &v, nil
+		},
+	}
+
+	service := &AufgabenService{repo: repo, cache: cache}
+
+	resp, err := service.GetAufgabeDetails(ctx, userID, projectID, taskID)
+
+	assert.Nil(t, err)
+	assert.True(t, resp.IsOverdue)
+	assert.NotNil(t, resp.OverdueSince)
+	assert.GreaterOrEqual(t, *resp.OverdueSince, int64(60*60))
+	assert.Equal(t, 1, cache.GetCalled)
+
+	repo.AssertExpectations(t)
+}
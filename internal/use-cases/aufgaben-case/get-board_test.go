@@ -0,0 +1,96 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - tasks are grouped into the workflow columns in board order
+func TestGetBoard_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	inReview := "In_Review"
+	stale := "Removed_Status"
+	limit := 3
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	repo.On("ListBoardColumns", ctx, projectID).Return([]entity.BoardColumnEntity{
+		{ProjectID: projectID, StatusKey: "In_Review", WIPLimit: limit},
+	}, (*app_errors.AppError)(nil))
+	repo.On("ListBoardTasks", ctx, projectID, (*entity.AufgabenStatus)(nil)).Return([]entity.BoardTaskEntity{
+		{ID: "task-1", Status: entity.AufgabenTodo, BoardRank: "512"},
+		{ID: "task-2", Status: entity.AufgabenInProgress, WorkflowStatus: &inReview, BoardRank: "1024"},
+		{ID: "task-3", Status: entity.AufgabenInProgress, WorkflowStatus: &stale, BoardRank: "1536"},
+		{ID: "task-4", Status: entity.AufgabenTodo, BoardRank: "2048"},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetBoard(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, projectID, resp.ProjectID)
+	assert.Len(t, resp.Columns, 4)
+
+	// Todo keeps the board order
+	assert.Equal(t, "Todo", resp.Columns[0].Key)
+	assert.Equal(t, 2, resp.Columns[0].Count)
+	assert.Equal(t, "task-1", resp.Columns[0].Tasks[0].AufgabenID)
+	assert.Equal(t, "task-4", resp.Columns[0].Tasks[1].AufgabenID)
+
+	// A stale workflow status falls back to the first column of its category
+	assert.Equal(t, "In_Progress", resp.Columns[1].Key)
+	assert.Equal(t, "task-3", resp.Columns[1].Tasks[0].AufgabenID)
+	assert.Nil(t, resp.Columns[1].WIPLimit)
+
+	assert.Equal(t, "In_Review", resp.Columns[2].Key)
+	assert.Equal(t, limit, *resp.Columns[2].WIPLimit)
+	assert.Equal(t, "task-2", resp.Columns[2].Tasks[0].AufgabenID)
+
+	// Empty columns are still part of the board
+	assert.Equal(t, "Done", resp.Columns[3].Key)
+	assert.Equal(t, 0, resp.Columns[3].Count)
+	assert.NotNil(t, resp.Columns[3].Tasks)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Non member can't see the board
+func TestGetBoard_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "outsider"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetBoard(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListBoardTasks", mock.Anything, mock.Anything, mock.Anything)
+}
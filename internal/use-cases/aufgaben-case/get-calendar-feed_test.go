@@ -0,0 +1,172 @@
+package aufgaben_case
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Personal feed - assigned tasks become events with status, priority and a link back
+func TestGetCalendarFeed_Personal(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	rawToken := "raw-token"
+	userID := "user-1"
+	description := "Bremsen prüfen, Öl wechseln; danach Probefahrt"
+	dueDate := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	repo.On("GetCalendarFeedByTokenHash", ctx, hashCalendarFeedToken(rawToken)).Return(&entity.CalendarFeedEntity{ID: "feed-1", UserID: userID}, (*app_errors.AppError)(nil))
+	repo.On("ListAssignedTasks", ctx, userID, mock.MatchedBy(func(f *aufgaben_dto.AssignedAufgabenFilter) bool {
+		return f.Limit == calendarFeedLimit
+	})).Return([]entity.AssignedAufgaben{
+		{
+			ID:          "task-1",
+			ProjectID:   "project-1",
+			ProjectName: "Werkstatt",
+			Title:       "Service, Inspektion",
+			Description: &description,
+			Status:      entity.AufgabenInProgress,
+			Priority:    entity.PriorityUrgent,
+			DueDate:     dueDate,
+		},
+	}, (*app_errors.AppError)(nil))
+	repo.On("TouchCalendarFeed", ctx, "feed-1").Return((*app_errors.AppError)(nil))
+
+	// Execute
+	feed, err := service.GetCalendarFeed(ctx, rawToken, "https://meister.example", &aufgaben_dto.CalendarFeedQuery{})
+
+	// Assert
+	assert.Nil(t, err)
+	ics := string(feed)
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "BEGIN:VEVENT\r\n")
+	assert.Contains(t, ics, "UID:task-1@aufgaben-meister\r\n")
+	assert.Contains(t, ics, "DTSTART:20260302T093000Z\r\n")
+	assert.Contains(t, ics, "SUMMARY:Service\\, Inspektion\r\n")
+	assert.Contains(t, ics, "CATEGORIES:In_Progress,Urgent\r\n")
+	assert.Contains(t, ics, "PRIORITY:1\r\n")
+	assert.Contains(t, ics, "URL:https://meister.example/api/v1/project/project-1/aufgaben/task-1\r\n")
+	assert.NotContains(t, ics, "VTODO")
+
+	// Every content line stays within 75 octets and folded lines join back to the escaped description
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	assert.Contains(t, unfolded, "DESCRIPTION:Project: Werkstatt\\nStatus: In_Progress\\nPriority: Urgent\\n\\nBremsen prüfen\\, Öl wechseln\\; danach Probefahrt\r\n")
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Project feed as VTODO - tasks without due date are left out
+func TestGetCalendarFeed_ProjectTodo(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	rawToken := "raw-token"
+	meisterID := "meister-1"
+	projectID := "project-1"
+	projectName := "Werkstatt"
+	role := entity.MEISTER
+	component := "todo"
+	dueDate := time.Now().Add(24 * time.Hour)
+
+	repo.On("GetCalendarFeedByTokenHash", ctx, hashCalendarFeedToken(rawToken)).Return(&entity.CalendarFeedEntity{ID: "feed-1", UserID: meisterID, ProjectID: &projectID, ProjectName: &projectName}, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("ListTasks", ctx, projectID, mock.MatchedBy(func(f *aufgaben_dto.AufgabenListFilter) bool {
+		return f.DueAfter != nil && len(f.Sort) == 1 && f.Sort[0] == "due_date" && f.Page == 1
+	})).Return([]entity.AufgabenEntity{
+		{ID: "task-1", ProjectID: projectID, Title: "Done task", Status: entity.AufgabenDone, Priority: entity.PriorityLow, DueDate: &dueDate},
+		{ID: "task-2", ProjectID: projectID, Title: "No due date", Status: entity.AufgabenTodo, Priority: entity.PriorityHigh},
+	}, (*app_errors.AppError)(nil))
+	repo.On("TouchCalendarFeed", ctx, "feed-1").Return((*app_errors.AppError)(nil))
+
+	// Execute
+	feed, err := service.GetCalendarFeed(ctx, rawToken, "https://meister.example", &aufgaben_dto.CalendarFeedQuery{Component: &component})
+
+	// Assert
+	assert.Nil(t, err)
+	ics := string(feed)
+	assert.Contains(t, ics, "X-WR-CALNAME:Werkstatt\r\n")
+	assert.Equal(t, 1, strings.Count(ics, "BEGIN:VTODO\r\n"))
+	assert.Contains(t, ics, "STATUS:COMPLETED\r\n")
+	assert.Contains(t, ics, "PRIORITY:9\r\n")
+	assert.NotContains(t, ics, "task-2")
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Revoked or unknown token
+func TestGetCalendarFeed_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	rawToken := "revoked-token"
+
+	repo.On("GetCalendarFeedByTokenHash", ctx, hashCalendarFeedToken(rawToken)).Return((*entity.CalendarFeedEntity)(nil), app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "calendar_feed_not_found", nil))
+
+	// Execute
+	feed, err := service.GetCalendarFeed(ctx, rawToken, "https://meister.example", &aufgaben_dto.CalendarFeedQuery{})
+
+	// Assert
+	assert.Nil(t, feed)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "TouchCalendarFeed", mock.Anything, mock.Anything)
+}
+
+// Test 4: Project feed of a former Meister stops working
+func TestGetCalendarFeed_ProjectNoLongerMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	rawToken := "raw-token"
+	userID := "user-1"
+	projectID := "project-1"
+	projectName := "Werkstatt"
+	role := entity.MITARBEITER
+
+	repo.On("GetCalendarFeedByTokenHash", ctx, hashCalendarFeedToken(rawToken)).Return(&entity.CalendarFeedEntity{ID: "feed-1", UserID: userID, ProjectID: &projectID, ProjectName: &projectName}, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	feed, err := service.GetCalendarFeed(ctx, rawToken, "https://meister.example", &aufgaben_dto.CalendarFeedQuery{})
+
+	// Assert
+	assert.Nil(t, feed)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListTasks", mock.Anything, mock.Anything, mock.Anything)
+}
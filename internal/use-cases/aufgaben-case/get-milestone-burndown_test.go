@@ -0,0 +1,89 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Running sprint, days after today only carry the ideal line
+func TestGetMilestoneBurndown_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 4)}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneBurndown", ctx, milestoneID).Return([]entity.BurndownDay{
+		{Day: start, ScopeTasks: 4, ScopePoints: 8, DoneTasks: 0},
+		{Day: start.AddDate(0, 0, 1), ScopeTasks: 5, ScopePoints: 10, DoneTasks: 2, DonePoints: 3},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetMilestoneBurndown(ctx, userID, projectID, milestoneID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Days, 5)
+
+	assert.Equal(t, "2026-03-02", resp.Days[0].Date)
+	assert.Equal(t, 4.0, resp.Days[0].IdealRemainingTasks)
+	assert.Equal(t, 8.0, resp.Days[0].IdealRemainingPoints)
+
+	// Scope grew on the second day
+	assert.Equal(t, 5, *resp.Days[1].ScopeTasks)
+	assert.Equal(t, 3, *resp.Days[1].RemainingTasks)
+	assert.Equal(t, 7.0, *resp.Days[1].RemainingPoints)
+	assert.Equal(t, 3.0, resp.Days[1].IdealRemainingTasks)
+	assert.Equal(t, 6.0, resp.Days[1].IdealRemainingPoints)
+
+	assert.Nil(t, resp.Days[2].ScopeTasks)
+	assert.Nil(t, resp.Days[2].RemainingPoints)
+	assert.Equal(t, "2026-03-06", resp.Days[4].Date)
+	assert.Equal(t, 0.0, resp.Days[4].IdealRemainingTasks)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Milestone of another project
+func TestGetMilestoneBurndown_OtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: "project-2", Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetMilestoneBurndown(ctx, userID, projectID, milestoneID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "milestone_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetMilestoneBurndown", ctx, milestoneID)
+}
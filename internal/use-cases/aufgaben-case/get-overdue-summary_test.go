@@ -0,0 +1,134 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister gets overdue tasks grouped by priority, age and assignee
+func TestGetOverdueSummary_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	assigneeA := "user-1"
+	assigneeB := "user-2"
+	tenDaysAgo := time.Now().Add(-10 * 24 * time.Hour)
+	twoDaysAgo := time.Now().Add(-2 * 24 * time.Hour)
+	twoHoursAgo := time.Now().Add(-2 * time.Hour)
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("ListOverdueTasks", ctx, projectID).Return([]entity.AufgabenEntity{
+		{ID: "task-1", ProjectID: projectID, Title: "Oldest", Status: entity.AufgabenInProgress, Priority: entity.PriorityUrgent, AssigneeID: &assigneeA, DueDate: &tenDaysAgo, EscalationLevel: 2},
+		{ID: "task-2", ProjectID: projectID, Title: "Unassigned", Status: entity.AufgabenTodo, Priority: entity.PriorityLow, DueDate: &twoDaysAgo},
+		{ID: "task-3", ProjectID: projectID, Title: "Recent", Status: entity.AufgabenInProgress, Priority: entity.PriorityUrgent, AssigneeID: &assigneeB, DueDate: &twoHoursAgo},
+		{ID: "task-4", ProjectID: projectID, Title: "Also A", Status: entity.AufgabenTodo, Priority: entity.PriorityMedium, AssigneeID: &assigneeA, DueDate: &twoHoursAgo},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetOverdueSummary(ctx, meisterID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, projectID, resp.ProjectID)
+	assert.Equal(t, 4, resp.Total)
+
+	assert.Len(t, resp.ByPriority, 4)
+	assert.Equal(t, "Urgent", resp.ByPriority[0].Key)
+	assert.Equal(t, 2, resp.ByPriority[0].Count)
+	assert.Equal(t, 0, resp.ByPriority[1].Count)
+
+	assert.Equal(t, "under_1d", resp.ByAge[0].Key)
+	assert.Equal(t, 2, resp.ByAge[0].Count)
+	assert.Equal(t, 1, resp.ByAge[1].Count)
+	assert.Equal(t, 0, resp.ByAge[2].Count)
+	assert.Equal(t, 1, resp.ByAge[3].Count)
+
+	// user-1 carries two overdue tasks, the unassigned task is overdue longer than user-2's
+	assert.Len(t, resp.ByAssignee, 3)
+	assert.Equal(t, &assigneeA, resp.ByAssignee[0].AssigneeID)
+	assert.Equal(t, 2, resp.ByAssignee[0].Count)
+	assert.Nil(t, resp.ByAssignee[1].AssigneeID)
+	assert.Equal(t, &assigneeB, resp.ByAssignee[2].AssigneeID)
+
+	assert.Len(t, resp.Oldest, 4)
+	assert.Equal(t, "task-1", resp.Oldest[0].AufgabenID)
+	assert.Equal(t, 2, resp.Oldest[0].EscalationLevel)
+	assert.GreaterOrEqual(t, resp.Oldest[0].OverdueSince, int64(10*24*60*60))
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't see the overdue summary
+func TestGetOverdueSummary_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetOverdueSummary(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListOverdueTasks", mock.Anything, mock.Anything)
+}
+
+// Test 3: No overdue tasks - every group is still listed with zero counts
+func TestGetOverdueSummary_Empty(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("ListOverdueTasks", ctx, projectID).Return([]entity.AufgabenEntity(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetOverdueSummary(ctx, meisterID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resp.Total)
+	assert.Len(t, resp.ByPriority, 4)
+	assert.Len(t, resp.ByAge, 4)
+	assert.Empty(t, resp.ByAssignee)
+	assert.NotNil(t, resp.Oldest)
+	assert.Empty(t, resp.Oldest)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,74 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - compliance is computed per priority
+func TestGetSLACompliance_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	filter := &aufgaben_dto.SLAComplianceFilter{From: "2026-01-01", To: "2026-03-31"}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetSLACompliance", ctx, projectID, filter).Return([]entity.SLAComplianceRow{
+		{Priority: entity.PriorityUrgent, Measured: 8, AssignmentBreaches: 1, CompletionBreaches: 2},
+		{Priority: entity.PriorityHigh, Measured: 0},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetSLACompliance(ctx, userID, projectID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, projectID, resp.ProjectID)
+	assert.Len(t, resp.Priorities, 2)
+	assert.Equal(t, 87.5, resp.Priorities[0].AssignmentCompliance)
+	assert.Equal(t, 75.0, resp.Priorities[0].CompletionCompliance)
+	// Nothing measured counts as fully compliant
+	assert.Equal(t, 100.0, resp.Priorities[1].AssignmentCompliance)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Reversed date range is rejected
+func TestGetSLACompliance_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	filter := &aufgaben_dto.SLAComplianceFilter{From: "2026-03-31", To: "2026-01-01"}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetSLACompliance(ctx, userID, projectID, filter)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetSLACompliance", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,70 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Summary sums up member totals
+func TestGetTaskTimeSummary_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetTaskWorklogTotals", ctx, taskID).Return([]entity.WorklogTotal{
+		{ID: "user-1", Name: "anna", TotalMinutes: 120},
+		{ID: "user-2", Name: "bernd", TotalMinutes: 45},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetTaskTimeSummary(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 165, resp.TotalMinutes)
+	assert.Len(t, resp.Members, 2)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Task of another project
+func TestGetTaskTimeSummary_WrongProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: "project-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetTaskTimeSummary(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetTaskWorklogTotals", ctx, taskID)
+}
@@ -0,0 +1,98 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Report over a date range
+func TestGetTimeReport_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	filter := &aufgaben_dto.TimeReportFilter{From: "2025-01-01", To: "2025-01-31"}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectTimeReport", ctx, projectID, filter).Return(
+		[]entity.WorklogTotal{{ID: "user-1", Name: "anna", TotalMinutes: 300}, {ID: "user-2", Name: "bernd", TotalMinutes: 60}},
+		[]entity.WorklogTotal{{ID: "task-1", Name: "Deploy", TotalMinutes: 360}},
+		(*app_errors.AppError)(nil),
+	)
+
+	// Execute
+	resp, err := service.GetTimeReport(ctx, userID, projectID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 360, resp.TotalMinutes)
+	assert.Len(t, resp.Members, 2)
+	assert.Len(t, resp.Tasks, 1)
+	assert.Equal(t, "2025-01-01", resp.From)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: "to" before "from"
+func TestGetTimeReport_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetTimeReport(ctx, userID, projectID, &aufgaben_dto.TimeReportFilter{From: "2025-02-01", To: "2025-01-01"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_date_range", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetProjectTimeReport", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test 3: Range longer than a year
+func TestGetTimeReport_RangeTooLong(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetTimeReport(ctx, userID, projectID, &aufgaben_dto.TimeReportFilter{From: "2024-01-01", To: "2025-06-01"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "request.invalid_date_range", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
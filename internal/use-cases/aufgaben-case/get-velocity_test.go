@@ -0,0 +1,105 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Averages leave out the running week
+func TestGetVelocity_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	week := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectVelocity", ctx, projectID, mock.Anything, mock.Anything).Return([]entity.VelocityWeek{
+		{WeekStart: week, PlannedPoints: 10, PlannedTasks: 4, CompletedPoints: 8, CompletedHours: 6, CompletedTasks: 3},
+		{WeekStart: week.AddDate(0, 0, 7), PlannedPoints: 12, PlannedTasks: 5, CompletedPoints: 13, CompletedHours: 2, CompletedTasks: 5},
+		{WeekStart: week.AddDate(0, 0, 14), PlannedPoints: 9, PlannedTasks: 3, CompletedPoints: 1, CompletedTasks: 1},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetVelocity(ctx, userID, projectID, &aufgaben_dto.VelocityFilter{Weeks: 3})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp.Weeks, 3)
+	assert.Equal(t, "2025-03-03", resp.Weeks[0].WeekStart)
+	assert.Equal(t, 10.5, resp.AveragePoints)
+	assert.Equal(t, 4.0, resp.AverageHours)
+	assert.Equal(t, 4.0, resp.AverageTasks)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Range covers the requested number of weeks
+func TestGetVelocity_DefaultWeeks(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	filter := &aufgaben_dto.VelocityFilter{}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectVelocity", ctx, projectID, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		from := args.Get(2).(time.Time)
+		to := args.Get(3).(time.Time)
+		assert.Equal(t, 49, int(to.Sub(from).Hours()/24))
+	}).Return([]entity.VelocityWeek{}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetVelocity(ctx, userID, projectID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 8, filter.Weeks)
+	assert.Empty(t, resp.Weeks)
+	assert.Equal(t, 0.0, resp.AveragePoints)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Non member can't see velocity
+func TestGetVelocity_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetVelocity(ctx, userID, projectID, &aufgaben_dto.VelocityFilter{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
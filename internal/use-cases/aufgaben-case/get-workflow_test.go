@@ -0,0 +1,109 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Project without custom workflow gets the default one
+func TestGetWorkflow_DefaultWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetWorkflow(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, resp.IsDefault)
+	assert.Len(t, resp.Statuses, 3)
+	assert.Equal(t, "Todo", resp.Statuses[0].Key)
+	assert.Equal(t, "Done", resp.Statuses[2].Category)
+	assert.Len(t, resp.Transitions, 5)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Custom workflow
+func TestGetWorkflow_CustomWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetWorkflow(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, resp.IsDefault)
+	assert.Len(t, resp.Statuses, 4)
+	assert.Equal(t, "In_Review", resp.Statuses[2].Key)
+	assert.Equal(t, []string{"Meister"}, resp.Transitions[2].Roles)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Performer is not a project member
+func TestGetWorkflow_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.GetWorkflow(ctx, "user-1", "project-1")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// reviewWorkflow is Todo -> In_Progress -> In_Review -> Done, only Meister approves a review
+func reviewWorkflow(projectID string) *entity.ProjectWorkflow {
+	return &entity.ProjectWorkflow{
+		ProjectID: projectID,
+		Statuses: []entity.WorkflowStatusEntity{
+			{Key: "Todo", Name: "Todo", Category: entity.AufgabenTodo, Position: 0},
+			{Key: "In_Progress", Name: "In Progress", Category: entity.AufgabenInProgress, Position: 1},
+			{Key: "In_Review", Name: "In Review", Category: entity.AufgabenInProgress, Position: 2},
+			{Key: "Done", Name: "Done", Category: entity.AufgabenDone, Position: 3},
+		},
+		Transitions: []entity.WorkflowTransitionEntity{
+			{FromKey: "Todo", ToKey: "In_Progress"},
+			{FromKey: "In_Progress", ToKey: "In_Review"},
+			{FromKey: "In_Review", ToKey: "Done", AllowedRoles: []entity.UserRole{entity.MEISTER}},
+			{FromKey: "In_Review", ToKey: "In_Progress"},
+		},
+	}
+}
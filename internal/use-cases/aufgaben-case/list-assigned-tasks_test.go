@@ -159,3 +159,48 @@ func TestListAssignedTasks_InvalidCursor(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test 4: overdue filter, last page flags overdue tasks and carries no cursor
+func TestListAssignedTasks_OverdueLastPage(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	overdue := true
+
+	filter := &aufgaben_dto.AssignedAufgabenFilter{
+		Overdue: &overdue,
+		Limit:   5,
+	}
+
+	dueDate := time.Now().Add(-2 * time.Hour)
+	assignedTasks := []entity.AssignedAufgaben{
+		{
+			ID:          "task-1",
+			ProjectName: "Test project",
+			Title:       "late task",
+			Status:      entity.AufgabenInProgress,
+			Priority:    entity.PriorityHigh,
+			DueDate:     dueDate,
+		},
+	}
+
+	repo.On("ListAssignedTasks", ctx, userID, filter).Return(assignedTasks, (*app_errors.AppError)(nil))
+
+	resp, c, err := service.ListAssignedTasks(ctx, userID, filter)
+
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.True(t, resp[0].IsOverdue)
+	assert.NotNil(t, resp[0].OverdueSince)
+	assert.GreaterOrEqual(t, *resp[0].OverdueSince, int64(2*60*60))
+
+	assert.False(t, c.HasMore)
+	assert.Nil(t, c.NextCursor)
+
+	repo.AssertExpectations(t)
+}
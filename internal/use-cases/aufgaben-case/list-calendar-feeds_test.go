@@ -0,0 +1,70 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - active feeds are listed without their URL
+func TestListCalendarFeeds_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	projectName := "Werkstatt"
+	lastUsedAt := time.Now().Add(-time.Hour)
+
+	repo.On("ListCalendarFeeds", ctx, userID).Return([]entity.CalendarFeedEntity{
+		{ID: "feed-1", UserID: userID, CreatedAt: time.Now(), LastUsedAt: &lastUsedAt},
+		{ID: "feed-2", UserID: userID, ProjectID: &projectID, ProjectName: &projectName, CreatedAt: time.Now()},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListCalendarFeeds(ctx, userID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "user", resp[0].Scope)
+	assert.Equal(t, &lastUsedAt, resp[0].LastUsedAt)
+	assert.Nil(t, resp[0].URL)
+	assert.Equal(t, "project", resp[1].Scope)
+	assert.Equal(t, &projectName, resp[1].ProjectName)
+	assert.Nil(t, resp[1].URL)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: No feeds - empty list instead of null
+func TestListCalendarFeeds_Empty(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+
+	repo.On("ListCalendarFeeds", ctx, userID).Return([]entity.CalendarFeedEntity(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListCalendarFeeds(ctx, userID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Empty(t, resp)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,157 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - more comments than the limit
+func TestListComments_HasMore(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	filter := &aufgaben_dto.AufgabenCommentFilter{Limit: 2}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	now := time.Now()
+	comments := []entity.AufgabenCommentEntity{
+		{ID: "0190b0a0-0000-7000-8000-000000000003", AufgabenID: taskID, AuthorID: userID, Body: "third", CreatedAt: now},
+		{ID: "0190b0a0-0000-7000-8000-000000000002", AufgabenID: taskID, AuthorID: userID, Body: "second", CreatedAt: now},
+		{ID: "0190b0a0-0000-7000-8000-000000000001", AufgabenID: taskID, AuthorID: userID, Body: "first", CreatedAt: now},
+	}
+	repo.On("ListCommentsForTask", ctx, taskID, filter).Return(comments, (*app_errors.AppError)(nil))
+	repo.On("ListCommentMentions", ctx, []string{
+		"0190b0a0-0000-7000-8000-000000000003",
+		"0190b0a0-0000-7000-8000-000000000002",
+	}).Return([]entity.CommentMention(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, meta, err := service.ListComments(ctx, userID, projectID, taskID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.True(t, meta.HasMore)
+	assert.Equal(t, "0190b0a0-0000-7000-8000-000000000002", meta.NextCursor)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Last page has no next cursor
+func TestListComments_LastPage(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	filter := &aufgaben_dto.AufgabenCommentFilter{}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListCommentsForTask", ctx, taskID, filter).Return([]entity.AufgabenCommentEntity{
+		{ID: "comment-1", AufgabenID: taskID, AuthorID: userID, Body: "only"},
+	}, (*app_errors.AppError)(nil))
+	repo.On("ListCommentMentions", ctx, []string{"comment-1"}).Return([]entity.CommentMention(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, meta, err := service.ListComments(ctx, userID, projectID, taskID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, 20, meta.Limit)
+	assert.False(t, meta.HasMore)
+	assert.Nil(t, meta.NextCursor)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Invalid cursor
+func TestListComments_InvalidCursor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	cursor := "not-a-uuid"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, meta, err := service.ListComments(ctx, userID, projectID, taskID, &aufgaben_dto.AufgabenCommentFilter{Cursor: &cursor})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_query", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Listed comments carry the mentions still written in their body
+func TestListComments_IncludesMentions(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	filter := &aufgaben_dto.AufgabenCommentFilter{}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListCommentsForTask", ctx, taskID, filter).Return([]entity.AufgabenCommentEntity{
+		{ID: "comment-2", AufgabenID: taskID, AuthorID: userID, Body: "ping @Bob"},
+		{ID: "comment-1", AufgabenID: taskID, AuthorID: userID, Body: "no mentions left"},
+	}, (*app_errors.AppError)(nil))
+	repo.On("ListCommentMentions", ctx, []string{"comment-2", "comment-1"}).Return([]entity.CommentMention{
+		{CommentID: "comment-2", MentionedUser: entity.MentionedUser{UserID: "user-2", Username: "bob"}},
+		// alice was mentioned before comment-2 was edited
+		{CommentID: "comment-2", MentionedUser: entity.MentionedUser{UserID: "user-3", Username: "alice"}},
+		{CommentID: "comment-1", MentionedUser: entity.MentionedUser{UserID: "user-2", Username: "bob"}},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, _, err := service.ListComments(ctx, userID, projectID, taskID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, []string{"bob"}, resp[0].Mentions)
+	assert.Empty(t, resp[1].Mentions)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,112 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - task is blocked by one open task
+func TestListDependencies_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	now := time.Now()
+	blockers := []entity.DependencyLink{
+		{AufgabenID: "task-2", Title: "Build", Status: entity.AufgabenDone, CreatedBy: userID, CreatedAt: now},
+		{AufgabenID: "task-3", Title: "Review", Status: entity.AufgabenInProgress, CreatedBy: userID, CreatedAt: now},
+	}
+	blocking := []entity.DependencyLink{
+		{AufgabenID: "task-4", Title: "Release", Status: entity.AufgabenTodo, CreatedBy: userID, CreatedAt: now},
+	}
+	repo.On("ListBlockers", ctx, taskID).Return(blockers, (*app_errors.AppError)(nil))
+	repo.On("ListBlocking", ctx, taskID).Return(blocking, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListDependencies(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, resp.IsBlocked)
+	assert.Len(t, resp.BlockedBy, 2)
+	assert.False(t, resp.BlockedBy[0].IsOpen)
+	assert.True(t, resp.BlockedBy[1].IsOpen)
+	assert.Len(t, resp.Blocking, 1)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: All blockers are done or archived
+func TestListDependencies_NotBlocked(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	archivedAt := time.Now()
+	blockers := []entity.DependencyLink{
+		{AufgabenID: "task-2", Title: "Build", Status: entity.AufgabenDone},
+		{AufgabenID: "task-3", Title: "Spike", Status: entity.AufgabenTodo, ArchivedAt: &archivedAt},
+	}
+	repo.On("ListBlockers", ctx, taskID).Return(blockers, (*app_errors.AppError)(nil))
+	repo.On("ListBlocking", ctx, taskID).Return([]entity.DependencyLink{}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListDependencies(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, resp.IsBlocked)
+	assert.Empty(t, resp.Blocking)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Performer is not a project member
+func TestListDependencies_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListDependencies(ctx, "user-1", "project-1", "task-1")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
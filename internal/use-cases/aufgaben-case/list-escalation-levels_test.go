@@ -0,0 +1,71 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - any member can read the escalation chain
+func TestListEscalationLevels_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	afterReminders := 2
+	afterHours := 72
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListEscalationLevels", ctx, projectID).Return([]entity.EscalationLevelEntity{
+		{ProjectID: projectID, Level: 1, AfterReminders: &afterReminders, Action: entity.EscalationNotifyMeister},
+		{ProjectID: projectID, Level: 2, AfterHoursOverdue: &afterHours, Action: entity.EscalationFlagForceUnassign},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListEscalationLevels(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 1, resp[0].Level)
+	assert.Equal(t, afterReminders, *resp[0].AfterReminders)
+	assert.Equal(t, string(entity.EscalationFlagForceUnassign), resp[1].Action)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Non member can't read the escalation chain
+func TestListEscalationLevels_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "outsider"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListEscalationLevels(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListEscalationLevels", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,71 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Meister sees pending handover requests of the project
+func TestListHandoverRequests_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+	repo.On("ListPendingHandoverRequests", ctx, projectID).Return([]entity.HandoverRequestEntity{
+		{ID: "request-1", AufgabenID: "task-1", ProjectID: projectID, RequestedBy: "user-1", TargetAssigneeID: "user-2", Status: entity.HandoverPending, AufgabeTitle: "Deploy", ExpiresAt: time.Now().Add(time.Hour)},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListHandoverRequests(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, "request-1", resp[0].RequestID)
+	assert.Equal(t, "Pending", resp[0].Status)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't see handover requests
+func TestListHandoverRequests_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	mitarbeiterRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&mitarbeiterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListHandoverRequests(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListPendingHandoverRequests", ctx, projectID)
+}
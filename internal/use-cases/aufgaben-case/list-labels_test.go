@@ -0,0 +1,62 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path
+func TestListLabels_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListLabels", ctx, projectID).Return([]entity.LabelEntity{
+		{ID: "label-1", ProjectID: projectID, Name: "backend"},
+		{ID: "label-2", ProjectID: projectID, Name: "bug"},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListLabels(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "label-1", resp[0].LabelID)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Performer is not a project member
+func TestListLabels_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListLabels(ctx, "user-1", "project-1")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,70 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Member lists the milestones with their progress
+func TestListMilestones_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListMilestones", ctx, projectID).Return([]entity.MilestoneEntity{
+		{ID: "ms-1", ProjectID: projectID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 13), TotalTasks: 8, DoneTasks: 3},
+		{ID: "ms-2", ProjectID: projectID, Name: "Sprint 2", StartDate: start.AddDate(0, 0, 14), EndDate: start.AddDate(0, 0, 27)},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListMilestones(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "2026-03-15", resp[0].EndDate)
+	assert.Equal(t, 8, resp[0].TotalTasks)
+	assert.Equal(t, 3, resp[0].DoneTasks)
+	assert.Equal(t, "2026-03-16", resp[1].StartDate)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Outsider can't list milestones
+func TestListMilestones_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-9"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListMilestones(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,68 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Members see all recurring tasks of the project
+func TestListRecurrences_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListRecurrences", ctx, projectID).Return([]entity.RecurrenceEntity{
+		{ID: "rec-1", ProjectID: projectID, Title: "Weekly sync notes", Priority: entity.PriorityLow, CronExpr: "@weekly", NextRunAt: time.Now(), Active: true},
+		{ID: "rec-2", ProjectID: projectID, Title: "Monthly invoice", Priority: entity.PriorityHigh, CronExpr: "0 8 1 * *", NextRunAt: time.Now(), Active: false},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListRecurrences(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "rec-1", resp[0].RecurrenceID)
+	assert.False(t, resp[1].Active)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Non member can't list
+func TestListRecurrences_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListRecurrences(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListRecurrences", ctx, projectID)
+}
@@ -0,0 +1,72 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - any member can read the SLA policies
+func TestListSLAPolicies_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	assignWithin := 30
+	completeWithin := 480
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListSLAPolicies", ctx, projectID).Return([]entity.SLAPolicyEntity{
+		{ProjectID: projectID, Priority: entity.PriorityUrgent, AssignWithinMinutes: &assignWithin},
+		{ProjectID: projectID, Priority: entity.PriorityHigh, CompleteWithinMinutes: &completeWithin},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListSLAPolicies(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, string(entity.PriorityUrgent), resp[0].Priority)
+	assert.Equal(t, assignWithin, *resp[0].AssignWithinMinutes)
+	assert.Nil(t, resp[0].CompleteWithinMinutes)
+	assert.Equal(t, completeWithin, *resp[1].CompleteWithinMinutes)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Non member can't read the SLA policies
+func TestListSLAPolicies_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "outsider"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListSLAPolicies(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListSLAPolicies", mock.Anything, mock.Anything)
+}
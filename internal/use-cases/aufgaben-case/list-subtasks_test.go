@@ -0,0 +1,108 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - progress is rolled up from children
+func TestListSubtasks_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	parent := &entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: projectID,
+		Status:    entity.AufgabenInProgress,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(parent, (*app_errors.AppError)(nil))
+
+	subtasks := []entity.AufgabenEntity{
+		{ID: "sub-1", ParentID: &taskID, Title: "Step 1", Status: entity.AufgabenDone, Priority: entity.PriorityLow},
+		{ID: "sub-2", ParentID: &taskID, Title: "Step 2", Status: entity.AufgabenInProgress, Priority: entity.PriorityLow},
+		{ID: "sub-3", ParentID: &taskID, Title: "Step 3", Status: entity.AufgabenTodo, Priority: entity.PriorityLow},
+	}
+	repo.On("ListSubtasks", ctx, taskID).Return(subtasks, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListSubtasks(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, taskID, resp.ParentID)
+	assert.Len(t, resp.Subtasks, 3)
+	assert.Equal(t, 3, resp.Progress.Total)
+	assert.Equal(t, 1, resp.Progress.Done)
+	assert.Equal(t, 33, resp.Progress.Percent)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Performer is not a project member
+func TestListSubtasks_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListSubtasks(ctx, "user-1", "project-1", "task-1")
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+	assert.Equal(t, "forbidden", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Parent has no subtasks
+func TestListSubtasks_Empty(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListSubtasks", ctx, taskID).Return([]entity.AufgabenEntity{}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListSubtasks(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Empty(t, resp.Subtasks)
+	assert.Equal(t, 0, resp.Progress.Total)
+	assert.Equal(t, 0, resp.Progress.Percent)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,45 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - owner and collaborator are listed with their roles
+func TestListTaskAssignees_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	meisterID := "meister-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListTaskAssignees", ctx, taskID).Return([]entity.AufgabenAssigneeEntity{
+		{AufgabenID: taskID, UserID: userID, Username: "anna", Role: entity.AssigneeOwner, CreatedAt: time.Now()},
+		{AufgabenID: taskID, UserID: "user-2", Username: "ben", Role: entity.AssigneeCollaborator, AddedBy: &meisterID, CreatedAt: time.Now()},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListTaskAssignees(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "Owner", resp[0].Role)
+	assert.Equal(t, "Collaborator", resp[1].Role)
+	assert.Equal(t, meisterID, *resp[1].AddedBy)
+
+	repo.AssertExpectations(t)
+}
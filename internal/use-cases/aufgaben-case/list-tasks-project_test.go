@@ -8,7 +8,9 @@ import (
 	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // Test Happy path
@@ -51,7 +53,7 @@ func TestListTasksProject_Success(t *testing.T) {
 		},
 	}
 	repo.On("ListTasks", ctx, projectID, filters).Return(([]entity.AufgabenEntity)(r), (*app_errors.AppError)(nil))
-	repo.On("CountTasks", ctx, projectID).Return(1, (*app_errors.AppError)(nil))
+	repo.On("CountTasks", ctx, projectID, filters).Return(1, (*app_errors.AppError)(nil))
 
 	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, *filters)
 
@@ -166,7 +168,7 @@ func TestListTasksProject_ReturnEmptyList(t *testing.T) {
 
 	r := []entity.AufgabenEntity{}
 	repo.On("ListTasks", ctx, projectID, filters).Return(([]entity.AufgabenEntity)(r), (*app_errors.AppError)(nil))
-	repo.On("CountTasks", ctx, projectID).Return(1, (*app_errors.AppError)(nil))
+	repo.On("CountTasks", ctx, projectID, filters).Return(1, (*app_errors.AppError)(nil))
 
 	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, *filters)
 
@@ -182,3 +184,241 @@ func TestListTasksProject_ReturnEmptyList(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test label filter is normalized before reaching the repo
+func TestListTasksProject_LabelFilterNormalized(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	labelMatch := "all"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	filters := aufgaben_dto.AufgabenListFilter{
+		Labels:     []string{"Backend, bug", " backend"},
+		LabelMatch: &labelMatch,
+		Limit:      10,
+		Page:       1,
+	}
+
+	repo.On("ListTasks", ctx, projectID, mock.MatchedBy(func(f *aufgaben_dto.AufgabenListFilter) bool {
+		return assert.ObjectsAreEqual([]string{"backend", "bug"}, f.Labels) && *f.LabelMatch == "all"
+	})).Return([]entity.AufgabenEntity{}, (*app_errors.AppError)(nil))
+	repo.On("CountTasks", ctx, projectID, mock.Anything).Return(0, (*app_errors.AppError)(nil))
+
+	_, _, err := service.ListTasksProject(ctx, userID, projectID, filters)
+
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test filtered total and the cursor of the next page
+func TestListTasksProject_FilteredTotalAndNextCursor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	priority := "High"
+	overdue := true
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	filters := aufgaben_dto.AufgabenListFilter{
+		Priority: &priority,
+		Overdue:  &overdue,
+		Sort:     []string{"Due_Date, -priority"},
+		Limit:    2,
+		Page:     1,
+	}
+
+	matchFilter := mock.MatchedBy(func(f *aufgaben_dto.AufgabenListFilter) bool {
+		return assert.ObjectsAreEqual([]string{"due_date", "-priority"}, f.Sort) && *f.Priority == "High" && *f.Overdue
+	})
+	repo.On("ListTasks", ctx, projectID, matchFilter).Return([]entity.AufgabenEntity{
+		{ID: "task-1", Title: "First", Status: entity.AufgabenInProgress, Priority: entity.PriorityHigh},
+		{ID: "task-2", Title: "Second", Status: entity.AufgabenInProgress, Priority: entity.PriorityHigh},
+		{ID: "task-3", Title: "Third", Status: entity.AufgabenTodo, Priority: entity.PriorityHigh},
+	}, (*app_errors.AppError)(nil))
+	repo.On("CountTasks", ctx, projectID, matchFilter).Return(5, (*app_errors.AppError)(nil))
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, filters)
+
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 5, paging.Total)
+	assert.Equal(t, 3, paging.TotalPages)
+	assert.Equal(t, "task-2", *paging.NextCursor)
+
+	repo.AssertExpectations(t)
+}
+
+// Test last page has no next cursor
+func TestListTasksProject_LastPageWithoutCursor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	cursor := "task-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	filters := aufgaben_dto.AufgabenListFilter{
+		Cursor: &cursor,
+		Limit:  2,
+		Page:   1,
+	}
+
+	repo.On("GetTaskByID", ctx, cursor).Return(&entity.AufgabenEntity{ID: cursor, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListTasks", ctx, projectID, mock.Anything).Return([]entity.AufgabenEntity{
+		{ID: "task-3", Title: "Third", Status: entity.AufgabenTodo, Priority: entity.PriorityHigh},
+	}, (*app_errors.AppError)(nil))
+	repo.On("CountTasks", ctx, projectID, mock.Anything).Return(3, (*app_errors.AppError)(nil))
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, filters)
+
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Nil(t, paging.NextCursor)
+	assert.Zero(t, paging.Page)
+	assert.Equal(t, 3, paging.Total)
+
+	repo.AssertExpectations(t)
+}
+
+// Test unknown sort key
+func TestListTasksProject_UnknownSortKey(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	filters := aufgaben_dto.AufgabenListFilter{
+		Sort:  []string{"title"},
+		Limit: 10,
+		Page:  1,
+	}
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, filters)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+	assert.Nil(t, paging)
+	assert.Equal(t, app_errors.ErrInvalidQuery, err.Type)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListTasks", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test assignee and unassigned filter together
+func TestListTasksProject_AssigneeAndUnassigned(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	assigneeID := "user-2"
+	unassigned := true
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("CheckProjectMember", ctx, projectID, assigneeID).Return(true, (*app_errors.AppError)(nil))
+
+	filters := aufgaben_dto.AufgabenListFilter{
+		AssigneeID: &assigneeID,
+		Unassigned: &unassigned,
+		Limit:      10,
+		Page:       1,
+	}
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, filters)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+	assert.Nil(t, paging)
+	assert.Equal(t, app_errors.ErrInvalidQuery, err.Type)
+
+	repo.AssertExpectations(t)
+}
+
+// Test cursor of a task in another project
+func TestListTasksProject_CursorFromOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	cursor := "task-9"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, cursor).Return(&entity.AufgabenEntity{ID: cursor, ProjectID: "project-2"}, (*app_errors.AppError)(nil))
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, aufgaben_dto.AufgabenListFilter{Cursor: &cursor, Limit: 2, Page: 1})
+
+	assert.Nil(t, resp)
+	assert.Nil(t, paging)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_query", err.MessageKey)
+
+	repo.AssertNotCalled(t, "ListTasks", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test cursor of a task that does not exist
+func TestListTasksProject_UnknownCursor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	cursor := "task-404"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, cursor).Return((*entity.AufgabenEntity)(nil), app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "task_not_found", nil))
+
+	resp, paging, err := service.ListTasksProject(ctx, userID, projectID, aufgaben_dto.AufgabenListFilter{Cursor: &cursor, Limit: 2, Page: 1})
+
+	assert.Nil(t, resp)
+	assert.Nil(t, paging)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_query", err.MessageKey)
+
+	repo.AssertNotCalled(t, "ListTasks", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
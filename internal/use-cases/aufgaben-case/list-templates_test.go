@@ -0,0 +1,68 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Members see the templates of the project
+func TestListTemplates_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("ListTemplates", ctx, projectID).Return([]entity.TemplateEntity{
+		{ID: "tpl-1", ProjectID: projectID, Name: "Bug report", Title: "Bug: {{summary}}", Priority: entity.PriorityHigh, CreatedAt: time.Now()},
+		{ID: "tpl-2", ProjectID: projectID, Name: "Onboarding", Title: "Onboard new colleague", Priority: entity.PriorityMedium, CreatedAt: time.Now()},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListTemplates(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, []string{"summary"}, resp[0].Variables)
+	assert.Empty(t, resp[1].Variables)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Non member can't list
+func TestListTemplates_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListTemplates(ctx, userID, projectID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListTemplates", ctx, projectID)
+}
@@ -0,0 +1,70 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Members see who watches the task
+func TestListWatchers_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListWatchers", ctx, taskID).Return([]entity.WatcherEntity{
+		{AufgabenID: taskID, UserID: "user-2", Username: "bob", CreatedAt: time.Now()},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListWatchers(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, "bob", resp[0].Username)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Task of another project
+func TestListWatchers_TaskOfOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: "project-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ListWatchers(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListWatchers", ctx, taskID)
+}
@@ -0,0 +1,79 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: List returns cursor when more worklogs exist
+func TestListWorklogs_HasMore(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	endedAt := time.Now()
+	filters := &aufgaben_dto.AufgabenWorklogFilter{Limit: 2}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("ListWorklogsForTask", ctx, taskID, filters).Return([]entity.WorklogEntity{
+		{ID: "worklog-3", AufgabenID: taskID, StartedAt: time.Now()},
+		{ID: "worklog-2", AufgabenID: taskID, StartedAt: endedAt.Add(-30 * time.Minute), EndedAt: &endedAt},
+		{ID: "worklog-1", AufgabenID: taskID, StartedAt: endedAt.Add(-time.Hour), EndedAt: &endedAt},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	data, meta, err := service.ListWorklogs(ctx, userID, projectID, taskID, filters)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, data, 2)
+	assert.True(t, data[0].Running)
+	assert.Equal(t, 30, *data[1].DurationMinutes)
+	assert.True(t, meta.HasMore)
+	assert.Equal(t, "worklog-2", meta.NextCursor)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Invalid cursor
+func TestListWorklogs_InvalidCursor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	cursor := "not-a-uuid"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	data, meta, err := service.ListWorklogs(ctx, userID, projectID, taskID, &aufgaben_dto.AufgabenWorklogFilter{Cursor: &cursor})
+
+	// Assert
+	assert.Nil(t, data)
+	assert.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+}
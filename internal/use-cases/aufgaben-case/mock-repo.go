@@ -31,8 +31,8 @@ func (m *MockAufgabenRepo) GetTaskByID(ctx context.Context, taskID string) (*ent
 	return args.Get(0).(*entity.AufgabenEntity), args.Get(1).(*app_errors.AppError)
 }
 
-func (m *MockAufgabenRepo) CountTasks(ctx context.Context, projectID string) (int64, *app_errors.AppError) {
-	args := m.Called(ctx, projectID)
+func (m *MockAufgabenRepo) CountTasks(ctx context.Context, projectID string, filter *aufgaben_dto.AufgabenListFilter) (int64, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, filter)
 	return int64(args.Int(0)), args.Get(1).(*app_errors.AppError)
 }
 
@@ -41,6 +41,11 @@ func (m *MockAufgabenRepo) ListTasks(ctx context.Context, projectID string, filt
 	return args.Get(0).([]entity.AufgabenEntity), args.Get(1).(*app_errors.AppError)
 }
 
+func (m *MockAufgabenRepo) LockTask(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError {
+	args := m.Called(ctx, t, taskID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
 func (m *MockAufgabenRepo) AssignTask(ctx context.Context, t tx.Tx, projectID, taskID, userID string, dueDate *time.Time) (*entity.AssignTaskEntity, *app_errors.AppError) {
 	args := m.Called(ctx, t, projectID, taskID, userID, dueDate)
 	return args.Get(0).(*entity.AssignTaskEntity), args.Get(1).(*app_errors.AppError)
@@ -56,9 +61,9 @@ func (m *MockAufgabenRepo) UnassignTask(ctx context.Context, t tx.Tx, rollbackMo
 	return args.Get(0).(entity.AufgabenStatus), args.Get(1).(*app_errors.AppError)
 }
 
-func (m *MockAufgabenRepo) ShouldRemind(ctx context.Context, taskID string) (*entity.ReminderAufgaben, *app_errors.AppError) {
+func (m *MockAufgabenRepo) ShouldRemind(ctx context.Context, taskID string) ([]entity.ReminderAufgaben, *app_errors.AppError) {
 	args := m.Called(ctx, taskID)
-	return args.Get(0).(*entity.ReminderAufgaben), args.Get(1).(*app_errors.AppError)
+	return args.Get(0).([]entity.ReminderAufgaben), args.Get(1).(*app_errors.AppError)
 }
 
 func (m *MockAufgabenRepo) UpdateAufgabeReminderBeforeDue(ctx context.Context, t tx.Tx, taskID string) *app_errors.AppError {
@@ -76,6 +81,16 @@ func (m *MockAufgabenRepo) ArchiveTask(ctx context.Context, t tx.Tx, taskID stri
 	return args.Get(0).(*app_errors.AppError)
 }
 
+func (m *MockAufgabenRepo) UnarchiveTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID)
+	return args.Get(0).(entity.AufgabenStatus), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ReopenTask(ctx context.Context, t tx.Tx, taskID string) (entity.AufgabenStatus, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID)
+	return args.Get(0).(entity.AufgabenStatus), args.Get(1).(*app_errors.AppError)
+}
+
 func (m *MockAufgabenRepo) BatchUpdateAufgabenReminderOverdue(ctx context.Context, t tx.Tx, taskIDs []string) *app_errors.AppError {
 	args := m.Called(ctx, t, taskIDs)
 	return args.Get(0).(*app_errors.AppError)
@@ -96,6 +111,11 @@ func (m *MockAufgabenRepo) UpdateDueDate(ctx context.Context, t tx.Tx, taskID st
 	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
 }
 
+func (m *MockAufgabenRepo) UpdateTaskContent(ctx context.Context, t tx.Tx, taskID, title string, description *string, priority entity.AufgabenPriority) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, title, description, priority)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
 func (m *MockAufgabenRepo) ListEventsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenEventFilter) ([]entity.AssignmentEventEntity, *app_errors.AppError) {
 	args := m.Called(ctx, taskID, filters)
 	return args.Get(0).([]entity.AssignmentEventEntity), args.Get(1).(*app_errors.AppError)
@@ -105,3 +125,553 @@ func (m *MockAufgabenRepo) GetUserRole(ctx context.Context, projectID, userID st
 	args := m.Called(ctx, projectID, userID)
 	return args.Get(0).(*entity.UserRole), args.Get(1).(*app_errors.AppError)
 }
+
+func (m *MockAufgabenRepo) InsertNewAufgabenWithTx(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) *app_errors.AppError {
+	args := m.Called(ctx, t, task)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListSubtasks(ctx context.Context, parentID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	args := m.Called(ctx, parentID)
+	return args.Get(0).([]entity.AufgabenEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetSubtaskProgress(ctx context.Context, parentID string) (*entity.SubtaskProgress, *app_errors.AppError) {
+	args := m.Called(ctx, parentID)
+	return args.Get(0).(*entity.SubtaskProgress), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertDependency(ctx context.Context, t tx.Tx, dependency *entity.AufgabenDependency) *app_errors.AppError {
+	args := m.Called(ctx, t, dependency)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteDependency(ctx context.Context, t tx.Tx, taskID, blockedByID string) *app_errors.AppError {
+	args := m.Called(ctx, t, taskID, blockedByID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) LockProjectDependencies(ctx context.Context, t tx.Tx, projectID string) *app_errors.AppError {
+	args := m.Called(ctx, t, projectID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) IsTransitivelyBlockedBy(ctx context.Context, t tx.Tx, taskID, blockedByID string) (bool, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, blockedByID)
+	return args.Get(0).(bool), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) CountOpenBlockers(ctx context.Context, t tx.Tx, taskID string) (int64, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID)
+	return int64(args.Int(0)), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListBlockers(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.DependencyLink), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListBlocking(ctx context.Context, taskID string) ([]entity.DependencyLink, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.DependencyLink), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListUnblockedDependents(ctx context.Context, t tx.Tx, blockerID string) ([]entity.UnblockedAufgaben, *app_errors.AppError) {
+	args := m.Called(ctx, t, blockerID)
+	return args.Get(0).([]entity.UnblockedAufgaben), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertComment(ctx context.Context, t tx.Tx, comment *entity.AufgabenCommentEntity) *app_errors.AppError {
+	args := m.Called(ctx, t, comment)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetCommentByID(ctx context.Context, commentID string) (*entity.AufgabenCommentEntity, *app_errors.AppError) {
+	args := m.Called(ctx, commentID)
+	return args.Get(0).(*entity.AufgabenCommentEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateCommentBody(ctx context.Context, t tx.Tx, commentID, body string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, commentID, body)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) SoftDeleteComment(ctx context.Context, commentID string) *app_errors.AppError {
+	args := m.Called(ctx, commentID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListCommentsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenCommentFilter) ([]entity.AufgabenCommentEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID, filters)
+	return args.Get(0).([]entity.AufgabenCommentEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) FindMentionableMembers(ctx context.Context, projectID string, usernames []string) ([]entity.MentionedUser, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, usernames)
+	return args.Get(0).([]entity.MentionedUser), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertCommentMentions(ctx context.Context, t tx.Tx, commentID string, userIDs []string) ([]string, *app_errors.AppError) {
+	args := m.Called(ctx, t, commentID, userIDs)
+	return args.Get(0).([]string), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListCommentMentions(ctx context.Context, commentIDs []string) ([]entity.CommentMention, *app_errors.AppError) {
+	args := m.Called(ctx, commentIDs)
+	return args.Get(0).([]entity.CommentMention), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertLabel(ctx context.Context, label *entity.LabelEntity) *app_errors.AppError {
+	args := m.Called(ctx, label)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetLabelByID(ctx context.Context, labelID string) (*entity.LabelEntity, *app_errors.AppError) {
+	args := m.Called(ctx, labelID)
+	return args.Get(0).(*entity.LabelEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListLabels(ctx context.Context, projectID string) ([]entity.LabelEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.LabelEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListLabelsForTask(ctx context.Context, taskID string) ([]entity.LabelEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.LabelEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateLabel(ctx context.Context, labelID string, name, color *string) (*entity.LabelEntity, *app_errors.AppError) {
+	args := m.Called(ctx, labelID, name, color)
+	return args.Get(0).(*entity.LabelEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteLabel(ctx context.Context, t tx.Tx, labelID string) ([]string, *app_errors.AppError) {
+	args := m.Called(ctx, t, labelID)
+	return args.Get(0).([]string), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) AttachLabel(ctx context.Context, t tx.Tx, taskID, labelID, userID string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, labelID, userID)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DetachLabel(ctx context.Context, t tx.Tx, taskID, labelID string) *app_errors.AppError {
+	args := m.Called(ctx, t, taskID, labelID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) SearchTasks(ctx context.Context, userID string, filter *aufgaben_dto.AufgabenSearchFilter) ([]entity.AufgabenSearchResult, *app_errors.AppError) {
+	args := m.Called(ctx, userID, filter)
+	return args.Get(0).([]entity.AufgabenSearchResult), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetProjectWorkflow(ctx context.Context, projectID string) (*entity.ProjectWorkflow, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).(*entity.ProjectWorkflow), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ReplaceProjectWorkflow(ctx context.Context, t tx.Tx, userID string, workflow *entity.ProjectWorkflow) ([]string, *app_errors.AppError) {
+	args := m.Called(ctx, t, userID, workflow)
+	return args.Get(0).([]string), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateWorkflowStatus(ctx context.Context, t tx.Tx, taskID, statusKey string) *app_errors.AppError {
+	args := m.Called(ctx, t, taskID, statusKey)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetRunningWorklog(ctx context.Context, userID string) (*entity.WorklogEntity, *app_errors.AppError) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*entity.WorklogEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetWorklogByID(ctx context.Context, worklogID string) (*entity.WorklogEntity, *app_errors.AppError) {
+	args := m.Called(ctx, worklogID)
+	return args.Get(0).(*entity.WorklogEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertWorklog(ctx context.Context, worklog *entity.WorklogEntity) *app_errors.AppError {
+	args := m.Called(ctx, worklog)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) StopWorklog(ctx context.Context, worklogID string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, worklogID)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateWorklog(ctx context.Context, worklog *entity.WorklogEntity) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, worklog)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteWorklog(ctx context.Context, worklogID string) *app_errors.AppError {
+	args := m.Called(ctx, worklogID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListWorklogsForTask(ctx context.Context, taskID string, filters *aufgaben_dto.AufgabenWorklogFilter) ([]entity.WorklogEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID, filters)
+	return args.Get(0).([]entity.WorklogEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetTaskWorklogTotals(ctx context.Context, taskID string) ([]entity.WorklogTotal, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.WorklogTotal), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetProjectTimeReport(ctx context.Context, projectID string, filter *aufgaben_dto.TimeReportFilter) ([]entity.WorklogTotal, []entity.WorklogTotal, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, filter)
+	return args.Get(0).([]entity.WorklogTotal), args.Get(1).([]entity.WorklogTotal), args.Get(2).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateEstimate(ctx context.Context, t tx.Tx, taskID string, estimate *float64, unit *entity.EstimateUnit) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, estimate, unit)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetProjectVelocity(ctx context.Context, projectID string, from, to time.Time) ([]entity.VelocityWeek, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, from, to)
+	return args.Get(0).([]entity.VelocityWeek), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) *app_errors.AppError {
+	args := m.Called(ctx, recurrence)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetRecurrenceByID(ctx context.Context, recurrenceID string) (*entity.RecurrenceEntity, *app_errors.AppError) {
+	args := m.Called(ctx, recurrenceID)
+	return args.Get(0).(*entity.RecurrenceEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListRecurrences(ctx context.Context, projectID string) ([]entity.RecurrenceEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.RecurrenceEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateRecurrence(ctx context.Context, recurrence *entity.RecurrenceEntity) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, recurrence)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteRecurrence(ctx context.Context, recurrenceID string) *app_errors.AppError {
+	args := m.Called(ctx, recurrenceID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListDueRecurrences(ctx context.Context, t tx.Tx, limit int) ([]entity.RecurrenceEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t, limit)
+	return args.Get(0).([]entity.RecurrenceEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertRecurringAufgabe(ctx context.Context, t tx.Tx, task *entity.AufgabenEntity) (bool, *app_errors.AppError) {
+	args := m.Called(ctx, t, task)
+	return args.Bool(0), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) AdvanceRecurrence(ctx context.Context, t tx.Tx, recurrenceID string, occurrenceAt, nextRunAt time.Time) *app_errors.AppError {
+	args := m.Called(ctx, t, recurrenceID, occurrenceAt, nextRunAt)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertTemplate(ctx context.Context, template *entity.TemplateEntity) *app_errors.AppError {
+	args := m.Called(ctx, template)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetTemplateByID(ctx context.Context, templateID string) (*entity.TemplateEntity, *app_errors.AppError) {
+	args := m.Called(ctx, templateID)
+	return args.Get(0).(*entity.TemplateEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListTemplates(ctx context.Context, projectID string) ([]entity.TemplateEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.TemplateEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateTemplate(ctx context.Context, template *entity.TemplateEntity) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, template)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteTemplate(ctx context.Context, templateID string) *app_errors.AppError {
+	args := m.Called(ctx, templateID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) LockAttachmentQuota(ctx context.Context, t tx.Tx, projectID string) (*entity.AttachmentQuota, *app_errors.AppError) {
+	args := m.Called(ctx, t, projectID)
+	return args.Get(0).(*entity.AttachmentQuota), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertAttachment(ctx context.Context, t tx.Tx, attachment *entity.AttachmentEntity) *app_errors.AppError {
+	args := m.Called(ctx, t, attachment)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetAttachmentByID(ctx context.Context, attachmentID string) (*entity.AttachmentEntity, *app_errors.AppError) {
+	args := m.Called(ctx, attachmentID)
+	return args.Get(0).(*entity.AttachmentEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListAttachmentsForTask(ctx context.Context, taskID string) ([]entity.AttachmentEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.AttachmentEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteAttachment(ctx context.Context, t tx.Tx, attachmentID string) *app_errors.AppError {
+	args := m.Called(ctx, t, attachmentID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) AddWatcher(ctx context.Context, taskID, userID string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, taskID, userID)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RemoveWatcher(ctx context.Context, taskID, userID string) *app_errors.AppError {
+	args := m.Called(ctx, taskID, userID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListWatchers(ctx context.Context, taskID string) ([]entity.WatcherEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.WatcherEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListWatcherContacts(ctx context.Context, taskID string) ([]entity.WatcherContact, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.WatcherContact), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertHandoverRequest(ctx context.Context, t tx.Tx, request *entity.HandoverRequestEntity) *app_errors.AppError {
+	args := m.Called(ctx, t, request)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListPendingHandoverRequests(ctx context.Context, projectID string) ([]entity.HandoverRequestEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.HandoverRequestEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetHandoverRequestForUpdate(ctx context.Context, t tx.Tx, requestID string) (*entity.HandoverRequestEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t, requestID)
+	return args.Get(0).(*entity.HandoverRequestEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DecideHandoverRequest(ctx context.Context, t tx.Tx, requestID string, status entity.HandoverRequestStatus, decidedBy string, reason *string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, requestID, status, decidedBy, reason)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ExpireHandoverRequests(ctx context.Context, t tx.Tx) ([]entity.HandoverRequestEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t)
+	return args.Get(0).([]entity.HandoverRequestEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) AddTaskAssignee(ctx context.Context, t tx.Tx, assignee *entity.AufgabenAssigneeEntity) *app_errors.AppError {
+	args := m.Called(ctx, t, assignee)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RemoveTaskAssignee(ctx context.Context, t tx.Tx, taskID, userID string) *app_errors.AppError {
+	args := m.Called(ctx, t, taskID, userID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetTaskAssigneeRole(ctx context.Context, taskID, userID string) (*entity.AssigneeRole, *app_errors.AppError) {
+	args := m.Called(ctx, taskID, userID)
+	return args.Get(0).(*entity.AssigneeRole), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListTaskAssignees(ctx context.Context, taskID string) ([]entity.AufgabenAssigneeEntity, *app_errors.AppError) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]entity.AufgabenAssigneeEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) LockNextAssignableTask(ctx context.Context, t tx.Tx, projectID string, dueDate *time.Time, skipIDs []string) (*string, *app_errors.AppError) {
+	args := m.Called(ctx, t, projectID, dueDate, skipIDs)
+	return args.Get(0).(*string), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListMemberWorkloads(ctx context.Context, projectID string, userID *string) ([]entity.MemberWorkload, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, userID)
+	return args.Get(0).([]entity.MemberWorkload), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetWorkloadThreshold(ctx context.Context, projectID string) (int, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Int(0), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateWorkloadThreshold(ctx context.Context, projectID string, threshold int) *app_errors.AppError {
+	args := m.Called(ctx, projectID, threshold)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpsertSLAPolicy(ctx context.Context, policy *entity.SLAPolicyEntity) *app_errors.AppError {
+	args := m.Called(ctx, policy)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListSLAPolicies(ctx context.Context, projectID string) ([]entity.SLAPolicyEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.SLAPolicyEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteSLAPolicy(ctx context.Context, projectID string, priority entity.AufgabenPriority) *app_errors.AppError {
+	args := m.Called(ctx, projectID, priority)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RecordSLABreaches(ctx context.Context, t tx.Tx) ([]entity.SLABreachEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t)
+	return args.Get(0).([]entity.SLABreachEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetSLACompliance(ctx context.Context, projectID string, filter *aufgaben_dto.SLAComplianceFilter) ([]entity.SLAComplianceRow, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, filter)
+	return args.Get(0).([]entity.SLAComplianceRow), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpsertEscalationLevel(ctx context.Context, level *entity.EscalationLevelEntity) *app_errors.AppError {
+	args := m.Called(ctx, level)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListEscalationLevels(ctx context.Context, projectID string) ([]entity.EscalationLevelEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.EscalationLevelEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteEscalationLevel(ctx context.Context, projectID string, level int) *app_errors.AppError {
+	args := m.Called(ctx, projectID, level)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RecordOverdueEscalations(ctx context.Context, t tx.Tx) ([]entity.OverdueEscalationEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t)
+	return args.Get(0).([]entity.OverdueEscalationEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListFlaggedTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.AufgabenEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListOverdueTasks(ctx context.Context, projectID string) ([]entity.AufgabenEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.AufgabenEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListBoardTasks(ctx context.Context, projectID string, status *entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, status)
+	return args.Get(0).([]entity.BoardTaskEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) LockBoardColumn(ctx context.Context, t tx.Tx, projectID, statusKey string) *app_errors.AppError {
+	args := m.Called(ctx, t, projectID, statusKey)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) LockBoardTasks(ctx context.Context, t tx.Tx, projectID string, status entity.AufgabenStatus) ([]entity.BoardTaskEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t, projectID, status)
+	return args.Get(0).([]entity.BoardTaskEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListBoardColumns(ctx context.Context, projectID string) ([]entity.BoardColumnEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.BoardColumnEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetBoardColumnWIPLimit(ctx context.Context, projectID, statusKey string) (*int, *app_errors.AppError) {
+	args := m.Called(ctx, projectID, statusKey)
+	return args.Get(0).(*int), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpsertBoardColumn(ctx context.Context, column *entity.BoardColumnEntity) *app_errors.AppError {
+	args := m.Called(ctx, column)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) DeleteBoardColumn(ctx context.Context, projectID, statusKey string) *app_errors.AppError {
+	args := m.Called(ctx, projectID, statusKey)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) MoveBoardTask(ctx context.Context, t tx.Tx, taskID, statusKey string, afterRank, beforeRank *string) (string, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, statusKey, afterRank, beforeRank)
+	return args.String(0), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertMilestone(ctx context.Context, milestone *entity.MilestoneEntity) *app_errors.AppError {
+	args := m.Called(ctx, milestone)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetMilestoneByID(ctx context.Context, milestoneID string) (*entity.MilestoneEntity, *app_errors.AppError) {
+	args := m.Called(ctx, milestoneID)
+	return args.Get(0).(*entity.MilestoneEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListMilestones(ctx context.Context, projectID string) ([]entity.MilestoneEntity, *app_errors.AppError) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]entity.MilestoneEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) UpdateMilestone(ctx context.Context, milestone *entity.MilestoneEntity) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, milestone)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) CloseMilestone(ctx context.Context, t tx.Tx, milestoneID, userID string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, milestoneID, userID)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) SetTaskMilestone(ctx context.Context, t tx.Tx, taskID string, milestoneID *string) (*time.Time, *app_errors.AppError) {
+	args := m.Called(ctx, t, taskID, milestoneID)
+	return args.Get(0).(*time.Time), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) CarryOverMilestoneTasks(ctx context.Context, t tx.Tx, fromID string, toID *string) ([]string, *app_errors.AppError) {
+	args := m.Called(ctx, t, fromID, toID)
+	return args.Get(0).([]string), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetMilestoneBurndown(ctx context.Context, milestoneID string) ([]entity.BurndownDay, *app_errors.AppError) {
+	args := m.Called(ctx, milestoneID)
+	return args.Get(0).([]entity.BurndownDay), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RevokeActiveCalendarFeed(ctx context.Context, t tx.Tx, userID string, projectID *string) *app_errors.AppError {
+	args := m.Called(ctx, t, userID, projectID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) InsertCalendarFeed(ctx context.Context, t tx.Tx, feed *entity.CalendarFeedEntity) (*entity.CalendarFeedEntity, *app_errors.AppError) {
+	args := m.Called(ctx, t, feed)
+	return args.Get(0).(*entity.CalendarFeedEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) ListCalendarFeeds(ctx context.Context, userID string) ([]entity.CalendarFeedEntity, *app_errors.AppError) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entity.CalendarFeedEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*entity.CalendarFeedEntity, *app_errors.AppError) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(*entity.CalendarFeedEntity), args.Get(1).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) TouchCalendarFeed(ctx context.Context, feedID string) *app_errors.AppError {
+	args := m.Called(ctx, feedID)
+	return args.Get(0).(*app_errors.AppError)
+}
+
+func (m *MockAufgabenRepo) RevokeCalendarFeed(ctx context.Context, feedID, userID string) *app_errors.AppError {
+	args := m.Called(ctx, feedID, userID)
+	return args.Get(0).(*app_errors.AppError)
+}
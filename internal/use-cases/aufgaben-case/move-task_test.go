@@ -0,0 +1,268 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - reorder inside the same column, no status event
+func TestMoveTask_ReorderInColumn(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	position := 0
+	inProgress := entity.AufgabenInProgress
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: "task-2", Status: entity.AufgabenInProgress, BoardRank: "1024"},
+		{ID: taskID, Status: entity.AufgabenInProgress, BoardRank: "2048"},
+		{ID: "task-3", Status: entity.AufgabenInProgress, BoardRank: "3072"},
+	}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	beforeRank := "1024"
+	repo.On("MoveBoardTask", ctx, tx, taskID, "In_Progress", (*string)(nil), &beforeRank).Return("0", (*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.MoveTask(ctx, userID, projectID, taskID, &aufgaben_dto.MoveBoardTaskRequest{Status: "In_Progress", Position: &position})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "In_Progress", resp.WorkflowStatus)
+	assert.Equal(t, "In_Progress", resp.PreviousStatus)
+	assert.Equal(t, 0, resp.Position)
+	assert.Equal(t, "0", resp.Rank)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertAssignmentEvent", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "GetBoardColumnWIPLimit", mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Happy path - moving into another column of the same category lands at the bottom and is recorded
+func TestMoveTask_ChangeColumn(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	inProgress := entity.AufgabenInProgress
+	inReview := "In_Review"
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Review").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Review").Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: taskID, Status: entity.AufgabenInProgress, BoardRank: "1024"},
+		{ID: "task-2", Status: entity.AufgabenInProgress, WorkflowStatus: &inReview, BoardRank: "4096"},
+	}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	afterRank := "4096"
+	repo.On("MoveBoardTask", ctx, tx, taskID, "In_Review", &afterRank, (*string)(nil)).Return("5120", (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionStatusChanged && *e.OldValue == "In_Progress" && *e.NewValue == "In_Review"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.MoveTask(ctx, userID, projectID, taskID, &aufgaben_dto.MoveBoardTaskRequest{Status: "In_Review"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "In_Review", resp.WorkflowStatus)
+	assert.Equal(t, "In_Progress", resp.PreviousStatus)
+	assert.Equal(t, 1, resp.Position)
+	assert.Equal(t, "5120", resp.Rank)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 3: Moving into a column of another category needs its own action
+func TestMoveTask_CategoryChange(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.MoveTask(ctx, userID, projectID, taskID, &aufgaben_dto.MoveBoardTaskRequest{Status: "Done"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.transition_requires_action", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+}
+
+// Test 4: The target column is full
+func TestMoveTask_WIPLimitReached(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	inProgress := entity.AufgabenInProgress
+	inReview := "In_Review"
+	limit := 1
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Review").Return((*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Review").Return(&limit, (*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: taskID, Status: entity.AufgabenInProgress, BoardRank: "1024"},
+		{ID: "task-2", Status: entity.AufgabenInProgress, WorkflowStatus: &inReview, BoardRank: "4096"},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.MoveTask(ctx, userID, projectID, taskID, &aufgaben_dto.MoveBoardTaskRequest{Status: "In_Review"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.wip_limit_reached", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "MoveBoardTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// Test 5: Neighbours are read on the tx after the column is locked, concurrent moves can't pick the same gap
+func TestMoveTask_ReadsNeighboursUnderColumnLock(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	position := 1
+	inProgress := entity.AufgabenInProgress
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: "task-2", Status: entity.AufgabenInProgress, BoardRank: "1024"},
+		{ID: "task-3", Status: entity.AufgabenInProgress, BoardRank: "2048"},
+	}, (*app_errors.AppError)(nil))
+
+	afterRank := "1024"
+	beforeRank := "2048"
+	repo.On("MoveBoardTask", ctx, tx, taskID, "In_Progress", &afterRank, &beforeRank).Return("1536", (*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.MoveTask(ctx, userID, projectID, taskID, &aufgaben_dto.MoveBoardTaskRequest{Status: "In_Progress", Position: &position})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "1536", resp.Rank)
+
+	var calls []string
+	for _, call := range repo.Calls {
+		switch call.Method {
+		case "LockBoardColumn", "LockBoardTasks", "MoveBoardTask":
+			calls = append(calls, call.Method)
+		}
+	}
+	assert.Equal(t, []string{"LockBoardColumn", "LockBoardTasks", "MoveBoardTask"}, calls)
+	repo.AssertNotCalled(t, "ListBoardTasks", mock.Anything, mock.Anything, mock.Anything)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
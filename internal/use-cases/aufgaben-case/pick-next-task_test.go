@@ -0,0 +1,341 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - next task is claimed with its own due date
+func TestPickNextTask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	dueDate := time.Now().Add(48 * time.Hour)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, (*time.Time)(nil), []string(nil)).Return(&taskID, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: projectID,
+		Title:     "Fix login",
+		Status:    entity.AufgabenTodo,
+		Priority:  entity.PriorityUrgent,
+		DueDate:   &dueDate,
+	}, (*app_errors.AppError)(nil))
+
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("AssignTask", ctx, tx, projectID, taskID, userID, &dueDate).Return(&entity.AssignTaskEntity{
+		ID:         taskID,
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityUrgent,
+		AssigneeID: userID,
+		CreatedBy:  "meister-1",
+		DueDate:    dueDate,
+	}, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionAssign && e.ActorID == userID && e.AufgabenID == taskID
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeAssigned
+	})).Return(nil)
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, taskID, resp.AufgabenID)
+	assert.Equal(t, userID, resp.AssigneeID)
+	assert.Equal(t, "Urgent", resp.Priority)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 2: Nothing left to pick
+func TestPickNextTask_NoTaskAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, (*time.Time)(nil), []string(nil)).Return((*string)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "no_task_available", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
+
+// Test 3: Top-priority task has no due date and none was given, the next task is picked
+func TestPickNextTask_SkipsTaskWithoutDueDate(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	// task-1 is Urgent but has no due date, the locking query leaves it out without a due date from the request
+	nextTaskID := "task-2"
+	dueDate := time.Now().Add(72 * time.Hour)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, (*time.Time)(nil), []string(nil)).Return(&nextTaskID, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, nextTaskID).Return(&entity.AufgabenEntity{
+		ID:        nextTaskID,
+		ProjectID: projectID,
+		Status:    entity.AufgabenTodo,
+		Priority:  entity.PriorityHigh,
+		DueDate:   &dueDate,
+	}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("AssignTask", ctx, tx, projectID, nextTaskID, userID, &dueDate).Return(&entity.AssignTaskEntity{
+		ID:         nextTaskID,
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityHigh,
+		AssigneeID: userID,
+		DueDate:    dueDate,
+	}, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, nextTaskID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.Anything).Return(nil)
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, nextTaskID, resp.AufgabenID)
+	assert.Equal(t, "High", resp.Priority)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 4: Due date from request is not far enough in the future
+func TestPickNextTask_RequestDueDateTooSoon(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	dueDate := time.Now().Add(30 * time.Minute)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{DueDate: &dueDate})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 5: Candidate the workflow rejects is skipped, the next one is picked with the request due date
+func TestPickNextTask_SkipsCandidateRejectedByWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	blockedTaskID := "task-1"
+	nextTaskID := "task-2"
+	dueDate := time.Now().Add(24 * time.Hour)
+	triage := "triage"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Triage has no way into work, only the backlog has
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(&entity.ProjectWorkflow{
+		ProjectID: projectID,
+		Statuses: []entity.WorkflowStatusEntity{
+			{Key: "backlog", Category: entity.AufgabenTodo, Position: 0},
+			{Key: triage, Category: entity.AufgabenTodo, Position: 1},
+			{Key: "doing", Category: entity.AufgabenInProgress, Position: 2},
+			{Key: "done", Category: entity.AufgabenDone, Position: 3},
+		},
+		Transitions: []entity.WorkflowTransitionEntity{
+			{FromKey: "backlog", ToKey: "doing"},
+			{FromKey: triage, ToKey: "backlog"},
+			{FromKey: "doing", ToKey: "done"},
+		},
+	}, (*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, &dueDate, []string(nil)).Return(&blockedTaskID, (*app_errors.AppError)(nil)).Once()
+	repo.On("GetTaskByID", ctx, blockedTaskID).Return(&entity.AufgabenEntity{ID: blockedTaskID, ProjectID: projectID, Status: entity.AufgabenTodo, WorkflowStatus: &triage}, (*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, &dueDate, []string{blockedTaskID}).Return(&nextTaskID, (*app_errors.AppError)(nil)).Once()
+	repo.On("GetTaskByID", ctx, nextTaskID).Return(&entity.AufgabenEntity{ID: nextTaskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "doing").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("AssignTask", ctx, tx, projectID, nextTaskID, userID, &dueDate).Return(&entity.AssignTaskEntity{
+		ID:         nextTaskID,
+		Status:     entity.AufgabenInProgress,
+		Priority:   entity.PriorityMedium,
+		AssigneeID: userID,
+		DueDate:    dueDate,
+	}, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, nextTaskID, "doing").Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueWatcherNotify", mock.Anything).Return(nil)
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{DueDate: &dueDate})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, nextTaskID, resp.AufgabenID)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 6: Every candidate hits the WIP limit
+func TestPickNextTask_AllCandidatesOverWIPLimit(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	dueDate := time.Now().Add(24 * time.Hour)
+	limit := 1
+	inProgress := entity.AufgabenInProgress
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, (*time.Time)(nil), []string(nil)).Return(&taskID, (*app_errors.AppError)(nil)).Once()
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo, DueDate: &dueDate}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return(&limit, (*app_errors.AppError)(nil))
+	repo.On("LockBoardColumn", ctx, tx, projectID, "In_Progress").Return((*app_errors.AppError)(nil))
+	repo.On("LockBoardTasks", ctx, tx, projectID, inProgress).Return([]entity.BoardTaskEntity{
+		{ID: "task-9", Status: entity.AufgabenInProgress},
+	}, (*app_errors.AppError)(nil))
+	repo.On("LockNextAssignableTask", ctx, tx, projectID, (*time.Time)(nil), []string{taskID}).Return((*string)(nil), (*app_errors.AppError)(nil)).Once()
+
+	// Execute
+	resp, err := service.PickNextTask(ctx, userID, projectID, &aufgaben_dto.PickNextAufgabeRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.wip_limit_reached", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AssignTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
@@ -88,6 +88,10 @@ func TestReassignTask_Success_Meister(t *testing.T) {
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
 	// Commit
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeAssigned
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
 	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
@@ -161,6 +165,11 @@ func TestReassignTask_Success_Mitarbeiter(t *testing.T) {
 	// Transaction
 	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
 
+	// InsertHandoverRequest, the request stays pending for the meister
+	repo.On("InsertHandoverRequest", ctx, tx, mock.MatchedBy(func(r *entity.HandoverRequestEntity) bool {
+		return r.AufgabenID == taskID && r.RequestedBy == mitarbeiterID && r.TargetAssigneeID == targetID && r.Status == entity.HandoverPending
+	})).Return((*app_errors.AppError)(nil))
+
 	// InsertAssignmentEvent (no AssignTask for MITARBEITER, just event)
 	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
 
@@ -170,7 +179,7 @@ func TestReassignTask_Success_Mitarbeiter(t *testing.T) {
 
 	// EnqueueHandoverRequestNotifyMeister with proper payload type
 	taskQueue.On("EnqueueHandoverRequestNotifyMeister", mock.MatchedBy(func(p *worker_task.HandoverRequestNotifyMeister) bool {
-		return p.AufgabeID == taskID && p.TargetAssigneeID == targetID
+		return p.AufgabeID == taskID && p.TargetAssigneeID == targetID && p.RequestID != "" && p.ExpiresAt.After(p.RequestedAt)
 	})).Return(nil)
 
 	// Execute
@@ -181,6 +190,7 @@ func TestReassignTask_Success_Mitarbeiter(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, taskID, resp.AufgabenID)
 	assert.Equal(t, string(entity.ActionHandoverRequest), resp.Action)
+	assert.NotNil(t, resp.RequestID)
 
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
@@ -342,3 +352,62 @@ func TestReassignTask_UserNotProjectMember(t *testing.T) {
 
 	repo.AssertExpectations(t)
 }
+
+// Test 6: MITARBEITER already has a pending handover request for the task
+func TestReassignTask_Mitarbeiter_RequestAlreadyPending(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	mitarbeiterID := "mitarbeiter-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	req := &aufgaben_dto.ReassignAufgabenRequest{
+		TargetID: "user-2",
+		Note:     "Can you help?",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, mitarbeiterID).Return(true, (*app_errors.AppError)(nil))
+
+	dueDate := time.Now().Add(48 * time.Hour)
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		ProjectID:  projectID,
+		Title:      "Test Task",
+		Status:     entity.AufgabenInProgress,
+		AssigneeID: &mitarbeiterID,
+		DueDate:    &dueDate,
+	}
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	mitarbeiterRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, mitarbeiterID).Return(&mitarbeiterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Unique index on pending requests
+	repo.On("InsertHandoverRequest", ctx, tx, mock.Anything).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", nil))
+
+	// Execute
+	resp, err := service.ReassignTask(ctx, mitarbeiterID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.handover_request_pending", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertAssignmentEvent", mock.Anything, mock.Anything, mock.Anything)
+	taskQueue.AssertNotCalled(t, "EnqueueHandoverRequestNotifyMeister", mock.Anything)
+}
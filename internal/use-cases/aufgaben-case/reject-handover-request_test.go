@@ -0,0 +1,127 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - rejection keeps the assignee and notifies the requester
+func TestRejectHandoverRequest_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	taskQueue := new(use_cases.MockTaskQueue)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		taskQueue: taskQueue,
+	}
+
+	meisterID := "meister-1"
+	requesterID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	requestID := "request-1"
+
+	req := &aufgaben_dto.RejectHandoverRequest{Reason: "Deadline is close, please finish it"}
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(&entity.HandoverRequestEntity{
+		ID:               requestID,
+		AufgabenID:       taskID,
+		ProjectID:        projectID,
+		RequestedBy:      requesterID,
+		TargetAssigneeID: "user-2",
+		Status:           entity.HandoverPending,
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Title: "Deploy", AssigneeID: &requesterID}, (*app_errors.AppError)(nil))
+
+	decidedAt := time.Now()
+	repo.On("DecideHandoverRequest", ctx, tx, requestID, entity.HandoverRejected, meisterID, &req.Reason).Return(&decidedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionHandoverRejected && e.ActorID == meisterID
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	taskQueue.On("EnqueueHandoverDecisionNotify", mock.MatchedBy(func(p *worker_task.HandoverDecisionNotify) bool {
+		return p.RequestID == requestID && p.RequesterID == requesterID && p.Outcome == "Rejected" && p.Reason == req.Reason
+	})).Return(nil).Once()
+
+	// Execute
+	resp, err := service.RejectHandoverRequest(ctx, meisterID, projectID, requestID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "Rejected", resp.Status)
+	assert.Equal(t, meisterID, *resp.DecidedBy)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AssignTask", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
+}
+
+// Test 2: Request was already decided
+func TestRejectHandoverRequest_AlreadyDecided(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	requestID := "request-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("GetHandoverRequestForUpdate", ctx, tx, requestID).Return(&entity.HandoverRequestEntity{
+		ID:        requestID,
+		ProjectID: projectID,
+		Status:    entity.HandoverApproved,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.RejectHandoverRequest(ctx, meisterID, projectID, requestID, &aufgaben_dto.RejectHandoverRequest{Reason: "too late"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.handover_request_closed", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DecideHandoverRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
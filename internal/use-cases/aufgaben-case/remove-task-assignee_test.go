@@ -0,0 +1,120 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - collaborator leaves the task themselves
+func TestRemoveTaskAssignee_SelfLeave(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	ownerID := "user-1"
+	collaboratorID := "user-2"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, collaboratorID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	role := entity.AssigneeCollaborator
+	repo.On("GetTaskAssigneeRole", ctx, taskID, collaboratorID).Return(&role, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("RemoveTaskAssignee", ctx, tx, taskID, collaboratorID).Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionParticipantRemoved && *e.TargetAssigneeID == collaboratorID && *e.OldValue == "Collaborator"
+	})).Return((*app_errors.AppError)(nil))
+
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.RemoveTaskAssignee(ctx, collaboratorID, projectID, taskID, collaboratorID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetUserRole", ctx, projectID, collaboratorID)
+	tx.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
+
+// Test 2: Owner can't be removed as a participant
+func TestRemoveTaskAssignee_OwnerNotRemovable(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	ownerID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	userRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&userRole, (*app_errors.AppError)(nil))
+	role := entity.AssigneeOwner
+	repo.On("GetTaskAssigneeRole", ctx, taskID, ownerID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.RemoveTaskAssignee(ctx, meisterID, projectID, taskID, ownerID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_owner_not_removable", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Target is not a participant of the task
+func TestRemoveTaskAssignee_NotAssignee(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	ownerID := "user-1"
+	targetID := "user-3"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, ownerID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, AssigneeID: &ownerID}, (*app_errors.AppError)(nil))
+	userRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, ownerID).Return(&userRole, (*app_errors.AppError)(nil))
+	repo.On("GetTaskAssigneeRole", ctx, taskID, targetID).Return((*entity.AssigneeRole)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.RemoveTaskAssignee(ctx, ownerID, projectID, taskID, targetID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "task_assignee_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
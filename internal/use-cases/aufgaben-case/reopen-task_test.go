@@ -0,0 +1,154 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestReopenTask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	assigneeID := "user-2"
+
+	completedAt := time.Now().Add(-time.Hour)
+	task := &entity.AufgabenEntity{
+		ID:          taskID,
+		ProjectID:   projectID,
+		Status:      entity.AufgabenDone,
+		AssigneeID:  &assigneeID,
+		CompletedAt: &completedAt,
+	}
+
+	note := "Bug came back in staging"
+	req := &aufgaben_dto.RestoreAufgabenRequest{
+		Note:       &note,
+		ReasonCode: string(entity.ReasonDefectFound),
+		Reason:     "Regression",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// workflow check, project uses the default workflow
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Progress").Return((*int)(nil), (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "In_Progress").Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("ReopenTask", ctx, tx, taskID).Return(entity.AufgabenInProgress, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionTaskReopened && e.ReasonCode == entity.ReasonDefectFound && *e.Note == note
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ReopenTask(ctx, userID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, string(entity.AufgabenInProgress), resp.Status)
+	assert.Equal(t, "Regression", resp.Reason)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Task is not done
+func TestReopenTask_NotDone(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress}, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ReopenTask(ctx, userID, projectID, taskID, &aufgaben_dto.RestoreAufgabenRequest{ReasonCode: "Other", Reason: "why not"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_not_done", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Archived task has to be unarchived first
+func TestReopenTask_TaskArchived(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	archivedAt := time.Now()
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone, ArchivedAt: &archivedAt}, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.ReopenTask(ctx, userID, projectID, taskID, &aufgaben_dto.RestoreAufgabenRequest{ReasonCode: "Mistake", Reason: "oops"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_unavailable", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
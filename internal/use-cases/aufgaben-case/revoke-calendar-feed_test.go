@@ -0,0 +1,58 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - owner revokes the feed
+func TestRevokeCalendarFeed_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	feedID := "feed-1"
+
+	repo.On("RevokeCalendarFeed", ctx, feedID, userID).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	err := service.RevokeCalendarFeed(ctx, userID, feedID)
+
+	// Assert
+	assert.Nil(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Feed of someone else or already revoked
+func TestRevokeCalendarFeed_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	feedID := "feed-2"
+
+	repo.On("RevokeCalendarFeed", ctx, feedID, userID).Return(app_errors.NewAppError(fiber.StatusNotFound, app_errors.ErrNotFound, "calendar_feed_not_found", nil))
+
+	// Execute
+	err := service.RevokeCalendarFeed(ctx, userID, feedID)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "calendar_feed_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,79 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestSearchTasksProject_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	commentSnippet := "see <mark>login</mark> logs"
+
+	filter := aufgaben_dto.AufgabenSearchFilter{
+		Query: "login",
+		Limit: 10,
+		Page:  1,
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("SearchTasks", ctx, userID, mock.MatchedBy(func(f *aufgaben_dto.AufgabenSearchFilter) bool {
+		return f.ProjectID != nil && *f.ProjectID == projectID && f.Query == "login"
+	})).Return([]entity.AufgabenSearchResult{
+		{ID: "task-1", ProjectID: projectID, Title: "Fix login", Status: entity.AufgabenInProgress, Rank: 0.6, Snippet: "Fix <mark>login</mark>", Total: 12},
+		{ID: "task-2", ProjectID: projectID, Title: "Audit", Rank: 0.1, Snippet: "Audit", CommentSnippet: &commentSnippet, Total: 12},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, paging, err := service.SearchTasksProject(ctx, userID, projectID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "task-1", resp[0].AufgabenID)
+	assert.Equal(t, "Fix <mark>login</mark>", resp[0].Snippet)
+	assert.Equal(t, &commentSnippet, resp[1].CommentSnippet)
+	assert.Equal(t, 12, paging.Total)
+	assert.Equal(t, 2, paging.TotalPages)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Performer is not a project member
+func TestSearchTasksProject_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, paging, err := service.SearchTasksProject(ctx, "user-1", "project-1", aufgaben_dto.AufgabenSearchFilter{Query: "login", Limit: 10, Page: 1})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.Nil(t, paging)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertNotCalled(t, "SearchTasks", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
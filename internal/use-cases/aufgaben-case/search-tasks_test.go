@@ -0,0 +1,100 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path across all projects of the user
+func TestSearchTasks_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	filter := aufgaben_dto.AufgabenSearchFilter{
+		Query: "deploy",
+		Limit: 20,
+		Page:  1,
+	}
+
+	repo.On("SearchTasks", ctx, userID, mock.MatchedBy(func(f *aufgaben_dto.AufgabenSearchFilter) bool {
+		return f.ProjectID == nil && f.Query == "deploy"
+	})).Return([]entity.AufgabenSearchResult{
+		{ID: "task-1", ProjectID: "project-1", ProjectName: "Alpha", Title: "Deploy", Total: 2},
+		{ID: "task-2", ProjectID: "project-2", ProjectName: "Beta", Title: "Deploy docs", Total: 2},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, paging, err := service.SearchTasks(ctx, userID, filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "Beta", resp[1].ProjectName)
+	assert.Equal(t, 2, paging.Total)
+	assert.Equal(t, 1, paging.TotalPages)
+
+	repo.AssertNotCalled(t, "CheckProjectMember", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 2: No matches returns empty list
+func TestSearchTasks_NoResults(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	filter := aufgaben_dto.AufgabenSearchFilter{Query: "nothing", Limit: 20, Page: 1}
+
+	repo.On("SearchTasks", ctx, "user-1", &filter).Return([]entity.AufgabenSearchResult(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, paging, err := service.SearchTasks(ctx, "user-1", filter)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Empty(t, resp)
+	assert.Equal(t, 0, paging.Total)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Project filter given but user is not member there
+func TestSearchTasks_ProjectFilterNotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	projectID := "project-9"
+	filter := aufgaben_dto.AufgabenSearchFilter{Query: "deploy", ProjectID: &projectID, Limit: 20, Page: 1}
+
+	repo.On("CheckProjectMember", ctx, projectID, "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, _, err := service.SearchTasks(ctx, "user-1", filter)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertNotCalled(t, "SearchTasks", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,184 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Put a backlog task into the sprint
+func TestSetTaskMilestone_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	milestoneID := "ms-1"
+	updatedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("SetTaskMilestone", ctx, tx, taskID, &milestoneID).Return(&updatedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionMilestoneChanged && *e.FieldName == "milestone" && e.OldValue == nil && *e.NewValue == milestoneID
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SetTaskMilestone(ctx, userID, projectID, taskID, &aufgaben_dto.SetTaskMilestoneRequest{MilestoneID: &milestoneID})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, &milestoneID, resp.MilestoneID)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Move a task back to the backlog
+func TestSetTaskMilestone_Clear(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	milestoneID := "ms-1"
+	updatedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo, MilestoneID: &milestoneID}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("SetTaskMilestone", ctx, tx, taskID, (*string)(nil)).Return(&updatedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionMilestoneChanged && *e.OldValue == milestoneID && e.NewValue == nil
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SetTaskMilestone(ctx, userID, projectID, taskID, &aufgaben_dto.SetTaskMilestoneRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Nil(t, resp.MilestoneID)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 3: Tasks can't leave a closed milestone
+func TestSetTaskMilestone_CurrentClosed(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	milestoneID := "ms-1"
+	nextID := "ms-2"
+	closedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone, MilestoneID: &milestoneID}, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1", ClosedAt: &closedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SetTaskMilestone(ctx, userID, projectID, taskID, &aufgaben_dto.SetTaskMilestoneRequest{MilestoneID: &nextID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.milestone_closed", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+}
+
+// Test 4: Task already is in the milestone
+func TestSetTaskMilestone_NoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	milestoneID := "ms-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo, MilestoneID: &milestoneID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SetTaskMilestone(ctx, userID, projectID, taskID, &aufgaben_dto.SetTaskMilestoneRequest{MilestoneID: &milestoneID})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.no_changes", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
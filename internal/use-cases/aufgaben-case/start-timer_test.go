@@ -0,0 +1,134 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Member starts a timer on an open task
+func TestStartTimer_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	note := "  pairing session  "
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return((*entity.WorklogEntity)(nil), (*app_errors.AppError)(nil))
+	repo.On("InsertWorklog", ctx, mock.MatchedBy(func(w *entity.WorklogEntity) bool {
+		return w.AufgabenID == taskID && w.UserID == userID && w.Source == entity.WorklogTimer && w.EndedAt == nil && *w.Note == "pairing session"
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StartTimer(ctx, userID, projectID, taskID, &aufgaben_dto.StartTimerRequest{Note: &note})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, resp.Running)
+	assert.Nil(t, resp.DurationMinutes)
+	assert.Equal(t, "Timer", resp.Source)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: User already has a running timer
+func TestStartTimer_AlreadyRunning(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return(&entity.WorklogEntity{ID: "worklog-1", AufgabenID: "task-2", UserID: userID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StartTimer(ctx, userID, projectID, taskID, &aufgaben_dto.StartTimerRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.timer_already_running", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertWorklog", mock.Anything, mock.Anything)
+}
+
+// Test 3: Concurrent start hits the unique running index
+func TestStartTimer_ConcurrentStart(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return((*entity.WorklogEntity)(nil), (*app_errors.AppError)(nil))
+	repo.On("InsertWorklog", ctx, mock.Anything).Return(app_errors.NewAppError(fiber.StatusConflict, app_errors.ErrConflict, "conflict", nil))
+
+	// Execute
+	resp, err := service.StartTimer(ctx, userID, projectID, taskID, &aufgaben_dto.StartTimerRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "conflict.timer_already_running", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Done tasks don't accept timers
+func TestStartTimer_TaskDone(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StartTimer(ctx, userID, projectID, taskID, &aufgaben_dto.StartTimerRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetRunningWorklog", ctx, userID)
+}
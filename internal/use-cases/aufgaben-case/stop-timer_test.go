@@ -0,0 +1,102 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: User stops own running timer
+func TestStopTimer_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	startedAt := time.Now().Add(-90 * time.Minute)
+	endedAt := startedAt.Add(90 * time.Minute)
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return(&entity.WorklogEntity{ID: "worklog-1", AufgabenID: taskID, UserID: userID, Source: entity.WorklogTimer, StartedAt: startedAt}, (*app_errors.AppError)(nil))
+	repo.On("StopWorklog", ctx, "worklog-1").Return(&endedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StopTimer(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.False(t, resp.Running)
+	assert.Equal(t, 90, *resp.DurationMinutes)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Running timer belongs to another task
+func TestStopTimer_OtherTask(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return(&entity.WorklogEntity{ID: "worklog-1", AufgabenID: "task-2", UserID: userID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StopTimer(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "timer_not_running", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "StopWorklog", ctx, "worklog-1")
+}
+
+// Test 3: No timer running at all
+func TestStopTimer_NotRunning(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetRunningWorklog", ctx, userID).Return((*entity.WorklogEntity)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.StopTimer(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "timer_not_running", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
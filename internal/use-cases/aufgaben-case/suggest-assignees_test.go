@@ -0,0 +1,90 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test 1: Happy path - members are ranked by load, current assignee left out
+func TestSuggestAssignees_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	currentAssignee := "user-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&meisterRole, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{
+		ID:         taskID,
+		ProjectID:  projectID,
+		Status:     entity.AufgabenInProgress,
+		AssigneeID: &currentAssignee,
+	}, (*app_errors.AppError)(nil))
+	repo.On("GetWorkloadThreshold", ctx, projectID).Return(5, (*app_errors.AppError)(nil))
+	repo.On("ListMemberWorkloads", ctx, projectID, (*string)(nil)).Return([]entity.MemberWorkload{
+		{UserID: currentAssignee, Username: "anna", Role: entity.MITARBEITER, OpenAssignments: 1},
+		// 2 open + 2*1 overdue + 1.5*1 urgent = 5.5
+		{UserID: "user-2", Username: "ben", Role: entity.MITARBEITER, OpenAssignments: 2, OverdueCount: 1, UrgentOpen: 1},
+		// 3*1 overload = 3
+		{UserID: "user-3", Username: "carl", Role: entity.MITARBEITER, RecentOverloads: 1},
+		{UserID: meisterID, Username: "meister", Role: entity.MEISTER},
+	}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SuggestAssignees(ctx, meisterID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, resp, 3)
+	assert.Equal(t, meisterID, resp[0].UserID)
+	assert.Equal(t, "user-3", resp[1].UserID)
+	assert.Equal(t, 3.0, resp[1].LoadScore)
+	assert.False(t, resp[1].AboveThreshold)
+	assert.Equal(t, "user-2", resp[2].UserID)
+	assert.Equal(t, 5.5, resp[2].LoadScore)
+	assert.True(t, resp[2].AboveThreshold)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't see the workload of other members
+func TestSuggestAssignees_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.SuggestAssignees(ctx, userID, projectID, taskID)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ListMemberWorkloads", ctx, projectID, (*string)(nil))
+}
@@ -0,0 +1,158 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path, move into review
+func TestTransitionTask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	task := &entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, AssigneeID: &userID}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	repo.On("GetBoardColumnWIPLimit", ctx, projectID, "In_Review").Return((*int)(nil), (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("UpdateWorkflowStatus", ctx, tx, taskID, "In_Review").Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionStatusChanged && *e.OldValue == "In_Progress" && *e.NewValue == "In_Review"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.TransitionTask(ctx, userID, projectID, taskID, &aufgaben_dto.TransitionTaskRequest{Status: "In_Review"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "In_Review", resp.WorkflowStatus)
+	assert.Equal(t, "In_Progress", resp.PreviousStatus)
+	assert.Equal(t, string(entity.AufgabenInProgress), resp.Status)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Transition is not defined in the workflow
+func TestTransitionTask_NotAllowed(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	workflow := reviewWorkflow(projectID)
+	workflow.Statuses = append(workflow.Statuses, entity.WorkflowStatusEntity{Key: "Blocked", Name: "Blocked", Category: entity.AufgabenInProgress, Position: 4})
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(workflow, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.TransitionTask(ctx, userID, projectID, taskID, &aufgaben_dto.TransitionTaskRequest{Status: "Blocked"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.transition_not_allowed", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Changing the category has to go through its action
+func TestTransitionTask_RequiresAction(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	reviewKey := "In_Review"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenInProgress, WorkflowStatus: &reviewKey}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.TransitionTask(ctx, userID, projectID, taskID, &aufgaben_dto.TransitionTaskRequest{Status: "Done"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "conflict.transition_requires_action", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Unknown workflow status
+func TestTransitionTask_UnknownStatus(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.TransitionTask(ctx, userID, projectID, taskID, &aufgaben_dto.TransitionTaskRequest{Status: "In_Review"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+}
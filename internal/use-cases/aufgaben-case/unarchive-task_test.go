@@ -0,0 +1,144 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path
+func TestUnarchiveTask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	archivedAt := time.Now().Add(-time.Hour)
+	task := &entity.AufgabenEntity{
+		ID:         taskID,
+		ProjectID:  projectID,
+		Status:     entity.AufgabenArchived,
+		ArchivedAt: &archivedAt,
+	}
+
+	req := &aufgaben_dto.RestoreAufgabenRequest{
+		ReasonCode: string(entity.ReasonMistake),
+		Reason:     "Archived the wrong task",
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockProjectDependencies", ctx, tx, projectID).Return((*app_errors.AppError)(nil))
+	repo.On("UnarchiveTask", ctx, tx, taskID).Return(entity.AufgabenTodo, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionTaskUnarchived && e.ReasonCode == entity.ReasonMistake && *e.ReasonText == req.Reason
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UnarchiveTask(ctx, userID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, string(entity.AufgabenTodo), resp.Status)
+	assert.Equal(t, string(entity.ActionTaskUnarchived), resp.Action)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Task is not archived
+func TestUnarchiveTask_NotArchived(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UnarchiveTask(ctx, userID, projectID, taskID, &aufgaben_dto.RestoreAufgabenRequest{ReasonCode: "Other", Reason: "why not"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_not_archived", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Performer is not Meister
+func TestUnarchiveTask_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	archivedAt := time.Now()
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, ArchivedAt: &archivedAt}, (*app_errors.AppError)(nil))
+
+	mitarbeiterRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&mitarbeiterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UnarchiveTask(ctx, userID, projectID, taskID, &aufgaben_dto.RestoreAufgabenRequest{ReasonCode: "Mistake", Reason: "oops"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
 	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
 	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,9 +22,17 @@ func TestUpdateDueDate_Success(t *testing.T) {
 	repo := new(MockAufgabenRepo)
 	txManager := new(use_cases.MockTxManager)
 	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	taskQueue := new(use_cases.MockTaskQueue)
 	service := &AufgabenService{
 		repo:      repo,
 		txManager: txManager,
+		cache:     cache,
+		taskQueue: taskQueue,
 	}
 
 	userID := "user-1"
@@ -60,9 +69,15 @@ func TestUpdateDueDate_Success(t *testing.T) {
 	repo.On("UpdateDueDate", ctx, tx, taskID, newDueDate).Return(&newDueDate, (*app_errors.AppError)(nil))
 
 	// InsertAssignmentEvent
-	repo.On("InsertAssignmentEvent", ctx, tx, mock.Anything).Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return *e.FieldName == "due_date" && *e.OldValue == oldDueDate.Format(time.RFC3339) && *e.NewValue == newDueDate.Format(time.RFC3339)
+	})).Return((*app_errors.AppError)(nil))
 
 	// Commit
+	taskQueue.On("EnqueueWatcherNotify", mock.MatchedBy(func(p *worker_task.WatcherNotify) bool {
+		return p.AufgabeID == taskID && p.Change == worker_task.WatcherChangeDueDateChanged
+	})).Return(nil)
+
 	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
 	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
 
@@ -74,10 +89,12 @@ func TestUpdateDueDate_Success(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, taskID, resp.AufgabenID)
 	assert.Equal(t, newDueDate, resp.DueDate)
+	assert.Equal(t, 1, cache.DelCalled)
 
 	repo.AssertExpectations(t)
 	tx.AssertExpectations(t)
 	txManager.AssertExpectations(t)
+	taskQueue.AssertExpectations(t)
 }
 
 // Test 2: User is not a project member
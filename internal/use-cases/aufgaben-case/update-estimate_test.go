@@ -0,0 +1,176 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Estimate a task in story points
+func TestUpdateEstimate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	estimate := 5.0
+	unit := "Points"
+	updatedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Status: entity.AufgabenDone}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("UpdateEstimate", ctx, tx, taskID, mock.MatchedBy(func(e *float64) bool { return *e == 5 }), mock.MatchedBy(func(u *entity.EstimateUnit) bool { return *u == entity.EstimatePoints })).Return(&updatedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionTaskUpdated && *e.FieldName == "estimate" && e.OldValue == nil && *e.NewValue == "5 Points"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateEstimate(ctx, userID, projectID, taskID, &aufgaben_dto.UpdateEstimateRequest{Estimate: &estimate, EstimateUnit: &unit})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 5.0, *resp.Estimate)
+	assert.Equal(t, "Points", *resp.EstimateUnit)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Clearing an estimate
+func TestUpdateEstimate_Clear(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	oldEstimate := 4.5
+	oldUnit := entity.EstimateHours
+	updatedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Estimate: &oldEstimate, EstimateUnit: &oldUnit}, (*app_errors.AppError)(nil))
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	repo.On("UpdateEstimate", ctx, tx, taskID, (*float64)(nil), (*entity.EstimateUnit)(nil)).Return(&updatedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return *e.OldValue == "4.5 Hours" && e.NewValue == nil
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateEstimate(ctx, userID, projectID, taskID, &aufgaben_dto.UpdateEstimateRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Estimate)
+	assert.Nil(t, resp.EstimateUnit)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Same estimate produces no change
+func TestUpdateEstimate_NoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	estimate := 3.0
+	unit := "Points"
+	oldUnit := entity.EstimatePoints
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, Estimate: &estimate, EstimateUnit: &oldUnit}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateEstimate(ctx, userID, projectID, taskID, &aufgaben_dto.UpdateEstimateRequest{Estimate: &estimate, EstimateUnit: &unit})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.no_changes", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "Begin", ctx)
+}
+
+// Test 4: Archived tasks can't be estimated
+func TestUpdateEstimate_TaskArchived(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	estimate := 2.0
+	unit := "Hours"
+	archivedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID, ArchivedAt: &archivedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateEstimate(ctx, userID, projectID, taskID, &aufgaben_dto.UpdateEstimateRequest{Estimate: &estimate, EstimateUnit: &unit})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.task_unavailable", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,152 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Meister extends the sprint by a few days and clears the goal
+func TestUpdateMilestone_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	goal := "Ship the board"
+	emptyGoal := ""
+	endDate := "2026-03-18"
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1", Goal: &goal, StartDate: start, EndDate: start.AddDate(0, 0, 13)}, (*app_errors.AppError)(nil))
+	repo.On("UpdateMilestone", ctx, mock.MatchedBy(func(m *entity.MilestoneEntity) bool {
+		return m.Goal == nil && m.StartDate.Equal(start) && m.EndDate.Format(time.DateOnly) == endDate
+	})).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.UpdateMilestoneRequest{
+		Goal:    &emptyGoal,
+		EndDate: &endDate,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Nil(t, resp.Goal)
+	assert.Equal(t, endDate, resp.EndDate)
+	assert.Equal(t, &updatedAt, resp.UpdatedAt)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: New start date behind the stored end date
+func TestUpdateMilestone_InvalidDateRange(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	startDate := "2026-04-01"
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 13)}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.UpdateMilestoneRequest{StartDate: &startDate})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_date_range", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateMilestone", mock.Anything, mock.Anything)
+}
+
+// Test 3: Closed milestones are read only
+func TestUpdateMilestone_Closed(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	name := "Sprint 1b"
+	closedAt := time.Now()
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: projectID, Name: "Sprint 1", ClosedAt: &closedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.UpdateMilestoneRequest{Name: &name})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.milestone_closed", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Milestone of another project
+func TestUpdateMilestone_OtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	milestoneID := "ms-1"
+	name := "Sprint 1b"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetMilestoneByID", ctx, milestoneID).Return(&entity.MilestoneEntity{ID: milestoneID, ProjectID: "project-2", Name: "Sprint 1"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateMilestone(ctx, userID, projectID, milestoneID, &aufgaben_dto.UpdateMilestoneRequest{Name: &name})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "milestone_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
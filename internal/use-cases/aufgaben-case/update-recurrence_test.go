@@ -0,0 +1,124 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Changing the rule reschedules the series
+func TestUpdateRecurrence_Reschedule(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	recurrenceID := "rec-1"
+	role := entity.MEISTER
+	oldNextRun := time.Now().Add(-time.Hour)
+	updatedAt := time.Now()
+	cronExpr := "0 7 * * *"
+	clearAssignee := ""
+	assigneeID := "user-2"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetRecurrenceByID", ctx, recurrenceID).Return(&entity.RecurrenceEntity{
+		ID: recurrenceID, ProjectID: projectID, Title: "Standup notes", Priority: entity.PriorityMedium,
+		DefaultAssigneeID: &assigneeID, CronExpr: "@weekly", NextRunAt: oldNextRun, Active: true,
+	}, (*app_errors.AppError)(nil))
+	repo.On("UpdateRecurrence", ctx, mock.MatchedBy(func(r *entity.RecurrenceEntity) bool {
+		return r.CronExpr == cronExpr && r.NextRunAt.After(time.Now()) && r.NextRunAt.Hour() == 7 && r.DefaultAssigneeID == nil
+	})).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateRecurrence(ctx, userID, projectID, recurrenceID, &aufgaben_dto.UpdateRecurrenceRequest{
+		CronExpr:          &cronExpr,
+		DefaultAssigneeID: &clearAssignee,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, cronExpr, resp.CronExpr)
+	assert.Nil(t, resp.DefaultAssigneeID)
+	assert.NotNil(t, resp.UpdatedAt)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Pausing keeps the schedule untouched
+func TestUpdateRecurrence_Pause(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	recurrenceID := "rec-1"
+	role := entity.MEISTER
+	nextRun := time.Now().Add(48 * time.Hour)
+	updatedAt := time.Now()
+	active := false
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetRecurrenceByID", ctx, recurrenceID).Return(&entity.RecurrenceEntity{ID: recurrenceID, ProjectID: projectID, CronExpr: "@weekly", NextRunAt: nextRun, Active: true}, (*app_errors.AppError)(nil))
+	repo.On("UpdateRecurrence", ctx, mock.MatchedBy(func(r *entity.RecurrenceEntity) bool {
+		return !r.Active && r.NextRunAt.Equal(nextRun)
+	})).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateRecurrence(ctx, userID, projectID, recurrenceID, &aufgaben_dto.UpdateRecurrenceRequest{Active: &active})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, resp.Active)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Recurrence of another project
+func TestUpdateRecurrence_WrongProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	recurrenceID := "rec-1"
+	role := entity.MEISTER
+	title := "New title"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetRecurrenceByID", ctx, recurrenceID).Return(&entity.RecurrenceEntity{ID: recurrenceID, ProjectID: "project-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateRecurrence(ctx, userID, projectID, recurrenceID, &aufgaben_dto.UpdateRecurrenceRequest{Title: &title})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "recurrence_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateRecurrence", mock.Anything, mock.Anything)
+}
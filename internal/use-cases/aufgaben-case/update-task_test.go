@@ -0,0 +1,177 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path, only changed fields produce events
+func TestUpdateTask_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	oldDescription := "Old description"
+	task := &entity.AufgabenEntity{
+		ID:          taskID,
+		ProjectID:   projectID,
+		Title:       "Old title",
+		Description: &oldDescription,
+		Status:      entity.AufgabenInProgress,
+		Priority:    entity.PriorityLow,
+	}
+
+	newTitle := "  New title "
+	samePriority := "Low"
+	emptyDescription := ""
+	req := &aufgaben_dto.UpdateAufgabenRequest{
+		Title:       &newTitle,
+		Description: &emptyDescription,
+		Priority:    &samePriority,
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	updatedAt := time.Now()
+	repo.On("UpdateTaskContent", ctx, tx, taskID, "New title", (*string)(nil), entity.PriorityLow).Return(&updatedAt, (*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionTaskUpdated && *e.FieldName == "title" && *e.OldValue == "Old title" && *e.NewValue == "New title"
+	})).Return((*app_errors.AppError)(nil)).Once()
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.Action == entity.ActionTaskUpdated && *e.FieldName == "description" && *e.OldValue == oldDescription && e.NewValue == nil
+	})).Return((*app_errors.AppError)(nil)).Once()
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateTask(ctx, userID, projectID, taskID, req)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "New title", resp.Title)
+	assert.Nil(t, resp.Description)
+	assert.Equal(t, "Low", resp.Priority)
+	assert.Len(t, resp.Changes, 2)
+	assert.Equal(t, updatedAt, resp.UpdatedAt)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Nothing changed
+func TestUpdateTask_NoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	title := "Same title"
+	task := &entity.AufgabenEntity{
+		ID:        taskID,
+		ProjectID: projectID,
+		Title:     title,
+		Status:    entity.AufgabenTodo,
+		Priority:  entity.PriorityMedium,
+	}
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(task, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateTask(ctx, userID, projectID, taskID, &aufgaben_dto.UpdateAufgabenRequest{Title: &title})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.no_changes", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Task belongs to another project
+func TestUpdateTask_TaskOfOtherProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, "task-1").Return(&entity.AufgabenEntity{ID: "task-1", ProjectID: "project-2", Status: entity.AufgabenTodo}, (*app_errors.AppError)(nil))
+
+	newTitle := "New title"
+
+	// Execute
+	resp, err := service.UpdateTask(ctx, "user-1", "project-1", "task-1", &aufgaben_dto.UpdateAufgabenRequest{Title: &newTitle})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: User is not a project member
+func TestUpdateTask_UserNotProjectMember(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	repo.On("CheckProjectMember", ctx, "project-1", "user-1").Return(false, (*app_errors.AppError)(nil))
+
+	newTitle := "New title"
+
+	// Execute
+	resp, err := service.UpdateTask(ctx, "user-1", "project-1", "task-1", &aufgaben_dto.UpdateAufgabenRequest{Title: &newTitle})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,91 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Update replaces all saved defaults
+func TestUpdateTemplate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	templateID := "tpl-1"
+	role := entity.MEISTER
+	description := "old checklist"
+	offset := 60
+	estimate := 3.0
+	unit := entity.EstimatePoints
+	updatedAt := time.Now()
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, templateID).Return(&entity.TemplateEntity{
+		ID: templateID, ProjectID: projectID, Name: "Release", Title: "Release", Description: &description,
+		Priority: entity.PriorityHigh, DueOffsetMinutes: &offset, Estimate: &estimate, EstimateUnit: &unit,
+	}, (*app_errors.AppError)(nil))
+	repo.On("UpdateTemplate", ctx, mock.MatchedBy(func(tpl *entity.TemplateEntity) bool {
+		return tpl.Title == "Release {{version}}" && tpl.Description == nil && tpl.Priority == entity.PriorityMedium && tpl.DueOffsetMinutes == nil && tpl.Estimate == nil
+	})).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateTemplate(ctx, userID, projectID, templateID, &aufgaben_dto.SaveTemplateRequest{
+		Name:  "Release",
+		Title: "Release {{version}}",
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"version"}, resp.Variables)
+	assert.NotNil(t, resp.UpdatedAt)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Template of another project
+func TestUpdateTemplate_WrongProject(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	templateID := "tpl-1"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetTemplateByID", ctx, templateID).Return(&entity.TemplateEntity{ID: templateID, ProjectID: "project-2"}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateTemplate(ctx, userID, projectID, templateID, &aufgaben_dto.SaveTemplateRequest{
+		Name:  "Release",
+		Title: "Release {{version}}",
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+	assert.Equal(t, "template_not_found", err.MessageKey)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateTemplate", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,197 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func reviewWorkflowRequest() *aufgaben_dto.UpdateWorkflowRequest {
+	return &aufgaben_dto.UpdateWorkflowRequest{
+		Statuses: []aufgaben_dto.WorkflowStatusRequest{
+			{Key: "Todo", Name: "Todo", Category: "Todo"},
+			{Key: "In_Progress", Name: "In Progress", Category: "In_Progress"},
+			{Key: "In_Review", Name: "In Review", Category: "In_Progress"},
+			{Key: "Done", Name: "Done", Category: "Done"},
+		},
+		Transitions: []aufgaben_dto.WorkflowTransitionRequest{
+			{From: "Todo", To: "In_Progress"},
+			{From: "In_Progress", To: "In_Review"},
+			{From: "In_Review", To: "Done", Roles: []string{"Meister", "Meister"}},
+		},
+	}
+}
+
+// Test 1: Happy path
+func TestUpdateWorkflow_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	cache := &use_cases.MockCache{
+		DelFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		cache:     cache,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("ReplaceProjectWorkflow", ctx, tx, userID, mock.MatchedBy(func(w *entity.ProjectWorkflow) bool {
+		return len(w.Statuses) == 4 && w.Statuses[2].Position == 2 && len(w.Transitions) == 3 && len(w.Transitions[2].AllowedRoles) == 1
+	})).Return([]string{"task-1"}, (*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorkflow(ctx, userID, projectID, reviewWorkflowRequest())
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, resp.IsDefault)
+	assert.Len(t, resp.Statuses, 4)
+	assert.Equal(t, 1, cache.DelCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Workflow is missing a Done status
+func TestUpdateWorkflow_MissingCategory(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	req := reviewWorkflowRequest()
+	req.Statuses[3].Category = "In_Progress"
+
+	// Execute
+	resp, err := service.UpdateWorkflow(ctx, userID, projectID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+	assert.Equal(t, "request.invalid_workflow", err.MessageKey)
+
+	txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Transition uses an unknown status
+func TestUpdateWorkflow_UnknownTransitionStatus(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	req := reviewWorkflowRequest()
+	req.Transitions = append(req.Transitions, aufgaben_dto.WorkflowTransitionRequest{From: "In_Review", To: "Blocked"})
+
+	// Execute
+	resp, err := service.UpdateWorkflow(ctx, userID, projectID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: Duplicate status key
+func TestUpdateWorkflow_DuplicateStatusKey(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	meisterRole := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&meisterRole, (*app_errors.AppError)(nil))
+
+	req := reviewWorkflowRequest()
+	req.Statuses[2].Key = "In_Progress"
+
+	// Execute
+	resp, err := service.UpdateWorkflow(ctx, userID, projectID, req)
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "request.invalid_workflow", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 5: Performer is not Meister
+func TestUpdateWorkflow_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	mitarbeiterRole := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&mitarbeiterRole, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorkflow(ctx, userID, projectID, reviewWorkflowRequest())
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+}
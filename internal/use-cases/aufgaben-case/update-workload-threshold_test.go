@@ -0,0 +1,71 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister changes the threshold
+func TestUpdateWorkloadThreshold_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	threshold := 15
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorkloadThreshold", ctx, projectID, threshold).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorkloadThreshold(ctx, meisterID, projectID, &aufgaben_dto.UpdateWorkloadThresholdRequest{Threshold: &threshold})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, projectID, resp.ProjectID)
+	assert.Equal(t, threshold, resp.Threshold)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't change the threshold
+func TestUpdateWorkloadThreshold_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	threshold := 0
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorkloadThreshold(ctx, userID, projectID, &aufgaben_dto.UpdateWorkloadThresholdRequest{Threshold: &threshold})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateWorkloadThreshold", mock.Anything, mock.Anything, mock.Anything)
+}
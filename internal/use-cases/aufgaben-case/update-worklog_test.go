@@ -0,0 +1,183 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Author changes the duration
+func TestUpdateWorklog_ByAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	startedAt := time.Now().Add(-5 * time.Hour)
+	endedAt := startedAt.Add(time.Hour)
+	updatedAt := time.Now()
+	duration := 120
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: userID, StartedAt: startedAt, EndedAt: &endedAt}, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorklog", ctx, mock.MatchedBy(func(w *entity.WorklogEntity) bool {
+		return w.EndedAt.Sub(w.StartedAt) == 2*time.Hour
+	})).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorklog(ctx, userID, projectID, taskID, worklogID, &aufgaben_dto.UpdateWorklogRequest{DurationMinutes: &duration})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 120, *resp.DurationMinutes)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetUserRole", ctx, projectID, userID)
+}
+
+// Test 2: Meister may edit worklogs of others
+func TestUpdateWorklog_ByMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "meister-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	startedAt := time.Now().Add(-5 * time.Hour)
+	endedAt := startedAt.Add(time.Hour)
+	updatedAt := time.Now()
+	note := "client call"
+	role := entity.MEISTER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: "user-2", StartedAt: startedAt, EndedAt: &endedAt}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("UpdateWorklog", ctx, mock.Anything).Return(&updatedAt, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorklog(ctx, userID, projectID, taskID, worklogID, &aufgaben_dto.UpdateWorklogRequest{Note: &note})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "client call", *resp.Note)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: Mitarbeiter can't edit worklogs of others
+func TestUpdateWorklog_Forbidden(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	startedAt := time.Now().Add(-5 * time.Hour)
+	endedAt := startedAt.Add(time.Hour)
+	duration := 30
+	role := entity.MITARBEITER
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: "user-2", StartedAt: startedAt, EndedAt: &endedAt}, (*app_errors.AppError)(nil))
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorklog(ctx, userID, projectID, taskID, worklogID, &aufgaben_dto.UpdateWorklogRequest{DurationMinutes: &duration})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateWorklog", mock.Anything, mock.Anything)
+}
+
+// Test 4: Running timer can't be edited
+func TestUpdateWorklog_Running(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	duration := 30
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: userID, StartedAt: time.Now()}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorklog(ctx, userID, projectID, taskID, worklogID, &aufgaben_dto.UpdateWorklogRequest{DurationMinutes: &duration})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "conflict.worklog_running", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 5: Nothing changed
+func TestUpdateWorklog_NoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	worklogID := "worklog-1"
+	startedAt := time.Now().Add(-5 * time.Hour)
+	endedAt := startedAt.Add(time.Hour)
+	duration := 60
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+	repo.On("GetWorklogByID", ctx, worklogID).Return(&entity.WorklogEntity{ID: worklogID, AufgabenID: taskID, UserID: userID, StartedAt: startedAt, EndedAt: &endedAt}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpdateWorklog(ctx, userID, projectID, taskID, worklogID, &aufgaben_dto.UpdateWorklogRequest{DurationMinutes: &duration})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "request.no_changes", err.MessageKey)
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,201 @@
+package aufgaben_case
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	use_cases "github.com/Xenn-00/aufgaben-meister/internal/use-cases"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// Test 1: Happy path, content is stored and metadata is written with an audit event
+func TestUploadAttachment_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	var stored []byte
+	storage := &use_cases.MockStorage{
+		PutFn: func(ctx context.Context, key string, content io.Reader) *app_errors.AppError {
+			stored, _ = io.ReadAll(content)
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		storage:   storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockAttachmentQuota", ctx, tx, projectID).Return(&entity.AttachmentQuota{QuotaBytes: 1000, UsedBytes: 100}, (*app_errors.AppError)(nil))
+	repo.On("InsertAttachment", ctx, tx, mock.MatchedBy(func(a *entity.AttachmentEntity) bool {
+		return a.FileName == "screen.png" && a.ContentType == "image/png" && a.SizeBytes == int64(len(pngHeader)) && strings.HasPrefix(a.StorageKey, projectID+"/")
+	})).Return((*app_errors.AppError)(nil))
+	repo.On("InsertAssignmentEvent", ctx, tx, mock.MatchedBy(func(e *entity.AddAssignment) bool {
+		return e.AufgabenID == taskID && e.Action == entity.ActionAttachmentAdded && *e.Note == "Attachment: screen.png"
+	})).Return((*app_errors.AppError)(nil))
+	tx.On("Commit", ctx).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UploadAttachment(ctx, userID, projectID, taskID, &aufgaben_dto.AttachmentUpload{
+		FileName:    "../../screen.png",
+		ContentType: "image/png",
+		Size:        int64(len(pngHeader)),
+		Content:     bytes.NewReader(pngHeader),
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "screen.png", resp.FileName)
+	assert.Equal(t, pngHeader, stored)
+	assert.Equal(t, 1, storage.PutCalled)
+	assert.Equal(t, 0, storage.DeleteCalled)
+
+	repo.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+// Test 2: Quota exhausted, stored content is removed again
+func TestUploadAttachment_QuotaExceeded(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	txManager := new(use_cases.MockTxManager)
+	tx := new(use_cases.MockTx)
+	storage := &use_cases.MockStorage{
+		PutFn: func(ctx context.Context, key string, content io.Reader) *app_errors.AppError {
+			return nil
+		},
+		DeleteFn: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	service := &AufgabenService{
+		repo:      repo,
+		txManager: txManager,
+		storage:   storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	txManager.On("Begin", ctx).Return(tx, (*app_errors.AppError)(nil))
+	tx.On("Rollback", ctx).Return((*app_errors.AppError)(nil))
+
+	repo.On("LockAttachmentQuota", ctx, tx, projectID).Return(&entity.AttachmentQuota{QuotaBytes: 1000, UsedBytes: 995}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UploadAttachment(ctx, userID, projectID, taskID, &aufgaben_dto.AttachmentUpload{
+		FileName:    "notes.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        11,
+		Content:     strings.NewReader("hello world"),
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusConflict, err.Code)
+	assert.Equal(t, "conflict.attachment_quota_exceeded", err.MessageKey)
+	assert.Equal(t, 1, storage.DeleteCalled)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "InsertAttachment", mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertNotCalled(t, "Commit", ctx)
+}
+
+// Test 3: Declared image type doesn't match the content
+func TestUploadAttachment_ContentTypeMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	storage := &use_cases.MockStorage{}
+	service := &AufgabenService{
+		repo:    repo,
+		storage: storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+	body := "<html><script>alert(1)</script></html>"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UploadAttachment(ctx, userID, projectID, taskID, &aufgaben_dto.AttachmentUpload{
+		FileName:    "cat.png",
+		ContentType: "image/png",
+		Size:        int64(len(body)),
+		Content:     strings.NewReader(body),
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusUnsupportedMediaType, err.Code)
+	assert.Equal(t, 0, storage.PutCalled)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 4: File is too large
+func TestUploadAttachment_TooLarge(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	storage := &use_cases.MockStorage{}
+	service := &AufgabenService{
+		repo:    repo,
+		storage: storage,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	taskID := "task-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	repo.On("GetTaskByID", ctx, taskID).Return(&entity.AufgabenEntity{ID: taskID, ProjectID: projectID}, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UploadAttachment(ctx, userID, projectID, taskID, &aufgaben_dto.AttachmentUpload{
+		FileName:    "video.pdf",
+		ContentType: "application/pdf",
+		Size:        maxAttachmentSize + 1,
+		Content:     strings.NewReader("%PDF-1.7"),
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusRequestEntityTooLarge, err.Code)
+	assert.Equal(t, 0, storage.PutCalled)
+
+	repo.AssertExpectations(t)
+}
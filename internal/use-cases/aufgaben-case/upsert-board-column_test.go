@@ -0,0 +1,104 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister sets the WIP limit of a column
+func TestUpsertBoardColumn_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	limit := 4
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return(reviewWorkflow(projectID), (*app_errors.AppError)(nil))
+	repo.On("UpsertBoardColumn", ctx, mock.MatchedBy(func(c *entity.BoardColumnEntity) bool {
+		return c.ProjectID == projectID && c.StatusKey == "In_Review" && c.WIPLimit == limit && *c.UpdatedBy == meisterID
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertBoardColumn(ctx, meisterID, projectID, "In_Review", &aufgaben_dto.UpsertBoardColumnRequest{WIPLimit: &limit})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "In_Review", resp.StatusKey)
+	assert.Equal(t, limit, resp.WIPLimit)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Unknown column
+func TestUpsertBoardColumn_UnknownStatus(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	limit := 4
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("GetProjectWorkflow", ctx, projectID).Return((*entity.ProjectWorkflow)(nil), (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertBoardColumn(ctx, meisterID, projectID, "In_Review", &aufgaben_dto.UpsertBoardColumnRequest{WIPLimit: &limit})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusNotFound, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertBoardColumn", mock.Anything, mock.Anything)
+}
+
+// Test 3: Mitarbeiter can't change WIP limits
+func TestUpsertBoardColumn_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	limit := 4
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertBoardColumn(ctx, userID, projectID, "Todo", &aufgaben_dto.UpsertBoardColumnRequest{WIPLimit: &limit})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertBoardColumn", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,144 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister sets a level, action defaults to notify the Meister
+func TestUpsertEscalationLevel_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	afterReminders := 2
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("UpsertEscalationLevel", ctx, mock.MatchedBy(func(l *entity.EscalationLevelEntity) bool {
+		return l.ProjectID == projectID &&
+			l.Level == 1 &&
+			*l.AfterReminders == afterReminders &&
+			l.AfterHoursOverdue == nil &&
+			l.Action == entity.EscalationNotifyMeister
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertEscalationLevel(ctx, meisterID, projectID, 1, &aufgaben_dto.UpsertEscalationLevelRequest{
+		AfterReminders: &afterReminders,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resp.Level)
+	assert.Equal(t, string(entity.EscalationNotifyMeister), resp.Action)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: A later level can flag the task for force-unassign
+func TestUpsertEscalationLevel_FlagForceUnassign(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	afterHours := 72
+	action := string(entity.EscalationFlagForceUnassign)
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("UpsertEscalationLevel", ctx, mock.MatchedBy(func(l *entity.EscalationLevelEntity) bool {
+		return l.Level == 2 && *l.AfterHoursOverdue == afterHours && l.Action == entity.EscalationFlagForceUnassign
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertEscalationLevel(ctx, meisterID, projectID, 2, &aufgaben_dto.UpsertEscalationLevelRequest{
+		AfterHoursOverdue: &afterHours,
+		Action:            &action,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, action, resp.Action)
+	assert.Equal(t, afterHours, *resp.AfterHoursOverdue)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 3: A level without trigger is rejected
+func TestUpsertEscalationLevel_NoTrigger(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertEscalationLevel(ctx, meisterID, projectID, 1, &aufgaben_dto.UpsertEscalationLevelRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertEscalationLevel", mock.Anything, mock.Anything)
+}
+
+// Test 4: Mitarbeiter can't change the escalation chain
+func TestUpsertEscalationLevel_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	afterReminders := 1
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertEscalationLevel(ctx, userID, projectID, 1, &aufgaben_dto.UpsertEscalationLevelRequest{
+		AfterReminders: &afterReminders,
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertEscalationLevel", mock.Anything, mock.Anything)
+}
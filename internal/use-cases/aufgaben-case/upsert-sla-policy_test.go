@@ -0,0 +1,112 @@
+package aufgaben_case
+
+import (
+	"context"
+	"testing"
+
+	aufgaben_dto "github.com/Xenn-00/aufgaben-meister/internal/dtos/aufgaben-dto"
+	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test 1: Happy path - Meister sets the SLA of a priority
+func TestUpsertSLAPolicy_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+	assignWithin := 60
+	completeWithin := 1440
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+	repo.On("UpsertSLAPolicy", ctx, mock.MatchedBy(func(p *entity.SLAPolicyEntity) bool {
+		return p.ProjectID == projectID &&
+			p.Priority == entity.PriorityUrgent &&
+			*p.AssignWithinMinutes == assignWithin &&
+			*p.CompleteWithinMinutes == completeWithin &&
+			*p.UpdatedBy == meisterID
+	})).Return((*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertSLAPolicy(ctx, meisterID, projectID, string(entity.PriorityUrgent), &aufgaben_dto.UpsertSLAPolicyRequest{
+		AssignWithinMinutes:   &assignWithin,
+		CompleteWithinMinutes: &completeWithin,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, string(entity.PriorityUrgent), resp.Priority)
+	assert.Equal(t, assignWithin, *resp.AssignWithinMinutes)
+	assert.Equal(t, completeWithin, *resp.CompleteWithinMinutes)
+
+	repo.AssertExpectations(t)
+}
+
+// Test 2: Mitarbeiter can't change SLA policies
+func TestUpsertSLAPolicy_NotMeister(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	userID := "user-1"
+	projectID := "project-1"
+	assignWithin := 60
+
+	repo.On("CheckProjectMember", ctx, projectID, userID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MITARBEITER
+	repo.On("GetUserRole", ctx, projectID, userID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertSLAPolicy(ctx, userID, projectID, string(entity.PriorityHigh), &aufgaben_dto.UpsertSLAPolicyRequest{
+		AssignWithinMinutes: &assignWithin,
+	})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusForbidden, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertSLAPolicy", mock.Anything, mock.Anything)
+}
+
+// Test 3: A policy without any target is rejected
+func TestUpsertSLAPolicy_NoTarget(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockAufgabenRepo)
+	service := &AufgabenService{
+		repo: repo,
+	}
+
+	meisterID := "meister-1"
+	projectID := "project-1"
+
+	repo.On("CheckProjectMember", ctx, projectID, meisterID).Return(true, (*app_errors.AppError)(nil))
+	role := entity.MEISTER
+	repo.On("GetUserRole", ctx, projectID, meisterID).Return(&role, (*app_errors.AppError)(nil))
+
+	// Execute
+	resp, err := service.UpsertSLAPolicy(ctx, meisterID, projectID, string(entity.PriorityLow), &aufgaben_dto.UpsertSLAPolicyRequest{})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, err.Code)
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpsertSLAPolicy", mock.Anything, mock.Anything)
+}
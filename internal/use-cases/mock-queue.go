@@ -29,3 +29,23 @@ func (m *MockTaskQueue) EnqueueHandoverRequestNotifyMeister(payload *worker_task
 	args := m.Called(payload)
 	return args.Error(0)
 }
+
+func (m *MockTaskQueue) EnqueueDependencyUnblockedNotify(payload *worker_task.DependencyUnblockedNotify) error {
+	args := m.Called(payload)
+	return args.Error(0)
+}
+
+func (m *MockTaskQueue) EnqueueCommentMentionNotify(payload *worker_task.CommentMentionNotify) error {
+	args := m.Called(payload)
+	return args.Error(0)
+}
+
+func (m *MockTaskQueue) EnqueueWatcherNotify(payload *worker_task.WatcherNotify) error {
+	args := m.Called(payload)
+	return args.Error(0)
+}
+
+func (m *MockTaskQueue) EnqueueHandoverDecisionNotify(payload *worker_task.HandoverDecisionNotify) error {
+	args := m.Called(payload)
+	return args.Error(0)
+}
@@ -0,0 +1,33 @@
+package use_cases
+
+import (
+	"context"
+	"io"
+
+	app_errors "github.com/Xenn-00/aufgaben-meister/internal/errors"
+)
+
+type MockStorage struct {
+	PutFn    func(ctx context.Context, key string, content io.Reader) *app_errors.AppError
+	OpenFn   func(ctx context.Context, key string) (io.ReadCloser, *app_errors.AppError)
+	DeleteFn func(ctx context.Context, key string) error
+
+	PutCalled    int
+	OpenCalled   int
+	DeleteCalled int
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, content io.Reader) *app_errors.AppError {
+	m.PutCalled++
+	return m.PutFn(ctx, key, content)
+}
+
+func (m *MockStorage) Open(ctx context.Context, key string) (io.ReadCloser, *app_errors.AppError) {
+	m.OpenCalled++
+	return m.OpenFn(ctx, key)
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	m.DeleteCalled++
+	return m.DeleteFn(ctx, key)
+}
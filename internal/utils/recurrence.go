@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// NextOccurrence returns the first occurrence of a cron expression after the given time.
+// Standard five field expressions and descriptors like @weekly or @monthly are supported.
+func NextOccurrence(expr string, after time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(after), nil
+}
@@ -24,6 +24,13 @@ func RegisterWorkerHandlers(mux *asynq.ServeMux, h *worker_handler.WorkerHander)
 	)
 	mux.HandleFunc(worker_task.TaskSendProjectProgressReminder, h.ReminderAufgaben())
 	mux.HandleFunc(worker_task.TaskHandoverRequestNotifyMeister, h.HandoverRequestNotifyMeister())
+	mux.HandleFunc(worker_task.TaskDependencyUnblockedNotify, h.DependencyUnblockedNotify())
+	mux.HandleFunc(worker_task.TaskCommentMentionNotify, h.CommentMentionNotify())
+	mux.HandleFunc(worker_task.TaskGenerateRecurringAufgaben, h.GenerateRecurringAufgaben())
+	mux.HandleFunc(worker_task.TaskWatcherNotify, h.WatcherNotify())
+	mux.HandleFunc(worker_task.TaskExpireHandoverRequests, h.ExpireHandoverRequests())
+	mux.HandleFunc(worker_task.TaskHandoverDecisionNotify, h.HandoverDecisionNotify())
+	mux.HandleFunc(worker_task.TaskDetectSLABreaches, h.DetectSLABreaches())
 }
 
 func RegisterCronJobs(s *asynq.Scheduler) error {
@@ -51,6 +58,24 @@ func RegisterCronJobs(s *asynq.Scheduler) error {
 			queue: "low",
 			desc:  "send task progress reminder",
 		},
+		{
+			spec:  "*/5 * * * *",
+			task:  asynq.NewTask(worker_task.TaskGenerateRecurringAufgaben, nil),
+			queue: "low",
+			desc:  "generate recurring tasks",
+		},
+		{
+			spec:  "*/15 * * * *",
+			task:  asynq.NewTask(worker_task.TaskExpireHandoverRequests, nil),
+			queue: "low",
+			desc:  "expire handover requests",
+		},
+		{
+			spec:  "*/5 * * * *",
+			task:  asynq.NewTask(worker_task.TaskDetectSLABreaches, nil),
+			queue: "low",
+			desc:  "detect SLA breaches",
+		},
 	}
 
 	for _, job := range jobs {
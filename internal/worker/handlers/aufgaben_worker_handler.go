@@ -2,10 +2,15 @@ package worker_handler
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/Xenn-00/aufgaben-meister/internal/entity"
+	"github.com/Xenn-00/aufgaben-meister/internal/utils"
 	worker_task "github.com/Xenn-00/aufgaben-meister/internal/worker/tasks"
 	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 )
@@ -19,9 +24,9 @@ func (wh *WorkerHander) OverdueAufgaben() asynq.HandlerFunc {
 			log.Error().Err(err).Msg("Worker handler: Error occured when list aufgaben")
 			return err
 		}
-		// When there is no matches, do nothing
+		// When there is no reminder to send, overdue tasks may still escalate by time
 		if len(aufgaben) == 0 {
-			return nil
+			return wh.escalateOverdueAufgaben(ctx)
 		}
 		// If there are matches, start transaction
 		tx, txErr := wh.txManager.Begin(ctx)
@@ -30,15 +35,20 @@ func (wh *WorkerHander) OverdueAufgaben() asynq.HandlerFunc {
 			return txErr
 		}
 		defer tx.Rollback(ctx)
-		// Send email to assignee from their related contact (email)
+		// Send email to every participant from their related contact (email)
 		aufgabenID := []string{}
+		reminded := map[string]bool{}
 		for _, aufgabe := range aufgaben {
 			if err := wh.mailer.SendReminderAufgabenOverdue(&aufgabe); err != nil {
 				log.Error().Err(err).Msg("Worker handler: Error occured when trying to send email.")
 				continue
 			}
 
-			aufgabenID = append(aufgabenID, aufgabe.ID)
+			// One row per participant, the reminder counts once per task
+			if !reminded[aufgabe.ID] {
+				reminded[aufgabe.ID] = true
+				aufgabenID = append(aufgabenID, aufgabe.ID)
+			}
 		}
 
 		// Update value last_reminder_at (batch)
@@ -54,8 +64,70 @@ func (wh *WorkerHander) OverdueAufgaben() asynq.HandlerFunc {
 			return err
 		}
 
+		// Escalate after the reminders are counted
+		return wh.escalateOverdueAufgaben(ctx)
+	}
+}
+
+// escalateOverdueAufgaben moves overdue tasks up the project's escalation chain and tells the Meister
+func (wh *WorkerHander) escalateOverdueAufgaben(ctx context.Context) error {
+	tx, txErr := wh.txManager.Begin(ctx)
+	if txErr != nil {
+		log.Error().Err(txErr).Msg("Worker handler: Failed to open db transaction")
+		return txErr
+	}
+	defer tx.Rollback(ctx)
+
+	escalations, err := wh.ar.RecordOverdueEscalations(ctx, tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Worker handler: Error occured when escalate overdue aufgaben")
+		return err
+	}
+	if len(escalations) == 0 {
 		return nil
 	}
+
+	// Record every step in the task's trail
+	fieldName := "escalation_level"
+	for _, escalation := range escalations {
+		eventID, _ := uuid.NewV7()
+		oldValue := strconv.Itoa(escalation.PreviousLevel)
+		newValue := strconv.Itoa(escalation.Level)
+		note := fmt.Sprintf("Escalated to level %d after %d overdue reminders, due %s", escalation.Level, escalation.OverdueReminderCount, escalation.DueDate.Format(time.RFC3339))
+		if escalation.Action == entity.EscalationFlagForceUnassign {
+			note += ", flagged for force-unassign"
+		}
+		event := &entity.AddAssignment{
+			ID:               eventID.String(),
+			AufgabenID:       escalation.AufgabenID,
+			ActorID:          escalation.CreatedBy,
+			TargetAssigneeID: escalation.AssigneeID,
+			Action:           entity.ActionEscalated,
+			Note:             &note,
+			FieldName:        &fieldName,
+			OldValue:         &oldValue,
+			NewValue:         &newValue,
+		}
+		if err := wh.ar.InsertAssignmentEvent(ctx, tx, event); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when insert escalation event")
+			return err
+		}
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Worker handler: Error when initiating commit transaction")
+		return err
+	}
+
+	// Notify the Meister, a failed mail is not retried since the level is already recorded
+	for i := range escalations {
+		if err := wh.mailer.SendOverdueEscalation(&escalations[i]); err != nil {
+			log.Error().Err(err).Str("aufgabe_id", escalations[i].AufgabenID).Msg("Worker handler: error occured when notify Meister about escalation")
+		}
+	}
+
+	return nil
 }
 
 func (wh *WorkerHander) ReminderAufgaben() asynq.HandlerFunc {
@@ -67,11 +139,17 @@ func (wh *WorkerHander) ReminderAufgaben() asynq.HandlerFunc {
 			return err
 		}
 
-		aufgabe, err := wh.ar.ShouldRemind(ctx, p.AufgabeID)
+		// One row per participant, none when the aufgabe doesn't need a reminder (anymore)
+		participants, err := wh.ar.ShouldRemind(ctx, p.AufgabeID)
 		if err != nil {
 			log.Error().Err(err).Msg("Worker handler: Error occured when list aufgaben")
 			return err
 		}
+		if len(participants) == 0 {
+			return nil
+		}
+
+		aufgabe := participants[0]
 		// Idempotency check
 		if aufgabe.LastReminderAt != nil {
 			return nil
@@ -90,9 +168,16 @@ func (wh *WorkerHander) ReminderAufgaben() asynq.HandlerFunc {
 		}
 		defer tx.Rollback(ctx)
 
-		// Send email to assignee from their related contact (email)
-		if err := wh.mailer.SendReminderAufgabenProgress(aufgabe); err != nil {
-			log.Error().Err(err).Msg("Worker handler: Error occured when trying to send email.")
+		// Send email to every participant from their related contact (email)
+		sent := 0
+		for _, participant := range participants {
+			if err := wh.mailer.SendReminderAufgabenProgress(&participant); err != nil {
+				log.Error().Err(err).Str("user_id", participant.AssigneeID).Msg("Worker handler: Error occured when trying to send email.")
+				continue
+			}
+			sent++
+		}
+		if sent == 0 {
 			return nil
 		}
 
@@ -138,3 +223,348 @@ func (wh *WorkerHander) HandoverRequestNotifyMeister() asynq.HandlerFunc {
 		return wh.mailer.SendHandoverRequest(&p, meister.Email, assignee.Username)
 	}
 }
+
+func (wh *WorkerHander) DependencyUnblockedNotify() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var p worker_task.DependencyUnblockedNotify
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when trying to unmarshal task payload.")
+			return err
+		}
+
+		// Get assignee info
+		assignee, err := wh.ur.FindByUserID(ctx, p.AssigneeID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch assignee info")
+			return err
+		}
+
+		return wh.mailer.SendDependencyUnblocked(&p, assignee.Email)
+	}
+}
+
+func (wh *WorkerHander) CommentMentionNotify() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var p worker_task.CommentMentionNotify
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when trying to unmarshal task payload.")
+			return err
+		}
+
+		// Get mentioned user info
+		mentioned, err := wh.ur.FindByUserID(ctx, p.MentionedUserID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch mentioned user info")
+			return err
+		}
+
+		// Get author info
+		author, err := wh.ur.FindByUserID(ctx, p.AuthorID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch author info")
+			return err
+		}
+
+		return wh.mailer.SendCommentMention(&p, mentioned.Email, author.Username)
+	}
+}
+
+func (wh *WorkerHander) WatcherNotify() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var p worker_task.WatcherNotify
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when trying to unmarshal task payload.")
+			return err
+		}
+
+		// Get watchers who are still active members of the project
+		watchers, err := wh.ar.ListWatcherContacts(ctx, p.AufgabeID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when list task watchers")
+			return err
+		}
+
+		// Get actor info
+		actor, err := wh.ur.FindByUserID(ctx, p.ActorID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch actor info")
+			return err
+		}
+
+		// Detail of an assignment carries the new assignee, the mail shows their name
+		if p.Change == worker_task.WatcherChangeAssigned {
+			assignee, err := wh.ur.FindByUserID(ctx, p.Detail)
+			if err != nil {
+				log.Error().Err(err).Msg("Worker handler: error occured when fetch assignee info")
+				return err
+			}
+			p.Detail = assignee.Username
+		}
+
+		for _, watcher := range watchers {
+			// The actor knows about their own change
+			if watcher.UserID == p.ActorID {
+				continue
+			}
+			// A failed mail is not retried, a retry would notify the other watchers twice
+			if err := wh.mailer.SendWatcherNotification(&p, watcher.Email, actor.Username); err != nil {
+				log.Error().Err(err).Str("watcher_id", watcher.UserID).Msg("Worker handler: error occured when notify watcher")
+			}
+		}
+
+		return nil
+	}
+}
+
+func (wh *WorkerHander) GenerateRecurringAufgaben() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		// TODO
+		// Lock all recurrences that are due, a second scheduler run skips locked rows
+		tx, txErr := wh.txManager.Begin(ctx)
+		if txErr != nil {
+			log.Error().Err(txErr).Msg("Worker handler: Failed to open db transaction")
+			return txErr
+		}
+		defer tx.Rollback(ctx)
+
+		recurrences, err := wh.ar.ListDueRecurrences(ctx, tx, 100)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when list due recurrences")
+			return err
+		}
+		// When there is no matches, do nothing
+		if len(recurrences) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		for _, recurrence := range recurrences {
+			occurrenceAt := recurrence.NextRunAt
+
+			// Missed occurrences are not back filled, the series continues after now
+			nextRunAt, parseErr := utils.NextOccurrence(recurrence.CronExpr, now)
+			if parseErr != nil {
+				log.Error().Err(parseErr).Str("recurrence_id", recurrence.ID).Msg("Worker handler: Invalid recurrence rule")
+				continue
+			}
+
+			// Default assignee may have left the project in the meantime
+			var assigneeID *string
+			if recurrence.DefaultAssigneeID != nil {
+				isMember, err := wh.ar.CheckProjectMember(ctx, recurrence.ProjectID, *recurrence.DefaultAssigneeID)
+				if err != nil {
+					log.Error().Err(err).Msg("Worker handler: Error occured when check default assignee")
+					return err
+				}
+				if isMember {
+					assigneeID = recurrence.DefaultAssigneeID
+				}
+			}
+
+			var dueDate *time.Time
+			if recurrence.DueOffsetMinutes != nil {
+				due := occurrenceAt.Add(time.Duration(*recurrence.DueOffsetMinutes) * time.Minute)
+				dueDate = &due
+			}
+
+			aufgabenID, _ := uuid.NewV7()
+			task := &entity.AufgabenEntity{
+				ID:           aufgabenID.String(),
+				ProjectID:    recurrence.ProjectID,
+				Title:        recurrence.Title,
+				Description:  recurrence.Description,
+				Status:       entity.AufgabenTodo,
+				Priority:     recurrence.Priority,
+				AssigneeID:   assigneeID,
+				CreatedBy:    recurrence.CreatedBy,
+				DueDate:      dueDate,
+				CreatedAt:    now,
+				RecurrenceID: &recurrence.ID,
+				OccurrenceAt: &occurrenceAt,
+			}
+
+			// Idempotency check, an occurrence that already exists is skipped
+			created, err := wh.ar.InsertRecurringAufgabe(ctx, tx, task)
+			if err != nil {
+				log.Error().Err(err).Msg("Worker handler: Error occured when insert recurring aufgabe")
+				return err
+			}
+			if !created {
+				log.Info().Str("recurrence_id", recurrence.ID).Msg("Worker handler: Occurrence already generated")
+			}
+
+			if err := wh.ar.AdvanceRecurrence(ctx, tx, recurrence.ID, occurrenceAt, nextRunAt); err != nil {
+				log.Error().Err(err).Msg("Worker handler: Error occured when advance recurrence")
+				return err
+			}
+		}
+
+		// Commit
+		if err := tx.Commit(ctx); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error when initiating commit transaction")
+			return err
+		}
+
+		return nil
+	}
+}
+
+func (wh *WorkerHander) ExpireHandoverRequests() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		// TODO
+		// Expire pending requests the Meister didn't decide in time
+		tx, txErr := wh.txManager.Begin(ctx)
+		if txErr != nil {
+			log.Error().Err(txErr).Msg("Worker handler: Failed to open db transaction")
+			return txErr
+		}
+		defer tx.Rollback(ctx)
+
+		expired, err := wh.ar.ExpireHandoverRequests(ctx, tx)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when expire handover requests")
+			return err
+		}
+		// When there is no matches, do nothing
+		if len(expired) == 0 {
+			return nil
+		}
+
+		// Record the expiry in the task's trail
+		for _, request := range expired {
+			eventID, _ := uuid.NewV7()
+			note := fmt.Sprintf("Handover request to %s expired without a decision", request.TargetAssigneeID)
+			event := &entity.AddAssignment{
+				ID:               eventID.String(),
+				AufgabenID:       request.AufgabenID,
+				ActorID:          request.RequestedBy,
+				TargetAssigneeID: &request.TargetAssigneeID,
+				Action:           entity.ActionHandoverExpired,
+				Note:             &note,
+			}
+			if err := wh.ar.InsertAssignmentEvent(ctx, tx, event); err != nil {
+				log.Error().Err(err).Msg("Worker handler: Error occured when insert handover event")
+				return err
+			}
+		}
+
+		// Commit
+		if err := tx.Commit(ctx); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error when initiating commit transaction")
+			return err
+		}
+
+		// Notify requesters, a failed mail is not retried since the requests are already expired
+		for _, request := range expired {
+			requester, err := wh.ur.FindByUserID(ctx, request.RequestedBy)
+			if err != nil {
+				log.Error().Err(err).Msg("Worker handler: error occured when fetch requester info")
+				continue
+			}
+
+			p := &worker_task.HandoverDecisionNotify{
+				RequestID:        request.ID,
+				AufgabeID:        request.AufgabenID,
+				AufgabeTitle:     request.AufgabeTitle,
+				ProjectID:        request.ProjectID,
+				ProjectName:      request.ProjectName,
+				RequesterID:      request.RequestedBy,
+				TargetAssigneeID: request.TargetAssigneeID,
+				Outcome:          string(entity.HandoverExpired),
+				DecidedAt:        *request.DecidedAt,
+			}
+			// No one decided, the system closed the request
+			if err := wh.mailer.SendHandoverDecision(p, requester.Email, "Aufgaben Meister"); err != nil {
+				log.Error().Err(err).Str("request_id", request.ID).Msg("Worker handler: error occured when notify requester")
+			}
+		}
+
+		return nil
+	}
+}
+
+func (wh *WorkerHander) HandoverDecisionNotify() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var p worker_task.HandoverDecisionNotify
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when trying to unmarshal task payload.")
+			return err
+		}
+
+		// Get requester info
+		requester, err := wh.ur.FindByUserID(ctx, p.RequesterID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch requester info")
+			return err
+		}
+
+		// Get meister info
+		decider, err := wh.ur.FindByUserID(ctx, p.DeciderID)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: error occured when fetch meister info")
+			return err
+		}
+
+		return wh.mailer.SendHandoverDecision(&p, requester.Email, decider.Username)
+	}
+}
+
+func (wh *WorkerHander) DetectSLABreaches() asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		// TODO
+		// Record every SLA target missed since the last run
+		tx, txErr := wh.txManager.Begin(ctx)
+		if txErr != nil {
+			log.Error().Err(txErr).Msg("Worker handler: Failed to open db transaction")
+			return txErr
+		}
+		defer tx.Rollback(ctx)
+
+		breaches, err := wh.ar.RecordSLABreaches(ctx, tx)
+		if err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error occured when record SLA breaches")
+			return err
+		}
+		// When there is no matches, do nothing
+		if len(breaches) == 0 {
+			return nil
+		}
+
+		// Record the breach in the task's trail
+		fieldName := "sla"
+		for _, breach := range breaches {
+			eventID, _ := uuid.NewV7()
+			kind := string(breach.Kind)
+			note := fmt.Sprintf("%s SLA for %s priority breached, deadline was %s", breach.Kind, breach.Priority, breach.DeadlineAt.Format(time.RFC3339))
+			event := &entity.AddAssignment{
+				ID:               eventID.String(),
+				AufgabenID:       breach.AufgabenID,
+				ActorID:          breach.CreatedBy,
+				TargetAssigneeID: breach.AssigneeID,
+				Action:           entity.ActionSLABreached,
+				Note:             &note,
+				FieldName:        &fieldName,
+				NewValue:         &kind,
+			}
+			if err := wh.ar.InsertAssignmentEvent(ctx, tx, event); err != nil {
+				log.Error().Err(err).Msg("Worker handler: Error occured when insert SLA event")
+				return err
+			}
+		}
+
+		// Commit
+		if err := tx.Commit(ctx); err != nil {
+			log.Error().Err(err).Msg("Worker handler: Error when initiating commit transaction")
+			return err
+		}
+
+		// Escalate to the Meister, a failed mail is not retried since the breaches are already recorded
+		for i := range breaches {
+			if err := wh.mailer.SendSLABreach(&breaches[i]); err != nil {
+				log.Error().Err(err).Str("aufgabe_id", breaches[i].AufgabenID).Msg("Worker handler: error occured when escalate SLA breach")
+			}
+		}
+
+		return nil
+	}
+}
@@ -12,6 +12,20 @@ const TaskSendProjectProgressReminder = "low:send_project_progress_reminder"
 
 const TaskHandoverRequestNotifyMeister = "email:handover_request_notify_meister"
 
+const TaskDependencyUnblockedNotify = "email:dependency_unblocked_notify"
+
+const TaskCommentMentionNotify = "email:comment_mention_notify"
+
+const TaskGenerateRecurringAufgaben = "low:generate_recurring_aufgaben"
+
+const TaskWatcherNotify = "email:watcher_notify"
+
+const TaskExpireHandoverRequests = "low:expire_handover_requests"
+
+const TaskHandoverDecisionNotify = "email:handover_decision_notify"
+
+const TaskDetectSLABreaches = "low:detect_sla_breaches"
+
 type SendInvitationEmailPayload struct {
 	InvitationID string `json:"invitation_id"`
 	RawToken     string `json:"raw_token"`
@@ -22,6 +36,7 @@ type SendProjectProgressReminder struct {
 }
 
 type HandoverRequestNotifyMeister struct {
+	RequestID        string    `json:"request_id"`
 	AufgabeID        string    `json:"aufgabe_id"`
 	AufgabeTitle     string    `json:"aufgabe_title"`
 	AufgabeStatus    string    `json:"aufgabe_status"`
@@ -31,5 +46,63 @@ type HandoverRequestNotifyMeister struct {
 	TargetAssigneeID string    `json:"target_assignee_id"`
 	RequestedAt      time.Time `json:"requested_at"`
 	DueDate          time.Time `json:"due_date"`
+	ExpiresAt        time.Time `json:"expires_at"`
 	Note             string    `json:"note"`
 }
+
+type DependencyUnblockedNotify struct {
+	AufgabeID        string    `json:"aufgabe_id"`
+	AufgabeTitle     string    `json:"aufgabe_title"`
+	ProjectID        string    `json:"project_id"`
+	ProjectName      string    `json:"project_name"`
+	AssigneeID       string    `json:"assignee_id"`
+	UnblockedByID    string    `json:"unblocked_by_id"`
+	UnblockedByTitle string    `json:"unblocked_by_title"`
+	UnblockedAt      time.Time `json:"unblocked_at"`
+}
+
+type CommentMentionNotify struct {
+	CommentID       string    `json:"comment_id"`
+	AufgabeID       string    `json:"aufgabe_id"`
+	AufgabeTitle    string    `json:"aufgabe_title"`
+	ProjectID       string    `json:"project_id"`
+	ProjectName     string    `json:"project_name"`
+	AuthorID        string    `json:"author_id"`
+	MentionedUserID string    `json:"mentioned_user_id"`
+	Excerpt         string    `json:"excerpt"`
+	MentionedAt     time.Time `json:"mentioned_at"`
+}
+
+// Changes a watcher is notified about
+const (
+	WatcherChangeAssigned       = "Assigned"
+	WatcherChangeCompleted      = "Completed"
+	WatcherChangeDueDateChanged = "Due_Date_Changed"
+	WatcherChangeArchived       = "Archived"
+	WatcherChangeCommented      = "Commented"
+)
+
+type WatcherNotify struct {
+	AufgabeID    string    `json:"aufgabe_id"`
+	AufgabeTitle string    `json:"aufgabe_title"`
+	ProjectID    string    `json:"project_id"`
+	ProjectName  string    `json:"project_name"`
+	ActorID      string    `json:"actor_id"`
+	Change       string    `json:"change"`
+	Detail       string    `json:"detail"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
+
+type HandoverDecisionNotify struct {
+	RequestID        string    `json:"request_id"`
+	AufgabeID        string    `json:"aufgabe_id"`
+	AufgabeTitle     string    `json:"aufgabe_title"`
+	ProjectID        string    `json:"project_id"`
+	ProjectName      string    `json:"project_name"`
+	RequesterID      string    `json:"requester_id"`
+	TargetAssigneeID string    `json:"target_assignee_id"`
+	DeciderID        string    `json:"decider_id,omitempty"`
+	Outcome          string    `json:"outcome"`
+	Reason           string    `json:"reason,omitempty"`
+	DecidedAt        time.Time `json:"decided_at"`
+}
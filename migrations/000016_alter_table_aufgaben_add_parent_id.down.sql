@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_aufgaben_parent;
+
+ALTER TABLE aufgaben
+    DROP CONSTRAINT check_parent_not_self,
+    DROP COLUMN parent_id;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Subtask_Created' and 'Subtask_Completed' stay in action_events
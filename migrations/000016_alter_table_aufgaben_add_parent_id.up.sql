@@ -0,0 +1,8 @@
+ALTER TABLE aufgaben
+    ADD COLUMN parent_id UUID NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    ADD CONSTRAINT check_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX idx_aufgaben_parent ON aufgaben(parent_id) WHERE parent_id IS NOT NULL;
+
+ALTER TYPE action_events ADD VALUE 'Subtask_Created';
+ALTER TYPE action_events ADD VALUE 'Subtask_Completed';
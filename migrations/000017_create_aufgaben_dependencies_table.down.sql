@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS aufgaben_dependencies;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Dependency_Added' and 'Dependency_Removed' stay in action_events
@@ -0,0 +1,17 @@
+-- AUFGABEN DEPENDENCIES ("aufgaben_id" is blocked by "blocked_by_id")
+CREATE TABLE aufgaben_dependencies (
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    blocked_by_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (aufgaben_id, blocked_by_id),
+    CONSTRAINT check_dependency_not_self CHECK (aufgaben_id <> blocked_by_id)
+);
+
+-- INDEX
+CREATE INDEX idx_aufgaben_dependencies_blocked_by ON aufgaben_dependencies(blocked_by_id);
+
+ALTER TYPE action_events ADD VALUE 'Dependency_Added';
+ALTER TYPE action_events ADD VALUE 'Dependency_Removed';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS aufgaben_comment_mentions;
+DROP TABLE IF EXISTS aufgaben_comments;
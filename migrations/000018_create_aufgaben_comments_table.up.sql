@@ -0,0 +1,24 @@
+-- AUFGABEN COMMENTS
+CREATE TABLE aufgaben_comments (
+    id UUID PRIMARY KEY,
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    body TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    edited_at TIMESTAMPTZ DEFAULT NULL,
+    deleted_at TIMESTAMPTZ DEFAULT NULL
+);
+
+-- COMMENT MENTIONS (one row per mentioned user, so edits only notify new mentions)
+CREATE TABLE aufgaben_comment_mentions (
+    comment_id UUID NOT NULL REFERENCES aufgaben_comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (comment_id, user_id)
+);
+
+-- INDEX
+CREATE INDEX idx_aufgaben_comments_aufgaben ON aufgaben_comments(aufgaben_id, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX idx_aufgaben_comment_mentions_user ON aufgaben_comment_mentions(user_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS aufgaben_labels;
+DROP TABLE IF EXISTS project_labels;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Label_Added' and 'Label_Removed' stay in action_events
@@ -0,0 +1,29 @@
+-- PROJECT LABELS
+CREATE TABLE project_labels (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) DEFAULT NULL,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT NULL
+);
+
+-- AUFGABEN LABELS
+CREATE TABLE aufgaben_labels (
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    label_id UUID NOT NULL REFERENCES project_labels(id) ON DELETE CASCADE,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (aufgaben_id, label_id)
+);
+
+-- INDEX
+CREATE UNIQUE INDEX idx_project_labels_name ON project_labels(project_id, lower(name));
+CREATE INDEX idx_aufgaben_labels_label ON aufgaben_labels(label_id);
+
+ALTER TYPE action_events ADD VALUE 'Label_Added';
+ALTER TYPE action_events ADD VALUE 'Label_Removed';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_aufgaben_comments_search_vector;
+DROP INDEX IF EXISTS idx_aufgaben_search_vector;
+
+ALTER TABLE aufgaben_comments DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE aufgaben DROP COLUMN IF EXISTS search_vector;
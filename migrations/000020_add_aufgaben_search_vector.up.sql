@@ -0,0 +1,15 @@
+-- FULL TEXT SEARCH ('simple' config, task texts are written in mixed languages)
+ALTER TABLE aufgaben
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE aufgaben_comments
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('simple', coalesce(body, ''))
+    ) STORED;
+
+-- INDEX
+CREATE INDEX idx_aufgaben_search_vector ON aufgaben USING GIN (search_vector);
+CREATE INDEX idx_aufgaben_comments_search_vector ON aufgaben_comments USING GIN (search_vector) WHERE deleted_at IS NULL;
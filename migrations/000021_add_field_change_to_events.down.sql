@@ -0,0 +1,6 @@
+ALTER TABLE aufgaben_assignment_events
+    DROP COLUMN IF EXISTS field_name,
+    DROP COLUMN IF EXISTS old_value,
+    DROP COLUMN IF EXISTS new_value;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Task_Updated' stays in action_events
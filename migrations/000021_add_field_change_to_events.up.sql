@@ -0,0 +1,6 @@
+ALTER TABLE aufgaben_assignment_events
+    ADD COLUMN field_name VARCHAR(50) NULL,
+    ADD COLUMN old_value TEXT NULL,
+    ADD COLUMN new_value TEXT NULL;
+
+ALTER TYPE action_events ADD VALUE 'Task_Updated';
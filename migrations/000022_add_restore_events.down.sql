@@ -0,0 +1,2 @@
+-- PostgreSQL doesn't support removing enum values directly, so 'Task_Unarchived', 'Task_Reopened',
+-- 'Mistake', 'Scope_Changed' and 'Defect_Found' stay in action_events and reason_code_event
@@ -0,0 +1,6 @@
+ALTER TYPE action_events ADD VALUE 'Task_Unarchived';
+ALTER TYPE action_events ADD VALUE 'Task_Reopened';
+
+ALTER TYPE reason_code_event ADD VALUE 'Mistake';
+ALTER TYPE reason_code_event ADD VALUE 'Scope_Changed';
+ALTER TYPE reason_code_event ADD VALUE 'Defect_Found';
@@ -0,0 +1,6 @@
+ALTER TABLE aufgaben DROP COLUMN IF EXISTS workflow_status;
+
+DROP TABLE IF EXISTS project_workflow_transitions;
+DROP TABLE IF EXISTS project_workflow_statuses;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Status_Changed' stays in action_events
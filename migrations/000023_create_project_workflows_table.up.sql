@@ -0,0 +1,36 @@
+-- PROJECT WORKFLOW STATUSES
+-- Each status maps to one aufgaben_status category, so reminders, dependencies and rollups keep working
+CREATE TABLE project_workflow_statuses (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    category aufgaben_status NOT NULL,
+    position INT NOT NULL,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (project_id, key),
+    CONSTRAINT check_workflow_category CHECK (category <> 'Archived')
+);
+
+-- PROJECT WORKFLOW TRANSITIONS
+CREATE TABLE project_workflow_transitions (
+    project_id UUID NOT NULL,
+    from_key VARCHAR(50) NOT NULL,
+    to_key VARCHAR(50) NOT NULL,
+    allowed_roles user_role_enum[] NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (project_id, from_key, to_key),
+    FOREIGN KEY (project_id, from_key) REFERENCES project_workflow_statuses(project_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (project_id, to_key) REFERENCES project_workflow_statuses(project_id, key) ON DELETE CASCADE,
+    CONSTRAINT check_transition_not_self CHECK (from_key <> to_key)
+);
+
+-- NULL means the first workflow status of the task's category
+ALTER TABLE aufgaben
+    ADD COLUMN workflow_status VARCHAR(50) NULL;
+
+ALTER TYPE action_events ADD VALUE 'Status_Changed';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS aufgaben_worklogs;
+DROP TYPE IF EXISTS worklog_source;
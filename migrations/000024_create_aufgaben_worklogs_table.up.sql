@@ -0,0 +1,23 @@
+CREATE TYPE worklog_source AS ENUM ('Timer', 'Manual');
+
+-- AUFGABEN WORKLOGS
+-- ended_at stays NULL while the timer is running
+CREATE TABLE aufgaben_worklogs (
+    id UUID PRIMARY KEY,
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    source worklog_source NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ NULL,
+    note TEXT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT NULL,
+
+    CONSTRAINT check_worklog_range CHECK (ended_at IS NULL OR ended_at > started_at)
+);
+
+-- INDEX
+CREATE UNIQUE INDEX idx_aufgaben_worklogs_running ON aufgaben_worklogs(user_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_aufgaben_worklogs_task ON aufgaben_worklogs(aufgaben_id);
+CREATE INDEX idx_aufgaben_worklogs_user_started ON aufgaben_worklogs(user_id, started_at);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_aufgaben_project_completed;
+
+ALTER TABLE aufgaben
+    DROP CONSTRAINT IF EXISTS check_estimate,
+    DROP COLUMN IF EXISTS estimate_unit,
+    DROP COLUMN IF EXISTS estimate;
+
+DROP TYPE IF EXISTS estimate_unit;
@@ -0,0 +1,12 @@
+CREATE TYPE estimate_unit AS ENUM ('Points', 'Hours');
+
+ALTER TABLE aufgaben
+    ADD COLUMN estimate NUMERIC(7, 2) NULL,
+    ADD COLUMN estimate_unit estimate_unit NULL,
+    ADD CONSTRAINT check_estimate CHECK (
+        (estimate IS NULL AND estimate_unit IS NULL)
+        OR (estimate > 0 AND estimate_unit IS NOT NULL)
+    );
+
+-- velocity is computed per week from completed_at
+CREATE INDEX idx_aufgaben_project_completed ON aufgaben(project_id, completed_at) WHERE completed_at IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_aufgaben_recurrence_occurrence;
+
+ALTER TABLE aufgaben
+    DROP COLUMN IF EXISTS occurrence_at,
+    DROP COLUMN IF EXISTS recurrence_id;
+
+DROP TABLE IF EXISTS aufgaben_recurrences;
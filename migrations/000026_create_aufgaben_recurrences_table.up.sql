@@ -0,0 +1,31 @@
+-- AUFGABEN RECURRENCES
+-- A recurrence is the template of a repeating task, the worker creates one aufgabe per occurrence
+CREATE TABLE aufgaben_recurrences (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    priority aufgaben_priority NOT NULL DEFAULT 'Medium',
+    default_assignee_id UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    due_offset_minutes INT NULL,
+    cron_expr VARCHAR(100) NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT NULL,
+
+    CONSTRAINT check_due_offset CHECK (due_offset_minutes IS NULL OR due_offset_minutes >= 0)
+);
+
+-- Generated tasks link back to their series, one task per occurrence
+ALTER TABLE aufgaben
+    ADD COLUMN recurrence_id UUID NULL REFERENCES aufgaben_recurrences(id) ON DELETE SET NULL,
+    ADD COLUMN occurrence_at TIMESTAMPTZ NULL;
+
+-- INDEX
+CREATE UNIQUE INDEX idx_aufgaben_recurrence_occurrence ON aufgaben(recurrence_id, occurrence_at) WHERE recurrence_id IS NOT NULL;
+CREATE INDEX idx_aufgaben_recurrences_project ON aufgaben_recurrences(project_id);
+CREATE INDEX idx_aufgaben_recurrences_due ON aufgaben_recurrences(next_run_at) WHERE active = true;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_aufgaben_templates_name;
+
+DROP TABLE IF EXISTS aufgaben_templates;
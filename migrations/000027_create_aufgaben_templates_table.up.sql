@@ -0,0 +1,26 @@
+-- AUFGABEN TEMPLATES
+-- Title and description may contain {{variable}} placeholders, they are filled in when a task is created from the template
+CREATE TABLE aufgaben_templates (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    priority aufgaben_priority NOT NULL DEFAULT 'Medium',
+    due_offset_minutes INT NULL,
+    estimate NUMERIC(7, 2) NULL,
+    estimate_unit estimate_unit NULL,
+
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT NULL,
+
+    CONSTRAINT check_template_due_offset CHECK (due_offset_minutes IS NULL OR due_offset_minutes >= 0),
+    CONSTRAINT check_template_estimate CHECK (
+        (estimate IS NULL AND estimate_unit IS NULL)
+        OR (estimate > 0 AND estimate_unit IS NOT NULL)
+    )
+);
+
+-- INDEX
+CREATE UNIQUE INDEX idx_aufgaben_templates_name ON aufgaben_templates(project_id, lower(name));
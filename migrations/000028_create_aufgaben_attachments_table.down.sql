@@ -0,0 +1,6 @@
+ALTER TABLE projects
+    DROP COLUMN IF EXISTS attachment_quota_bytes;
+
+DROP TABLE IF EXISTS aufgaben_attachments;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Attachment_Added' and 'Attachment_Removed' stay in action_events
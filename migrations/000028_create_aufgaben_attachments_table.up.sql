@@ -0,0 +1,27 @@
+-- AUFGABEN ATTACHMENTS
+-- Only metadata lives here, the content is stored by the blob storage under storage_key
+CREATE TABLE aufgaben_attachments (
+    id UUID PRIMARY KEY,
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+
+    uploaded_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT check_attachment_size CHECK (size_bytes > 0)
+);
+
+-- Every project gets 500 MB by default, the quota counts all attachments of the project
+ALTER TABLE projects
+    ADD COLUMN attachment_quota_bytes BIGINT NOT NULL DEFAULT 524288000;
+
+-- INDEX
+CREATE INDEX idx_aufgaben_attachments_task ON aufgaben_attachments(aufgaben_id, created_at);
+CREATE INDEX idx_aufgaben_attachments_project ON aufgaben_attachments(project_id);
+
+ALTER TYPE action_events ADD VALUE 'Attachment_Added';
+ALTER TYPE action_events ADD VALUE 'Attachment_Removed';
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS auto_watch_aufgaben ON aufgaben;
+DROP FUNCTION IF EXISTS auto_watch_aufgabe();
+
+DROP TABLE IF EXISTS aufgaben_watchers;
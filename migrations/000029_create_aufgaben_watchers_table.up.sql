@@ -0,0 +1,45 @@
+-- AUFGABEN WATCHERS
+-- Watchers get a mail through the worker when the task is assigned, completed, rescheduled, archived or commented
+CREATE TABLE aufgaben_watchers (
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (aufgaben_id, user_id)
+);
+
+-- TRIGGER
+-- Creator and every new assignee start watching automatically, whatever code path created or assigned the task.
+-- An unsubscribed user is only added again when the task is assigned to them anew.
+CREATE OR REPLACE FUNCTION auto_watch_aufgabe()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO aufgaben_watchers (aufgaben_id, user_id)
+        VALUES (NEW.id, NEW.created_by)
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    IF NEW.assignee_id IS NOT NULL AND (TG_OP = 'INSERT' OR NEW.assignee_id IS DISTINCT FROM OLD.assignee_id) THEN
+        INSERT INTO aufgaben_watchers (aufgaben_id, user_id)
+        VALUES (NEW.id, NEW.assignee_id)
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER auto_watch_aufgaben
+AFTER INSERT OR UPDATE OF assignee_id ON aufgaben
+FOR EACH ROW EXECUTE FUNCTION auto_watch_aufgabe();
+
+-- Existing tasks are watched by their creator and current assignee
+INSERT INTO aufgaben_watchers (aufgaben_id, user_id)
+SELECT id, created_by FROM aufgaben
+UNION
+SELECT id, assignee_id FROM aufgaben WHERE assignee_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- INDEX
+CREATE INDEX idx_aufgaben_watchers_user ON aufgaben_watchers(user_id);
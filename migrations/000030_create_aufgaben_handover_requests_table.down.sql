@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS aufgaben_handover_requests;
+
+DROP TYPE IF EXISTS handover_request_status_enum;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Handover_Rejected' and 'Handover_Expired' stay in action_events
@@ -0,0 +1,31 @@
+-- ENUM TYPE FOR HANDOVER REQUEST STATUS
+CREATE TYPE handover_request_status_enum AS ENUM ('Pending', 'Approved', 'Rejected', 'Expired');
+
+-- AUFGABEN HANDOVER REQUESTS
+-- A Mitarbeiter asks the Meister to hand their task over, the Meister approves or rejects before it expires
+CREATE TABLE aufgaben_handover_requests (
+    id UUID PRIMARY KEY,
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_assignee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    note TEXT NOT NULL,
+
+    status handover_request_status_enum NOT NULL DEFAULT 'Pending',
+    decided_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    decision_reason TEXT NULL,
+    decided_at TIMESTAMPTZ NULL,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TYPE action_events ADD VALUE 'Handover_Rejected';
+ALTER TYPE action_events ADD VALUE 'Handover_Expired';
+
+-- INDEX
+-- One open request per requester and task, the Meister decides before a new one can be filed
+CREATE UNIQUE INDEX uniq_aufgaben_handover_requests_pending ON aufgaben_handover_requests(aufgaben_id, requested_by) WHERE status = 'Pending';
+CREATE INDEX idx_aufgaben_handover_requests_project_status ON aufgaben_handover_requests(project_id, status, created_at);
+CREATE INDEX idx_aufgaben_handover_requests_expiry ON aufgaben_handover_requests(expires_at) WHERE status = 'Pending';
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS sync_aufgaben_owner ON aufgaben;
+DROP FUNCTION IF EXISTS sync_aufgabe_owner();
+
+DROP TRIGGER IF EXISTS auto_watch_aufgaben_assignees ON aufgaben_assignees;
+DROP FUNCTION IF EXISTS auto_watch_aufgabe_assignee();
+
+DROP TABLE IF EXISTS aufgaben_assignees;
+
+DROP TYPE IF EXISTS assignee_role_enum;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Participant_Added' and 'Participant_Removed' stay in action_events
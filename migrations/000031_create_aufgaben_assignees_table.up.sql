@@ -0,0 +1,70 @@
+-- ENUM
+CREATE TYPE assignee_role_enum AS ENUM ('Owner', 'Collaborator', 'Reviewer');
+
+-- AUFGABEN ASSIGNEES
+-- Every participant of a task. The Owner mirrors aufgaben.assignee_id, collaborators and reviewers are added on top of it.
+CREATE TABLE aufgaben_assignees (
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role assignee_role_enum NOT NULL,
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (aufgaben_id, user_id)
+);
+
+-- TRIGGER
+-- Keep the Owner row in sync with aufgaben.assignee_id, whatever code path assigned, handed over or unassigned the task.
+-- A collaborator or reviewer who becomes the assignee is promoted to Owner.
+CREATE OR REPLACE FUNCTION sync_aufgabe_owner()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.assignee_id IS NOT NULL AND OLD.assignee_id IS DISTINCT FROM NEW.assignee_id THEN
+        DELETE FROM aufgaben_assignees
+        WHERE aufgaben_id = NEW.id
+            AND user_id = OLD.assignee_id
+            AND role = 'Owner';
+    END IF;
+
+    IF NEW.assignee_id IS NOT NULL AND (TG_OP = 'INSERT' OR NEW.assignee_id IS DISTINCT FROM OLD.assignee_id) THEN
+        INSERT INTO aufgaben_assignees (aufgaben_id, user_id, role)
+        VALUES (NEW.id, NEW.assignee_id, 'Owner')
+        ON CONFLICT (aufgaben_id, user_id) DO UPDATE SET role = 'Owner';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sync_aufgaben_owner
+AFTER INSERT OR UPDATE OF assignee_id ON aufgaben
+FOR EACH ROW EXECUTE FUNCTION sync_aufgabe_owner();
+
+-- Every participant starts watching the task
+CREATE OR REPLACE FUNCTION auto_watch_aufgabe_assignee()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO aufgaben_watchers (aufgaben_id, user_id)
+    VALUES (NEW.aufgaben_id, NEW.user_id)
+    ON CONFLICT DO NOTHING;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER auto_watch_aufgaben_assignees
+AFTER INSERT ON aufgaben_assignees
+FOR EACH ROW EXECUTE FUNCTION auto_watch_aufgabe_assignee();
+
+-- Existing assignees become the Owner of their task
+INSERT INTO aufgaben_assignees (aufgaben_id, user_id, role)
+SELECT id, assignee_id, 'Owner' FROM aufgaben WHERE assignee_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- ACTION EVENTS
+ALTER TYPE action_events ADD VALUE 'Participant_Added';
+ALTER TYPE action_events ADD VALUE 'Participant_Removed';
+
+-- INDEX
+CREATE UNIQUE INDEX uniq_aufgaben_assignees_owner ON aufgaben_assignees(aufgaben_id) WHERE role = 'Owner';
+CREATE INDEX idx_aufgaben_assignees_user ON aufgaben_assignees(user_id);
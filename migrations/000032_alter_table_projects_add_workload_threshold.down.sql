@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_aufgaben_assignment_events_overload;
+
+ALTER TABLE projects
+    DROP COLUMN IF EXISTS workload_threshold;
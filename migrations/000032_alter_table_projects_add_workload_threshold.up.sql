@@ -0,0 +1,8 @@
+-- WORKLOAD THRESHOLD
+-- Load score above which a force handover warns the Meister, 0 turns the warning off
+ALTER TABLE projects
+    ADD COLUMN workload_threshold INT NOT NULL DEFAULT 10 CHECK (workload_threshold >= 0);
+
+-- INDEX
+-- Recent Overload unassigns are part of a member's load score
+CREATE INDEX idx_aufgaben_assignment_events_overload ON aufgaben_assignment_events(target_assignee_id, created_at) WHERE reason_code = 'Overload';
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS aufgaben_sla_breaches;
+DROP TABLE IF EXISTS project_sla_policies;
+
+DROP TYPE IF EXISTS sla_breach_kind_enum;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'SLA_Breached' stays in action_events
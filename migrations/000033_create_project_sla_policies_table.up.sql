@@ -0,0 +1,37 @@
+-- ENUM
+CREATE TYPE sla_breach_kind_enum AS ENUM ('Assignment', 'Completion');
+
+-- PROJECT SLA POLICIES
+-- One policy per priority and project. Both targets count from the creation of the task, a missing target is not measured.
+CREATE TABLE project_sla_policies (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    priority aufgaben_priority NOT NULL,
+    assign_within_minutes INT NULL CHECK (assign_within_minutes > 0),
+    complete_within_minutes INT NULL CHECK (complete_within_minutes > 0),
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (project_id, priority),
+    CHECK (assign_within_minutes IS NOT NULL OR complete_within_minutes IS NOT NULL)
+);
+
+-- AUFGABEN SLA BREACHES
+-- Written by the worker, a task breaches every kind at most once
+CREATE TABLE aufgaben_sla_breaches (
+    id UUID PRIMARY KEY,
+    aufgaben_id UUID NOT NULL REFERENCES aufgaben(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    kind sla_breach_kind_enum NOT NULL,
+    priority aufgaben_priority NOT NULL,
+    deadline_at TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (aufgaben_id, kind)
+);
+
+-- ACTION EVENTS
+ALTER TYPE action_events ADD VALUE 'SLA_Breached';
+
+-- INDEX
+CREATE INDEX idx_aufgaben_sla_breaches_project ON aufgaben_sla_breaches(project_id, detected_at);
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_aufgaben_flagged_for_unassign;
+
+DROP TRIGGER IF EXISTS trg_reset_aufgabe_escalation ON aufgaben;
+DROP FUNCTION IF EXISTS reset_aufgabe_escalation();
+
+ALTER TABLE aufgaben
+    DROP COLUMN IF EXISTS flagged_for_unassign_at,
+    DROP COLUMN IF EXISTS escalated_at,
+    DROP COLUMN IF EXISTS escalation_level,
+    DROP COLUMN IF EXISTS overdue_reminder_count;
+
+DROP TABLE IF EXISTS project_escalation_levels;
+
+DROP TYPE IF EXISTS escalation_action_enum;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Escalated' stays in action_events
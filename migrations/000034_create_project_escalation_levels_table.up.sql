@@ -0,0 +1,50 @@
+-- ENUM
+CREATE TYPE escalation_action_enum AS ENUM ('Notify_Meister', 'Flag_Force_Unassign');
+
+-- PROJECT ESCALATION LEVELS
+-- A level is reached after a number of overdue reminders or hours overdue, whichever comes first
+CREATE TABLE project_escalation_levels (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    level SMALLINT NOT NULL CHECK (level BETWEEN 1 AND 5),
+    after_reminders INT NULL CHECK (after_reminders > 0),
+    after_hours_overdue INT NULL CHECK (after_hours_overdue > 0),
+    action escalation_action_enum NOT NULL DEFAULT 'Notify_Meister',
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (project_id, level),
+    CHECK (after_reminders IS NOT NULL OR after_hours_overdue IS NOT NULL)
+);
+
+-- AUFGABEN
+-- Escalation is tracked next to reminder_stage and restarts whenever reminder_stage is reset
+ALTER TABLE aufgaben
+    ADD COLUMN overdue_reminder_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN escalation_level SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN escalated_at TIMESTAMPTZ NULL,
+    ADD COLUMN flagged_for_unassign_at TIMESTAMPTZ NULL;
+
+CREATE OR REPLACE FUNCTION reset_aufgabe_escalation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.reminder_stage = 'None' THEN
+        NEW.overdue_reminder_count := 0;
+        NEW.escalation_level := 0;
+        NEW.escalated_at := NULL;
+        NEW.flagged_for_unassign_at := NULL;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_reset_aufgabe_escalation
+BEFORE UPDATE OF reminder_stage ON aufgaben
+FOR EACH ROW
+EXECUTE FUNCTION reset_aufgabe_escalation();
+
+-- ACTION EVENTS
+ALTER TYPE action_events ADD VALUE 'Escalated';
+
+-- INDEX
+CREATE INDEX idx_aufgaben_flagged_for_unassign ON aufgaben(project_id) WHERE flagged_for_unassign_at IS NOT NULL;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_aufgaben_board_rank;
+
+DROP TRIGGER IF EXISTS trg_set_aufgabe_board_rank ON aufgaben;
+DROP FUNCTION IF EXISTS set_aufgabe_board_rank();
+
+ALTER TABLE aufgaben
+    DROP COLUMN IF EXISTS board_rank;
+
+DROP TABLE IF EXISTS project_board_columns;
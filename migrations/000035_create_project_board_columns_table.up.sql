@@ -0,0 +1,52 @@
+-- PROJECT BOARD COLUMNS
+-- Board columns are the project's workflow statuses, this only holds their optional WIP limit.
+-- No foreign key, projects on the default workflow have no rows in project_workflow_statuses.
+CREATE TABLE project_board_columns (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    status_key VARCHAR(50) NOT NULL,
+    wip_limit INT NOT NULL CHECK (wip_limit > 0),
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (project_id, status_key)
+);
+
+-- AUFGABEN
+-- Manual order on the board, only compared between tasks of the same column.
+-- NUMERIC keeps midpoints exact, so a task can always be dropped between two others.
+ALTER TABLE aufgaben
+    ADD COLUMN board_rank NUMERIC NULL;
+
+UPDATE aufgaben a
+SET board_rank = r.rn * 1024
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY project_id ORDER BY created_at, id) AS rn
+    FROM aufgaben
+) r
+WHERE r.id = a.id;
+
+ALTER TABLE aufgaben
+    ALTER COLUMN board_rank SET NOT NULL;
+
+-- New tasks go to the bottom of their column
+CREATE OR REPLACE FUNCTION set_aufgabe_board_rank()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.board_rank IS NULL THEN
+        SELECT COALESCE(MAX(board_rank), 0) + 1024
+        INTO NEW.board_rank
+        FROM aufgaben
+        WHERE project_id = NEW.project_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_set_aufgabe_board_rank
+BEFORE INSERT ON aufgaben
+FOR EACH ROW
+EXECUTE FUNCTION set_aufgabe_board_rank();
+
+-- INDEX
+CREATE INDEX idx_aufgaben_board_rank ON aufgaben(project_id, board_rank) WHERE archived_at IS NULL;
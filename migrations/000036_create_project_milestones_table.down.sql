@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_aufgaben_milestone;
+DROP INDEX IF EXISTS idx_project_milestones_project;
+
+ALTER TABLE aufgaben
+    DROP COLUMN IF EXISTS milestone_id;
+
+DROP TABLE IF EXISTS project_milestones;
+
+-- PostgreSQL doesn't support removing enum values directly, so 'Milestone_Changed' stays in action_events
@@ -0,0 +1,30 @@
+-- PROJECT MILESTONES
+-- A time boxed iteration of a project, closed milestones are read only
+CREATE TABLE project_milestones (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    goal TEXT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    closed_at TIMESTAMPTZ NULL,
+    closed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NULL,
+
+    UNIQUE (project_id, name),
+    CHECK (end_date >= start_date)
+);
+
+-- AUFGABEN
+-- A task is in at most one milestone, every change is written as 'Milestone_Changed' event for the burndown
+ALTER TABLE aufgaben
+    ADD COLUMN milestone_id UUID NULL REFERENCES project_milestones(id) ON DELETE SET NULL;
+
+-- ACTION EVENTS
+ALTER TYPE action_events ADD VALUE 'Milestone_Changed';
+
+-- INDEX
+CREATE INDEX idx_project_milestones_project ON project_milestones(project_id, start_date);
+CREATE INDEX idx_aufgaben_milestone ON aufgaben(milestone_id) WHERE milestone_id IS NOT NULL;
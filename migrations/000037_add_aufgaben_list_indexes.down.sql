@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_aufgaben_project_created;
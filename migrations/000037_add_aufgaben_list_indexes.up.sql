@@ -0,0 +1,3 @@
+-- INDEX
+-- Default order of the task list, the id breaks ties for the keyset cursor
+CREATE INDEX idx_aufgaben_project_created ON aufgaben(project_id, created_at DESC, id DESC) WHERE archived_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_calendar_feeds_active_scope;
+DROP TABLE IF EXISTS calendar_feeds;
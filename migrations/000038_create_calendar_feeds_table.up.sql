@@ -0,0 +1,18 @@
+-- CALENDAR FEEDS
+-- A revocable token for an .ics feed, independent of any session. Only the hash of the token is stored,
+-- feeds without project are the personal feed of the user, with project they are the Meister feed of that project
+CREATE TABLE calendar_feeds (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    project_id UUID NULL REFERENCES projects(id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NULL
+);
+
+-- INDEX
+-- One active feed per user and scope, issuing a new one revokes the old one
+CREATE UNIQUE INDEX idx_calendar_feeds_active_scope
+    ON calendar_feeds(user_id, COALESCE(project_id, '00000000-0000-0000-0000-000000000000'::uuid))
+    WHERE revoked_at IS NULL;